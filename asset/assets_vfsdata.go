@@ -32,21 +32,19 @@ var Assets = func() http.FileSystem {
 			modTime:          time.Date(1970, 1, 1, 0, 0, 1, 0, time.UTC),
 			uncompressedSize: 2681,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\xc3\xfb\x3f\xd3\x8f\xa3\xc0\xf1\xf7\x66\x8d\x0d\xd9\x5c\xb7\x2e\xd8\x2a\x86\x2e\xee\xdd\x24\x5a\x85\xb0\xa4\x95\x7b\x14\xc9\xad\xdc\x1b\x8a\xcd\x92\xd6\x10\x4a\x08\xcd\x84\x72\x27\xbe\x98\x30\x53\xae\x1f\x84\xf5\x61\x2e\x95\x7b\xee\xd7\x70\x18\xb3\xf3\x38\x3f\x9e\xff\xe0\xfb\x7a\x3d\x9e\x2f\xad\x08\x26\x92\xf0\x03\x70\x00\x00\x24\xcd\x4c\x2f\x5b\x03\x00\xc0\xf9\x3f\x62\x22\x00\x00\xdc\x07\x67\xe8\x01\x00\x90\x6b\x65\x41\xbc\x62\xe2\x67\x21\xfc\xaf\x8f\xa6\x2a\xb2\x05\x00\x80\x7e\x90\x35\xe1\x26\x20\xf2\x3f\x12\x7b\x1a\x93\xa0\x1d\xc1\x22\x62\x6d\x46\xae\x5f\x71\xa3\x33\xd9\x03\x3a\x4a\xba\x8a\xf6\x8b\x89\xc6\x8a\x25\x58\xa5\xd7\xb6\x92\x0b\xcd\x6f\x0d\x73\x2e\x9e\xd5\x75\xc9\xe5\x3a\x94\x55\xa9\x9c\x64\xac\xd4\x62\x28\x00\x00\xb3\x34\xbb\x6c\x4c\x0c\x19\x5a\x74\x9a\x4d\xfe\x50\x27\x6f\x89\xc0\x68\xab\x53\xb0\x62\xea\xb8\x33\xdd\x6b\x7b\xf1\x8f\x9b\x13\x4b\x36\xe7\xe1\x18\xe0\xf7\xd4\xde\xa0\x77\xb0\x7f\xd5\xd9\xc0\xd7\x32\x00\xfd\x19\x49\xfb\x63\xc1\xa1\x99\x6c\x43\x48\xa6\xe8\x80\xed\xc6\xfe\x43\x5e\x9f\x69\x37\xba\x13\xbd\x23\xd2\xb6\xc6\xbf\xf6\x42\xf4\xd9\x20\xff\x27\x13\x33\xab\x99\x47\xd8\xb3\xaf\xd6\x8b\xd7\x11\xc2\x6b\xc6\x9b\x4f\xcc\x57\xf1\xb8\x10\x4b\xd7\xa1\xfb\xe3\x4a\x1e\x66\x43\x9a\xc4\xad\x6e\xab\xf0\x77\x71\x23\x94\x2b\x73\x67\xbc\x78\xc3\x28\x66\x39\x0a\xf2\xd5\x61\xd5\x37\xed\xdf\xf7\x07\xba\xa0\xce\x0f\xfa\x6c\xce\x0b\x4f\xec\xbd\xa5\x6a\x96\x16\x6a\xa5\xbe\x9c\xcb\x26\xc7\x90\x4f\x43\x55\x89\x73\xf2\x68\xe6\x56\x66\x81\x10\xfa\xfe\x0b\x5a\x64\xc1\x41\x48\x14\x90\x5a\xca\x1a\x50\x78\xa7\x26\x52\xf6\x6a\x6c\x3b\x36\xe2\x50\x85\x8d\xab\x6d\x1f\x07\x15\x52\x5a\xc3\xce\x8b\xb0\xa0\x24\xcb\x4a\x14\xd1\xe3\x30\xab\xfc\xc0\x77\xa6\xbb\xb5\x5a\x3e\x05\xba\xd0\x81\x6a\x6b\x98\x14\xfa\x51\x62\x96\x76\x9f\xb0\x6b\xde\xf5\x46\xde\x51\x4b\x6a\xdc\x1b\xad\x29\x2b\x0b\x51\xeb\xd1\x24\x6f\x09\x2b\xc7\x3b\xc4\x06\xb9\x1f\x78\x6b\x9d\x24\x77\x09\x2b\xbb\x33\x69\x4d\x2d\x41\x91\xac\x59\xbe\x6d\xb4\x56\xea\xd2\x2b\xfa\x5c\xc3\x4b\x89\x63\x28\x91\x65\xbd\xb8\x70\x85\xe6\x7d\x91\x27\x06\x50\xaf\xa3\x5a\x53\x06\x59\xa6\x9a\x05\x8a\x95\xf5\xe3\x75\x6c\x62\xd1\x96\x0d\xbc\xf9\x72\x74\xcc\x5c\xe6\xad\x8f\x21\xab\xbe\x7a\xf8\x7b\x12\xba\x7a\x2f\x4f\xc7\xb7\x30\xf0\xfe\x9e\x2e\xc7\xba\x3e\xe7\xdd\x30\xad\x63\x9f\x4a\xc1\xed\x55\xb8\x33\xb9\x96\x54\x81\x5f\x9e\x59\x31\x2b\xa8\x4c\x82\xa4\x05\x3d\x55\xba\xc4\xea\x0d\x8d\x1e\xa7\x73\xe0\xec\x7a\x2f\xce\x53\xd8\xf5\x6a\xed\x55\x5f\xd9\x36\x6f\xe8\x1d\x47\x02\x38\xcb\x7a\xb5\x54\xf6\x5c\xc5\x2a\x4d\xd9\x72\xbc\xd0\x5a\x40\x4a\xc4\x68\x41\x2b\xaa\xee\x28\x15\xf2\x93\xa4\xea\x8f\x55\x69\xad\xc4\xbd\xad\x5f\xfe\xc0\xba\xcd\x3a\x20\xf2\xdb\x50\xc4\x67\x13\x9c\x25\xc7\x93\x52\x70\x15\xf3\xb7\x8e\xed\x23\xde\x9b\x08\x03\xe7\x43\xaf\x3a\xa2\x98\x2c\x76\xcf\xcf\x11\x58\xac\xf7\x71\x72\xa7\x5e\x03\x84\x0d\x61\x43\xf6\x96\x61\x33\xce\xe0\xff\xff\x24\x24\x7a\x21\x85\x93\xc2\x69\x45\x1a\xb1\x4e\x74\xa6\x49\x27\xec\xaf\x0f\x9d\x0f\x84\xfd\xc7\xfd\x7b\x81\x16\x1c\xa1\x07\x3e\x46\x9a\xca\xd9\xb6\x8f\xe4\x54\x3c\xef\x3e\xca\x09\xe0\x73\x45\x38\x06\x71\xc7\xec\x21\x19\xda\xb0\xa0\x3f\x57\x95\x80\x51\xbb\x17\xfb\xf5\x0e\xfa\x5d\xf3\x39\x71\x6d\xfa\x16\x77\xc1\xe7\x32\xe8\xca\x29\xa2\x62\xe4\x6d\x92\x5f\x54\xfb\x1d\x7a\xf1\x78\x49\x58\xb1\x18\xd3\xa5\x78\x05\x30\x22\x71\xee\x9e\x67\x9f\x43\xce\x2c\x14\x5f\x0e\x1f\xf5\x3a\x3e\x2a\xfc\xc4\x47\xb8\xdc\x17\x40\x40\xc0\x9f\x80\xee\x26\x25\x53\x66\xee\xd0\x62\xe1\x6e\x72\xe6\x6c\x60\x30\xa6\x87\xfb\x98\xd7\x85\x0d\x94\x75\xdb\xb1\xb7\x14\x63\x6e\xcf\x7c\xb9\xe5\x76\xd5\xbe\x25\x7e\x0c\x81\x8b\x88\x79\x59\xf3\xce\x17\x4b\xee\x67\xda\x89\x83\xbf\xb2\x60\xbe\xfc\x37\x76\x7b\xe7\x10\x2e\x35\xc6\xc2\xaa\x77\x8a\xd0\xc1\x06\xa2\x08\x58\x07\x8f\x49\x69\x08\x03\x17\x79\xd1\x4e\x6f\x17\x9f\x6d\x27\x15\xa8\xf4\x58\xf3\x4e\x6f\x8f\x7d\x55\xe5\x5b\x34\x43\x6c\x83\xc3\xcf\x6b\x70\x4a\x37\xdd\x9c\x1b\x31\x9a\x6a\xb1\x46\xde\xb5\xb5\x1d\xe4\x9f\x9a\xfe\xbb\xfe\x1a\x1c\xaf\x5e\x41\x0e\xde\x59\x35\xa9\x81\x2b\xe7\x52\xd9\xeb\x14\xe1\x8f\x6d\xca\x34\xa1\xfc\x32\x8c\x10\x46\xf7\x9e\x28\xff\xdc\xac\x80\x1f\xf5\x6c\x60\x7e\xbe\x3d\xc8\xea\x91\x73\xa1\x2b\x6b\xea\x2c\xad\xa5\xf7\xea\x9b\x4c\xe4\x6b\x83\x9d\x1c\xea\x4e\xad\x70\xbb\x7f\x9c\x29\xa4\x53\x7e\xd6\x76\x48\xc9\xd6\xcb\x90\x15\x41\x8d\x9e\x23\x42\x96\x47\xe0\xea\x34\xd2\x45\xbf\x6b\xa7\x59\x3a\x30\xa7\x25\xd3\x04\xd8\xee\x68\xf5\x6f\xff\x63\x98\x19\xe3\x5a\x8b\x82\x84\x25\xc1\xca\xec\xb7\xce\x04\x0f\x93\x72\x32\xc8\x16\x17\xf6\x08\x9c\xe4\x19\x69\x2b\x5e\x7c\xf6\x6b\x46\xe3\x94\x40\xab\x0a\xf5\x49\x5e\x32\x5f\x59\xb4\xe9\xd4\x74\x4d\xb5\x22\x75\x04\xb6\xe2\x1d\xf9\x71\xf7\xb0\x60\x8c\x62\xb4\x93\xe1\x7c\x76\x1a\xa5\x51\x76\x04\xc3\x11\x73\xb4\x89\x08\xbc\xf0\xea\x1d\x43\x59\x6f\x1f\xdd\xb5\xdf\x57\x48\x50\x7e\x0d\x1b\xea\x7c\xe0\x2a\xbb\x94\x41\xd9\x2e\x04\xad\x07\x19\xf9\x21\x27\x47\x6a\xbd\x0e\xf3\x1b\x64\xc4\x74\x64\x12\x76\x83\x47\xa2\x10\x61\x1d\x5d\x52\x3b\xb3\x4b\x8c\xd6\xbd\x9d\x07\x70\xb3\x7b\xde\x74\x63\xce\x3f\x5f\xb8\xd4\xc0\x35\x45\x9b\xd8\x0d\x5d\x91\xdb\x07\x70\xf1\x0d\x43\x4c\xa6\x91\x02\xf8\xe0\x14\x09\x1c\x13\x3b\xf8\xc5\x20\x64\x76\x2a\xb3\x12\x5b\x11\x1a\xf6\x30\x22\xab\x77\x60\xf7\xb2\xeb\x2a\x09\xfc\xc9\xae\xdf\x40\x35\x18\x24\x57\xb2\x6b\x53\x6d\x59\xc1\x50\x90\x1f\x72\x55\x1f\x43\x4a\x6a\x9e\x44\xb0\x86\x2d\xc8\x6b\x7e\xfc\xbf\xfc\x26\x7e\xbe\x7a\x86\x60\xd7\xfe\x5b\x72\xfe\x99\xfb\x6e\xae\x3f\xc7\x66\x76\xec\xce\x25\x77\x13\xfa\xfe\x56\x33\x7e\xc7\xdd\x0a\x60\x40\x45\x15\x0e\xbc\xcf\xc3\x16\x6b\x3d\xf2\xc3\xa5\xc7\x9e\x78\xb2\x23\x05\xfd\x13\x0e\xe3\x9a\xe3\x8f\x44\xfd\xaa\xb3\xa7\x19\x1b\x05\x95\x87\xc7\x09\x1a\x39\xf5\xf7\x33\xbc\xdc\xb8\xb7\x99\x67\xfa\xe5\x51\x90\x03\xf5\x45\xed\x56\x3c\xd3\xf4\x64\x9e\xb9\xd7\xc9\xbb\xd8\x8f\xb2\xb4\xfe\xdf\x35\xf1\x89\x96\x22\x81\xef\xcf\xa6\xa4\x16\xdf\x94\xd8\x9a\xd2\xbf\xc1\xe4\x7d\xfa\x29\x59\x09\x09\xa7\x27\x9a\x01\x28\x77\x15\xce\x23\x13\xb5\x65\xe6\x0c\x8e\x20\x78\x56\xf3\xc7\xa3\x5d\x69\xde\xf6\x74\xbd\xcd\xe0\x0e\xc1\x83\x1c\xb6\x4f\x24\x42\x55\xbc\x9e\x97\x72\xdb\xb0\xf2\x9b\x82\x07\x35\x58\x66\xc9\x34\xa1\xca\x9c\xa0\xde\x52\xfb\x62\xb6\xd0\x4f\x5a\x75\x7d\x4e\x1c\xdd\x1a\x03\x66\x26\xe4\x38\x55\xd8\x0c\x41\x2d\x76\xe4\xa8\x59\x95\xb9\x2a\xe4\x3a\x27\x17\xe4\x8a\x69\x5a\x8c\x67\xe1\xf3\x92\x54\x6d\x31\xfa\xb1\xaa\xe5\x7b\x48\x65\x0d\x4f\xae\x97\xb0\x5c\x34\x90\x97\xab\x4a\x9e\x8f\x3d\x5b\x7c\xd7\xb8\x7c\xae\xc8\xab\xfe\x73\xd9\x04\xe3\x5e\xa1\xed\xdc\x98\x93\xfa\x9f\x78\x43\x18\x28\x47\xc3\x97\x8a\xed\x02\xa7\x1f\xbc\xfb\x1b\x27\xdf\xfe\xe8\x5e\x7e\xbf\x33\xe6\x3e\xba\xc7\x00\x9c\xcf\xf0\x7b\x01\x5a\xa7\x19\x28\xe9\xec\xda\x3d\xc4\xed\xef\x52\x41\x7f\x55\xa3\xf9\xa2\xba\xda\xea\x32\x36\xa6\x7d\x0e\xa7\x56\xcb\xf0\x27\x44\x0f\xd0\x58\x10\x5a\xec\xe8\xda\xbe\x69\x13\xcf\xb2\xac\x9a\xc1\x6f\xf0\x23\x0b\xab\x12\x8a\x63\x5c\xef\x8e\x75\xe9\x10\xe2\xdc\x16\x31\x5e\x8e\x07\x01\x28\xe7\xcb\x21\x77\xd2\x72\x0e\x6f\xb4\x7f\x52\x23\xc7\xb6\xc0\xf5\x41\x3d\x12\x6d\x58\x4b\x75\xe2\x9a\x00\xd4\xa7\x78\xff\x38\x1a\x7d\xd5\xfc\x28\x5a\x2b\xf1\xd3\x2b\x46\x1c\xeb\x47\x42\x01\xb8\xfe\x2f\x37\x28\x26\xcd\x41\x83\x17\xba\x90\x1b\x6d\xb8\x18\xfe\xfa\x59\x7a\x24\x60\x9b\x58\x89\xb4\xaf\x5e\xd2\x0a\x7a\xa2\xbe\x28\x33\x10\xfb\xc6\xd3\xfa\x3a\xd9\xe2\x23\xfe\x57\x53\x5f\x68\xff\x1d\x9a\x67\x76\x6d\x87\xee\x44\xf4\xbf\x52\xc1\x2a\x2a\x41\x0f\x8b\x29\x5d\x7b\xa1\x32\x6e\x5f\x3d\x09\xf6\x8f\x4f\xe9\x07\x80\x44\xb7\x72\xcd\x5a\xec\xae\x4b\xbe\xf0\xc1\x87\x9d\xcf\x50\x08\xe8\xbf\x54\xa9\xfd\x0a\x88\xc2\xd8\x19\x36\x99\x3e\x6f\x8b\xff\x1e\x9e\x80\x14\x84\xbf\x77\x2f\x6a\x9b\xef\xd4\x61\x58\x38\xe4\xc5\xef\x2e\x8c\x89\x69\xbb\xe6\xf6\x99\x44\xb4\xab\xa0\x78\x57\xd3\x08\xb2\xa0\x87\x66\xda\x39\x71\x65\x45\x6a\xc0\x61\xa7\xa3\xca\x46\x0f\x9c\x13\xe1\xee\x98\xde\x9c\xb3\xa8\xe0\x79\xcc\xc5\x37\xb9\xd2\xb8\x92\xa3\xc7\xc3\x41\xaf\xab\xd2\x1c\x45\xb7\x91\xb3\x60\x03\x52\x4c\xc1\x1d\x0a\x2d\xc4\x31\xa7\xa6\xa4\x51\x1a\xe1\x86\xdc\xb0\xa7\x1e\x12\x37\xaa\xa9\x71\x6c\x04\x1f\x7d\x8b\xbe\xa9\x15\xf9\x28\xc9\x7b\xfd\xe4\x7f\xd4\xbf\xd3\xce\x7d\x73\x14\x4f\xf0\xe1\x99\xde\xfa\xa7\x1b\xda\x51\x57\xd1\x69\xf3\x57\x5d\xd5\xf9\x9f\xc7\x4b\xc8\x21\xc6\x8d\xbb\x4e\x46\x44\xd1\xdd\xbe\x82\x12\xcd\xac\xd4\x2b\xb2\x2a\xd7\xbf\xf9\x39\xbc\xdf\xa7\x41\x01\x07\x38\x86\xe6\xff\xa4\x2a\x7d\xd2\x2b\xb8\x81\x6d\xfc\xa8\xdb\xda\x80\x3d\x76\xed\x30\xbf\x4b\x3c\x4c\x35\xb9\x7c\x12\xb1\x07\xb7\xc2\xcb\x31\x70\xc1\x1e\xc9\x54\x69\x3b\xfb\xc1\x31\x8b\x54\x1c\x05\x8b\xf3\x1d\xb8\x8b\xeb\x69\xd9\x47\xec\x55\x4a\x4e\x97\xf1\xc4\x2c\xb6\x5a\xa5\xfd\xd0\x96\xf1\xfe\xce\xdc\x8b\x15\x73\xe4\x4f\xa1\x03\xe6\x7a\x8e\x5f\x98\xac\xc5\xa7\xaa\x81\xb3\x3d\x2b\x68\xa2\x91\xd7\x62\xa0\x1e\x6c\xdc\xa4\x9e\x32\xdb\xe9\x15\xa6\xba\x2a\x9b\xfa\xbb\x79\x3c\x7e\x9a\x80\x46\xfa\x38\x75\xde\xbc\x0a\x7b\x76\x13\x56\x42\xbb\x84\xdc\x94\x82\x16\xe2\xc7\x9e\x99\xab\x60\x71\x08\xe2\x12\xf6\x6d\xaa\x71\xdd\xf0\xa6\x95\xb8\x24\xf0\x8c\x3a\x25\xca\x19\x96\x6b\x33\xc2\x61\x29\x02\xb9\xfd\xd8\x8d\x6c\xf0\x4a\x7a\x82\x01\x9c\xfe\xd9\xed\xee\x0c\xd5\x1a\x50\xd8\xc1\x8e\x47\x1a\x78\xa4\x6c\xd4\xa9\x18\x3c\xcc\x6b\x75\xcb\x19\xc2\xe2\x24\x9f\x06\x5a\xbc\x03\xc1\xb1\x52\x49\x62\x7b\x79\x37\x1f\xca\x67\x85\xda\xae\x1d\xa9\x29\xfb\x75\x73\xf6\x5d\xc0\xb4\xcb\x5b\x61\x5b\xa9\x74\xef\x39\x74\x53\x00\xbf\x80\x4e\x4a\xee\x6a\x4e\xa2\x63\x0c\x5a\xe4\x93\xa5\x06\x0e\x05\x6c\xd7\x9e\xe8\x7c\x6a\x33\xe8\x3d\x51\xe6\x3b\x69\x38\xa2\x9a\xa1\xa0\x98\x27\x51\xf7\xc0\x32\x30\xf4\x88\x6e\x3a\x4b\xee\xa2\xce\xd2\x66\xd5\x64\x8c\xf2\xc2\x91\x7d\x44\x09\x36\xf2\x51\x9b\x7c\xe4\x95\x61\xb3\x1f\x90\x3e\x91\x2f\x32\x6f\x99\x5c\xb7\xe7\x9e\x03\x40\x25\x70\xca\x7e\xa2\x28\x1e\x51\x1e\xeb\xae\x84\xc9\x13\x77\xb9\x98\xe6\x7e\xae\xb4\x23\xef\xfb\x07\xd0\x1c\xe4\x87\xde\x7e\x6d\xf6\x16\xde\x3c\x8a\xe9\xae\x99\xbe\x79\x68\xfe\xc2\x95\x9c\xb9\x29\xf6\xb5\x78\x25\xd9\xdb\xf5\xdf\x7c\x97\x51\x15\xef\x8b\x15\xd5\x0d\xf2\xd4\xea\x26\x1e\xe9\xec\xbc\x30\xb9\x74\x49\xd3\xee\xdf\x35\x1e\x59\x8d\xbb\x71\x63\x48\x88\x68\xa8\x3d\x4d\x88\xb3\x8f\x21\x6f\x98\x8e\x97\x57\x2f\x7f\xc8\xec\xef\x79\xad\x7b\x21\xc0\x52\xf1\x29\x10\xd2\x2d\x29\x6a\x98\x92\x96\x12\x15\xb5\xd8\x62\xab\x8e\x78\x0a\x0f\x61\x27\xfa\xa5\xc2\x70\xaf\x1c\xa4\xab\x7f\x0d\x74\xb0\x61\x86\x70\x03\x0a\xe3\xa0\xa4\xfe\xdf\x6d\xe3\x6a\x85\x6b\xfe\x05\x7f\x2f\xe6\x6f\x5f\xcc\xc8\xaa\xa8\x21\x01\x00\x00\x98\x5d\x21\x5c\x2e\xb9\xe4\x42\xfd\xdf\x00\x00\x00\xff\xff\x2c\xa4\x27\x21\x79\x0a\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\xc6\x8b\x37\xd3\x8f\xc3\xc0\xf1\xcf\x66\x8d\x0d\xd9\x5c\xb7\x2e\xd8\x2a\x86\x2e\xee\xdd\x24\x5a\x85\xb0\xa4\x95\x7b\x14\xc9\xad\xdc\x1b\x8a\xcd\x92\xd6\x10\x4a\x08\xcd\x84\x72\x27\x7e\x98\x30\x53\xae\x5f\x84\xf5\x65\x2e\x95\x7b\xee\xd7\xf0\x30\x66\xcf\x79\xce\xf3\x4f\xfc\xde\xe7\xbc\xcf\x79\xbd\xb4\x22\x98\x48\xc2\x0f\xc0\x01\x00\x90\x34\x33\xbd\x6c\x0d\x00\x00\xe7\xff\x16\x13\x01\x00\xe0\x3e\x38\x43\x0f\x00\x80\x5c\x2b\x0b\xe2\x15\x13\x3f\x0b\xe1\x7f\x7d\x34\x55\x91\x2d\x00\x00\xf4\x83\xac\x09\x37\x01\x91\xff\x91\xd8\xd3\x98\x04\xed\x08\x16\x11\x6b\x33\x72\xfd\x8a\x1b\x9d\xc9\x1e\xd0\x51\xd2\x55\xb4\x5f\x4c\x34\x56\x2c\xc1\x2a\xbd\xb6\x95\x5c\x68\x7e\x6b\x98\x73\xf1\xac\xae\x4b\x2e\xd7\xa1\xac\x4a\xe5\x24\x63\xa5\x16\x43\x01\x00\x98\xa5\xd9\x65\x63\x62\xc8\xd0\xa2\xd3\x6c\xf2\x87\x3a\x79\x4b\x04\x46\x5b\x9d\x82\x15\x53\xc7\x9d\xe9\x5e\xdb\x8b\x7f\xdc\x9c\x58\xb2\x39\x0f\xc7\x00\xbf\xa7\xf6\x06\xbd\x83\xfd\xab\xce\x06\xbe\x96\x01\xe8\xcf\x48\xda\x1f\x0b\x0e\xcd\x64\x1b\x42\x32\x45\x07\x6c\x37\xf6\x1f\xf2\xfa\x4c\xbb\xd1\x9d\xe8\x1d\x91\xb6\x35\xfe\xb5\x17\xa2\xcf\x06\xf9\x3f\x99\x98\x59\xcd\x3c\xc2\x9e\x7d\xb5\x5e\xbc\x8e\x10\x5e\x33\xde\x7c\x62\xbe\x8a\xc7\x85\x58\xba\x0e\xdd\x1f\x57\xf2\x30\x1b\xd2\x24\x6e\x75\x5b\x85\xbf\x8b\x1b\xa1\x5c\x99\x3b\xe3\xc5\x1b\x46\x31\xcb\x51\x90\xaf\x0e\xab\xbe\x69\xff\xbe\x3f\xd0\x05\x75\x7e\xd0\x67\x73\x5e\x78\x62\xef\x2d\x55\xb3\xb4\x50\x2b\xf5\xe5\x5c\x36\x39\x86\x7c\x1a\xaa\x4a\x9c\x93\x47\x33\xb7\x32\x0b\x84\xd0\xf7\x5f\xd0\x22\x0b\x0e\x42\xa2\x80\xd4\x52\xd6\x80\xc2\x3b\x35\x91\xb2\x57\x63\xdb\xb1\x11\x87\x2a\x6c\x5c\x6d\xfb\x38\xa8\x90\xd2\x1a\x76\x5e\x84\x05\x25\x59\x56\xa2\x88\x1e\x87\x59\xe5\x07\xbe\x33\xdd\xad\xd5\xf2\x29\xd0\x85\x0e\x54\x5b\xc3\xa4\xd0\x8f\x12\xb3\xb4\xfb\x84\x5d\xf3\xae\x37\xf2\x8e\x5a\x52\xe3\xde\x68\x4d\x59\x59\x88\x5a\x8f\x26\x79\x4b\x58\x39\xde\x21\x36\xc8\xfd\xc0\x5b\xeb\x24\xb9\x4b\x58\xd9\x9d\x49\x6b\x6a\x09\x8a\x64\xcd\xf2\x6d\xa3\xb5\x52\x97\x5e\xd1\xe7\x1a\x5e\x4a\x1c\x43\x89\x2c\xeb\xc5\x85\x2b\x34\xef\x8b\x3c\x31\x80\x7a\x1d\xd5\x9a\x32\xc8\x32\xd5\x2c\x50\xac\xac\x1f\xaf\x63\x13\x8b\xb6\x6c\xe0\xcd\x97\xa3\x63\xe6\x32\x6f\x7d\x0c\x59\xf5\xd5\xc3\xdf\x93\xd0\xd5\x7b\x79\x3a\xbe\x85\x81\xf7\xf7\x74\x39\xd6\xf5\x39\xef\x86\x69\x1d\xfb\x54\x0a\x6e\xaf\xc2\x9d\xc9\xb5\xa4\x0a\xfc\xf2\xcc\x8a\x59\x41\x65\x12\x24\x2d\xe8\xa9\xd2\x25\x56\x6f\x68\xf4\x38\x9d\x03\x67\xd7\x7b\x71\x9e\xc2\xae\x57\x6b\xaf\xfa\xca\xb6\x79\x43\xef\x38\x12\xc0\x59\xd6\xab\xa5\xb2\xe7\x2a\x56\x69\xca\x96\xe3\x85\xd6\x02\x52\x22\x46\x0b\x5a\x51\x75\x47\xa9\x90\x9f\x24\x55\x7f\xac\x4a\x6b\x25\xee\x6d\xfd\xf2\x07\xd6\x6d\xd6\x01\x91\xdf\x86\x22\x3e\x9b\xe0\x2c\x39\x9e\x94\x82\xab\x98\xbf\x75\x6c\x1f\xf1\xde\x44\x18\x38\x1f\x7a\xd5\x11\xc5\x64\xb1\x7b\x7e\x8e\xc0\x62\xbd\x8f\x93\x3b\xf5\x1a\x20\x6c\x08\x1b\xb2\xb7\x0c\x9b\x71\x06\x3b\x83\x9d\xc1\xce\x60\x67\xf0\xff\x6b\x12\x12\xbd\x90\xc2\x49\xe1\xb4\x22\x8d\x58\x27\x3a\xd3\xa4\x13\xf6\xd7\x87\xce\x07\xc2\xfe\xe3\xfe\xbd\x40\x0b\x8e\xd0\x03\x1f\x23\x4d\xe5\x6c\xdb\x47\x72\x2a\x9e\x77\x1f\xe5\x04\xf0\xb9\x22\x1c\x83\xb8\x63\xf6\x90\x0c\x6d\x58\xd0\x9f\xab\x4a\xc0\xa8\xdd\x8b\xfd\x7a\x07\xfd\xae\xf9\x9c\xb8\x36\x7d\x8b\xbb\xe0\x73\x19\x74\xe5\x14\x51\x31\xf2\x36\xc9\x2f\xaa\xfd\x0e\xbd\x78\xbc\x24\xac\x58\x8c\xe9\x52\xbc\x02\x18\x91\x38\x77\xcf\xb3\xcf\x21\x67\x16\x8a\x2f\x87\x8f\x7a\x1d\x1f\x15\x7e\xe2\x23\x5c\xee\x0b\x20\x20\xe0\x4f\x40\x77\x93\x92\x29\x33\x77\x68\xb1\x70\x37\x39\x73\x36\x30\x18\xd3\xc3\x7d\xcc\xeb\xc2\x06\xca\xba\xed\xd8\x5b\x8a\x31\xb7\x67\xbe\xdc\x72\xbb\x6a\xdf\x12\x3f\x86\xc0\x45\xc4\xbc\xac\x79\xe7\x8b\x25\xf7\x33\xed\xc4\xc1\x5f\x59\x30\x5f\xfe\x1b\xbb\xbd\x73\x08\x97\x1a\x63\x61\xd5\x3b\x45\xe8\x60\x03\x51\x04\xac\x83\xc7\xa4\x34\x84\x81\x8b\xbc\x68\xa7\xb7\x8b\xcf\xb6\x93\x0a\x54\x7a\xac\x79\xa7\xb7\xc7\xbe\xaa\xf2\x2d\x9a\x21\xb6\xc1\xe1\xe7\x35\x38\xa5\x9b\x6e\xce\x8d\x18\x4d\xb5\x58\x23\xef\xda\xda\x0e\xf2\x4f\x4d\xff\x5d\x7f\x0d\x8e\x57\xaf\x20\x07\xef\xac\x9a\xd4\xc0\x95\x73\xa9\xec\x75\x8a\xf0\xc7\x36\x65\x9a\x50\x7e\x19\x46\x08\xa3\x7b\x4f\x94\x7f\x6e\x56\xc0\x8f\x7a\x36\x30\x3f\xdf\x1e\x64\xf5\xc8\xb9\xd0\x95\x35\x75\x96\xd6\xd2\x7b\xf5\x4d\x26\xf2\xb5\xc1\x4e\x0e\x75\xa7\x56\xb8\xdd\x3f\xce\x14\xd2\x29\x3f\x6b\x3b\xa4\x64\xeb\x65\xc8\x8a\xa0\x46\xcf\x11\x21\xcb\x23\x70\x75\x1a\xe9\xa2\xdf\xb5\xd3\x2c\x1d\x98\xd3\x92\x69\x02\x6c\x77\xb4\xfa\xb7\xff\x31\xcc\x8c\x71\xad\x45\x41\xc2\x92\x60\x65\xf6\x5b\x67\x82\x87\x49\x39\x19\x64\x8b\x0b\x7b\x04\x4e\xf2\x8c\xb4\x15\x2f\x3e\xfb\x35\xa3\x71\x4a\xa0\x55\x85\xfa\x24\x2f\x99\xaf\x2c\xda\x74\x6a\xba\xa6\x5a\x91\x3a\x02\x5b\xf1\x8e\xfc\xb8\x7b\x58\x30\x46\x31\xda\xc9\x70\x3e\x3b\x8d\xd2\x28\x3b\x82\xe1\x88\x39\xda\x44\x04\x5e\x78\xf5\x8e\xa1\xac\xb7\x8f\xee\xda\xef\x2b\x24\x28\xbf\x86\x0d\x75\x3e\x70\x95\x5d\xca\xa0\x6c\x17\x82\xd6\x83\x8c\xfc\x90\x93\x23\xb5\x5e\x87\xf9\x0d\x32\x62\x3a\x32\x09\xbb\xc1\x23\x51\x88\xb0\x8e\x2e\xa9\x9d\xd9\x25\x46\xeb\xde\xce\x03\xb8\xd9\x3d\x6f\xba\x31\xe7\x9f\x2f\x5c\x6a\xe0\x9a\xa2\x4d\xec\x86\xae\xc8\xed\x03\xb8\xf8\x86\x21\x26\xd3\x48\x01\x7c\x70\x8a\x04\x8e\x89\x1d\xfc\x62\x10\x32\x3b\x95\x59\x89\xad\x08\x0d\x7b\x18\x91\xd5\x3b\xb0\x7b\xd9\x75\x95\x04\xfe\x64\xd7\x6f\xa0\x1a\x0c\x92\x2b\xd9\xb5\xa9\xb6\xac\x60\x28\xc8\x0f\xb9\xaa\x8f\x21\x25\x35\x4f\x22\x58\xc3\x16\xe4\x35\x3f\xfe\x5f\x7e\x13\x3f\x5f\x3d\x43\xb0\x6b\xff\x2d\x39\xff\xcc\x7d\x37\xd7\x9f\x63\x33\x3b\x76\xe7\x92\xbb\x09\x7d\x7f\xab\x19\xbf\xe3\x6e\x05\x30\xa0\xa2\x0a\x07\xde\xe7\x61\x8b\xb5\x1e\xf9\xe1\xd2\x63\x4f\x3c\xd9\x91\x82\xfe\x09\x87\x71\xcd\xf1\x47\xa2\x7e\xd5\xd9\xd3\x8c\x8d\x82\xca\xc3\xe3\x04\x8d\x9c\xfa\xfb\x19\x5e\x6e\xdc\xdb\xcc\x33\xfd\xf2\x28\xc8\x81\xfa\xa2\x76\x2b\x9e\x69\x7a\x32\xcf\xdc\xeb\xe4\x5d\xec\x47\x59\x5a\xff\xef\x9a\xf8\x44\x4b\x91\xc0\xf7\x67\x53\x52\x8b\x6f\x4a\x6c\x4d\xe9\xdf\x60\xf2\x3e\xfd\x94\xac\x84\x84\xd3\x13\xcd\x00\x94\xbb\x0a\xe7\x91\x89\xda\x32\x73\x06\x47\x10\x3c\xab\xf9\xe3\xd1\xae\x34\x6f\x7b\xba\xde\x66\x70\x87\xe0\x41\x0e\xdb\x27\x12\xa1\x2a\x5e\xcf\x4b\xb9\x6d\x58\xf9\x4d\xc1\x83\x1a\x2c\xb3\x64\x9a\x50\x65\x4e\x50\x6f\xa9\x7d\x31\x5b\xe8\x27\xad\xba\x3e\x27\x8e\x6e\x8d\x01\x33\x13\x72\x9c\x2a\x6c\x86\xa0\x16\x3b\x72\xd4\xac\xca\x5c\x15\x72\x9d\x93\x0b\x72\xc5\x34\x2d\xc6\xb3\xf0\x79\x49\xaa\xb6\x18\xfd\x58\xd5\xf2\x3d\xa4\xb2\x86\x27\xd7\x4b\x58\x2e\x1a\xc8\xcb\x55\x25\xcf\xc7\x9e\x2d\xbe\x6b\x5c\x3e\x57\xe4\x55\xff\xb9\x6c\x82\x71\xaf\xd0\x76\x6e\xcc\x49\xfd\x4f\xbc\x21\x0c\x94\xa3\xe1\x4b\xc5\x76\x81\xd3\x0f\xde\xfd\x8d\x93\x6f\x7f\x74\x2f\xbf\xdf\x19\x73\x1f\xdd\x63\x00\xce\x67\xf8\xbd\x00\xad\xd3\x0c\x94\x74\x76\xed\x1e\xe2\xf6\x77\xa9\xa0\xbf\xaa\xd1\x7c\x51\x5d\x6d\x75\x19\x1b\xd3\x3e\x87\x53\xab\x65\xf8\x13\xa2\x07\x68\x2c\x08\x2d\x76\x74\x6d\xdf\xb4\x89\x67\x59\x56\xcd\xe0\x37\xf8\x91\x85\x55\x09\xc5\x31\xae\x77\xc7\xba\x74\x08\x71\x6e\x8b\x18\x2f\xc7\x83\x00\x94\xf3\xe5\x90\x3b\x69\x39\x87\x37\xda\x3f\xa9\x91\x63\x5b\xe0\xfa\xa0\x1e\x89\x36\xac\xa5\x3a\x71\x4d\x00\xea\x53\xbc\x7f\x1c\x8d\xbe\x6a\x7e\x14\xad\x95\xf8\xe9\x15\x23\x8e\xf5\x23\xa1\x00\x5c\xff\x97\x1b\x14\x93\xe6\xa0\xc1\x0b\x5d\xc8\x8d\x36\x5c\x0c\x7f\xfd\x2c\x3d\x12\xb0\x4d\xac\x44\xda\x57\x2f\x69\x05\x3d\x51\x5f\x94\x19\x88\x7d\xe3\x69\x7d\x9d\x6c\xf1\x11\xff\xab\xa9\x2f\xb4\xff\x0e\xcd\x33\xbb\xb6\x43\x77\x22\xfa\x5f\xa9\x60\x15\x95\xa0\x87\xc5\x94\xae\xbd\x50\x19\xb7\xaf\x9e\x04\xfb\xc7\xa7\xf4\x03\x40\xa2\x5b\xb9\x66\x2d\x76\xd7\x25\x5f\xf8\xe0\xc3\xce\x67\x28\x04\xf4\x5f\xaa\xd4\x7e\x05\x44\x61\xec\x0c\x9b\x4c\x9f\xb7\xc5\x7f\x0f\x4f\x40\x0a\xc2\xdf\xbb\x17\xb5\xcd\x77\xea\x30\x2c\x1c\xf2\xe2\x77\x17\xc6\xc4\xb4\x5d\x73\xfb\x4c\x22\xda\x55\x50\xbc\xab\x69\x04\x59\xd0\x43\x33\xed\x9c\xb8\xb2\x22\x35\xe0\xb0\xd3\x51\x65\xa3\x07\xce\x89\x70\x77\x4c\x6f\xce\x59\x54\xf0\x3c\xe6\xe2\x9b\x5c\x69\x5c\xc9\xd1\xe3\xe1\xa0\xd7\x55\x69\x8e\xa2\xdb\xc8\x59\xb0\x01\x29\xa6\xe0\x0e\x85\x16\xe2\x98\x53\x53\xd2\x28\x8d\x70\x43\x6e\xd8\x53\x0f\x89\x1b\xd5\xd4\x38\x36\x82\x8f\xbe\x45\xdf\xd4\x8a\x7c\x94\xe4\xbd\x7e\xf2\x3f\xea\xdf\x69\xe7\xbe\x39\x8a\x27\xf8\xf0\x4c\x6f\xfd\xd3\x0d\xed\xa8\xab\xe8\xb4\xf9\xab\xae\xea\xfc\xcf\xe3\x25\xe4\x10\xe3\xc6\x5d\x27\x23\xa2\xe8\x6e\x5f\x41\x89\x66\x56\xea\x15\x59\x95\xeb\xdf\xfc\x1c\xde\xef\xd3\xa0\x80\x03\x1c\x43\xf3\x7f\x52\x95\x3e\xe9\x15\xdc\xc0\x36\x7e\xd4\x6d\x6d\xc0\x1e\xbb\x76\x98\xdf\x25\x1e\xa6\x9a\x5c\x3e\x89\xd8\x83\x5b\xe1\xe5\x18\xb8\x60\x8f\x64\xaa\xb4\x9d\xfd\xe0\x98\x45\x2a\x8e\x82\xc5\xf9\x0e\xdc\xc5\xf5\xb4\xec\x23\xf6\x2a\x25\xa7\xcb\x78\x62\x16\x5b\xad\xd2\x7e\x68\xcb\x78\x7f\x67\xee\xc5\x8a\x39\xf2\xa7\xd0\x01\x73\x3d\xc7\x2f\x4c\xd6\xe2\x53\xd5\xc0\xd9\x9e\x15\x34\xd1\xc8\x6b\x31\x50\x0f\x36\x6e\x52\x4f\x99\xed\xf4\x0a\x53\x5d\x95\x4d\xfd\xdd\x3c\x1e\x3f\x4d\x40\x23\x7d\x9c\x3a\x6f\x5e\x85\x3d\xbb\x09\x2b\xa1\x5d\x42\x6e\x4a\x41\x0b\xf1\x63\xcf\xcc\x55\xb0\x38\x04\x71\x09\xfb\x36\xd5\xb8\x6e\x78\xd3\x4a\x5c\x12\x78\x46\x9d\x12\xe5\x0c\xcb\xb5\x19\xe1\xb0\x14\x81\xdc\x7e\xec\x46\x36\x78\x25\x3d\xc1\x00\x4e\xff\xec\x76\x77\x86\x6a\x0d\x28\xec\x60\xc7\x23\x0d\x3c\x52\x36\xea\x54\x0c\x1e\xe6\xb5\xba\xe5\x0c\x61\x71\x92\x4f\x03\x2d\xde\x81\xe0\x58\xa9\x24\xb1\xbd\xbc\x9b\x0f\xe5\xb3\x42\x6d\xd7\x8e\xd4\x94\xfd\xba\x39\xfb\x2e\x60\xda\xe5\xad\xb0\xad\x54\xba\xf7\x1c\xba\x29\x80\x5f\x40\x27\x25\x77\x35\x27\xd1\x31\x06\x2d\xf2\xc9\x52\x03\x87\x02\xb6\x6b\x4f\x74\x3e\xb5\x19\xf4\x9e\x28\xf3\x9d\x34\x1c\x51\xcd\x50\x50\xcc\x93\xa8\x7b\x60\x19\x18\x7a\x44\x37\x9d\x25\x77\x51\x67\x69\xb3\x6a\x32\x46\x79\xe1\xc8\x3e\xa2\x04\x1b\xf9\xa8\x4d\x3e\xf2\xca\xb0\xd9\x0f\x48\x9f\xc8\x17\x99\xb7\x4c\xae\xdb\x73\xcf\x01\xa0\x12\x38\x65\x3f\x51\x14\x8f\x28\x8f\x75\x57\xc2\xe4\x89\xbb\x5c\x4c\x73\x3f\x57\xda\x91\xf7\xfd\x03\x68\x0e\xf2\x43\x6f\xbf\x36\x7b\x0b\x6f\x1e\xc5\x74\xd7\x4c\xdf\x3c\x34\x7f\xe1\x4a\xce\xdc\x14\xfb\x5a\xbc\x92\xec\xed\xfa\x6f\xbe\xcb\xa8\x8a\xf7\xc5\x8a\xea\x06\x79\x6a\x75\x13\x8f\x74\x76\x5e\x98\x5c\xba\xa4\x69\xf7\xef\x1a\x8f\xac\xc6\xdd\xb8\x31\x24\x44\x34\xd4\x9e\x26\xc4\xd9\xc7\x90\x37\x4c\xc7\xcb\xab\x97\x3f\x64\xf6\xf7\xbc\xd6\xbd\x10\x60\xa9\xf8\x14\x08\xe9\x96\x14\x35\x4c\x49\x4b\x89\x8a\x5a\x6c\xb1\x55\x47\x3c\x85\x87\xb0\x13\xfd\x52\x61\xb8\x57\x0e\xd2\xd5\xbf\x06\x3a\xd8\x30\x43\xb8\x01\x85\x71\x50\x52\xff\xef\xb6\x71\xb5\xc2\x35\xff\x82\xbf\x17\xf3\xb7\x2f\x66\x64\x55\xd4\x90\x00\x00\x00\xcc\xae\x10\x2e\x97\x5c\x72\xa1\xfe\xef\x00\x2c\xa4\x27\x21\x79\x0a\x00\x00"),
 		},
-		"/assets/protego-logo.png": &vfsgen۰CompressedFileInfo{
-			name:             "protego-logo.png",
-			modTime:          time.Date(1970, 1, 1, 0, 0, 1, 0, time.UTC),
-			uncompressedSize: 6744,
-
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x98\xd7\x53\x13\x5a\xd8\xaf\x23\x84\x1e\x7a\xef\x2a\xbd\xb7\xd0\x21\x48\x11\x04\x0c\x3d\x88\x82\x94\xd0\x25\x4a\x28\x4a\x10\x50\x42\x24\x48\x11\x44\x05\xa4\x44\x08\x7d\x4b\x91\xa0\x06\xd0\x88\xa1\x04\x94\x2a\xb0\xc1\x50\x4d\x40\x09\x28\x25\x6c\x14\x82\x20\xfb\x0c\xfb\xcc\x9c\x73\xf3\xfd\x01\xdf\xba\xfa\xcd\x3c\xcf\xfb\xae\x59\x33\x6b\xad\x8b\x37\xdb\xc3\xcd\x89\x9f\x57\x86\x17\x00\x00\xf0\x3b\x5f\x70\xf0\x02\x00\x4e\x39\x03\x00\xa7\x1e\x72\xb3\x03\x00\x00\xb1\x70\x78\x1a\x00\x00\xa8\xf6\xb8\xe8\x73\xde\x09\x79\xf1\xdf\xff\xdd\xcb\xda\x72\x43\x0b\x00\x00\x18\x27\x7a\xb9\x79\x03\xd8\xe5\x8f\x8e\xdb\x79\x4f\x1d\xfc\xda\xd9\xfc\x36\xcf\x39\x2c\x29\xa0\x7e\xb6\x63\x7a\x5d\xe4\x36\x6d\xc0\xc1\x45\xd7\xfa\xaf\xac\x1e\x0f\x92\x51\xba\x59\xfe\x8d\x27\xd1\xcf\x6a\xfc\x3f\x25\x04\xf9\xb6\x96\x86\xc5\x1c\x7c\x62\x00\x00\xd2\xfa\xce\x0e\xb6\x3e\x52\xd2\x9b\x3d\x59\x19\xe5\x16\x12\x80\x4f\x5a\x0f\xef\x39\x6c\x4f\x9b\x0c\xbc\xdb\x3f\xfc\xf7\x3d\xf1\x85\x8a\x65\x69\xb1\x33\x3c\xb4\xcd\x9c\x4b\xe6\x01\x73\xf6\x9a\xdf\x6a\xb1\xb8\xee\x94\x1d\x01\xbf\xeb\xdf\x69\x69\x59\xff\xe3\x5a\xa4\xb4\x57\x84\x67\xa0\x80\x20\x7b\xa6\x3d\x43\x72\xf6\xd9\xfc\x76\x9d\x9a\xeb\xe0\x7b\x8f\xa3\x5c\xfd\x3e\xfa\xad\x65\x55\x93\xc4\x3b\xa7\x3c\x39\x73\x13\x6e\x50\xbc\x28\xb1\x8d\xfa\xed\x8b\x11\x5f\xf8\xaa\x76\x61\xc4\x79\x3d\x89\xe1\x4f\x22\x62\x41\xf8\xcb\xaf\x97\xf6\x55\x79\xd5\x3e\x1d\x64\xd4\x5e\xb8\xec\xa8\xd1\x8d\xf1\xba\x96\x9f\x65\xfd\x19\x3b\xc6\x00\xfb\x4b\xcb\x61\xd5\xd4\x1e\xb3\x84\xa3\xb7\xa8\xa9\x30\xe0\x33\x4e\x9c\xa0\x90\x25\x8f\xea\x60\x48\x14\xcf\x91\x98\xf8\x2a\x2a\x59\x1a\xa8\xab\xf6\x29\x59\xf0\xd2\xed\xe8\xf5\xa7\xd8\x2a\x96\xb9\x66\xa2\x11\x7c\xaa\x70\x1c\xbf\x8b\x6d\x18\xc9\x5f\x7f\x33\xa4\x7c\x87\x47\x3c\x2e\xd0\xad\x03\x43\xc6\x8c\xf1\xdd\xa3\x1e\x47\x99\xcd\xc0\x8e\x54\xda\xcb\x14\xd3\xca\x85\xd6\x8e\xb2\x58\xef\x28\xc9\x24\x23\x0e\x4a\xd0\xd9\xe5\x46\x8b\x6d\xa7\x6e\x68\x15\xfb\xbd\xb7\xc7\x88\xd7\xcd\x17\x8f\xac\x23\xee\x04\x82\x52\x4b\xe6\x99\x1b\x28\xfd\xb2\xf6\x0f\x0f\xd9\xee\x16\x3c\xc4\x1a\x3f\x8b\xfd\x03\x7c\x26\xbd\x93\xd1\x13\x72\x65\xb5\xde\x21\xe7\xbd\xbb\xcc\x58\x74\xb7\xe6\xd1\x3d\xb2\xf7\x59\x93\xa4\xc3\xf9\x64\x85\x26\x16\xc3\x67\xbd\x42\xef\xe5\xbd\x79\xe0\x33\xf7\xe5\x82\x49\xc3\x0e\x90\xed\xce\xb8\xe7\x74\x5b\xc5\xd4\x4f\x33\x67\xda\x47\x94\xcb\xdd\x27\xad\x09\x57\xcf\xe7\x7c\xb6\xbb\x19\xf9\x38\x5f\xd5\xd6\x0e\x8c\x52\xf8\xb3\x99\x8a\x58\xcb\x58\x36\xf6\xc4\x0f\x4e\xff\x33\xc9\x00\x3e\xcb\xd8\x3c\x78\x37\x5c\xb1\x09\x77\xc8\xf9\x5c\x3f\xe0\xd9\x19\xf0\xf3\x77\x36\xbf\x60\x3e\xd2\xbd\xb4\x65\xc6\x92\x3d\x76\xf0\xbe\x49\xae\x2d\x2d\x8e\x4d\x30\xaf\xf3\xf1\x26\xf3\x6b\xd8\x1b\xf2\xbc\xf0\x6d\x22\x1f\x23\xab\x94\xaf\x60\xc8\x2d\x63\xf6\xd6\x95\x2d\x10\xba\xa3\x3e\x67\x0d\x6c\x35\x72\x74\x8f\xac\xe4\xa2\xda\x74\xb3\x96\x0f\x46\x99\xf7\x86\x9d\xa9\x19\x85\x0f\xd0\xe9\x9d\x8f\x3d\xdf\x48\x3b\x59\x50\xe6\xd6\x7c\x5f\x3b\x17\x18\x95\x44\x31\x4f\x83\x7b\xaf\xd6\x51\x4b\x2c\xd1\xaf\xab\x9f\x0c\x35\x5e\xcc\x25\xff\xba\xcf\x59\x40\xde\x8b\x28\x49\x8e\x2a\xb5\xa0\xbc\xf9\xcb\x90\x38\x30\x3c\x4d\x7e\x8d\xff\xd5\xf9\x43\xb2\xc4\x3c\x17\x44\xe2\x73\x81\x2d\xf2\x32\x9a\x02\xbf\x7c\xfb\x74\x63\x2b\x38\x28\xc2\xa2\x4e\x6c\xdb\xb1\x6e\xd2\x6a\x93\x4b\xf7\x55\x49\x0b\x88\xd8\xe7\x7d\xc3\x59\x7c\xdb\xb5\xe8\xf2\x18\x36\x0b\x6d\x57\x95\xb9\xe1\xb0\xae\xd8\xa0\xe6\x5a\x47\x05\x49\xc8\x2a\xa4\xa9\x10\x8c\xa3\xa4\x3b\xa8\x2f\x70\x23\x42\x62\xb2\x76\x32\x97\xbb\x97\x24\x75\xd1\x7b\xa7\x89\x86\xeb\x3a\x63\xa1\xae\x67\xbd\x10\x42\xd1\x0f\xc6\x73\x27\x1c\xe8\xc0\xb9\x73\x4a\x0b\x3a\xf5\x21\xf5\x70\x51\x1e\xa3\x8a\x3d\x31\x37\x70\x94\x95\x9e\xba\x6f\x02\xd1\x48\x85\xa2\x5a\x3d\xb9\x90\x39\x0c\x13\x46\x46\x81\xb7\x38\x31\xc3\x56\x60\x15\x2f\x84\x6e\x48\x3e\xa3\xc4\x96\x16\x1f\xa5\x2d\xf2\x73\xd2\x5b\xe2\x47\x06\xfc\xb1\x27\x7e\x70\x46\x63\x93\xe3\xd3\x45\x2b\x29\xb9\x86\xf2\x85\x5a\x31\xff\xf4\x88\x64\x73\x6b\x01\x36\xb7\x2a\x4f\x30\x3c\xe1\x9b\x99\x5c\xf9\x7d\xb1\xf9\x8c\xb2\x69\x66\x23\xca\x64\xa2\xca\x80\x7b\x3f\xe5\x70\xd7\x2c\x47\x85\xc8\x5d\x14\x07\x86\x6c\xa7\x74\x37\xd8\x2a\x09\xb5\xfe\xcc\xa5\x74\x19\x72\x10\x0b\xf4\x9b\x76\xab\x3f\x5f\xb2\x62\xdb\x67\x1c\x0c\xd2\xa9\x93\x75\x2e\x84\xb4\x46\xcd\x07\x08\x2b\xab\xdf\x72\x5b\xda\x3b\xb9\xd3\x47\x5f\xfb\xd9\xfc\x73\x73\xa8\xe6\xa3\x7a\x99\x95\xe4\x77\x51\xc4\x92\x11\xdd\xe1\x80\xe0\xc3\x8f\xa9\xc9\xa5\xca\x0b\x33\x34\x85\x8d\xe3\xb8\x2b\x16\xc5\xb6\x84\x3f\x3e\x7d\xf1\x26\xeb\x84\x58\xd1\xc6\xd2\x4d\x8e\xc7\x81\x6f\xe2\xe0\xa9\x72\x71\xdd\xce\x29\x8f\xc9\x2f\xf1\x8e\x65\x9f\x6d\xb4\x13\xbc\x79\x73\x0a\xec\xc5\x0d\x87\x4b\xb8\x6c\xd0\x6f\x84\x3a\xbe\xcc\xeb\xf5\x7d\x10\x62\x45\xdd\x23\xf0\x8d\x1f\x06\x7e\x51\x41\x26\xdc\x53\xb3\x25\xf8\x6d\x27\x8a\x6b\x40\xce\xc2\x2c\x7e\x9c\xc7\x2b\x57\x90\xaf\x77\x3d\xee\x4b\x95\x2b\x7d\xe3\xb2\x63\xe0\x09\x33\xad\x41\x76\xe2\x94\x91\xf6\xd9\x36\x66\x20\x77\xcb\xec\x02\xb7\x2f\x45\x7d\x9b\x64\xf7\xfb\x9c\xa6\x78\xa8\x6f\x39\xce\x03\x96\x8a\x7a\xf2\x7c\xf2\xf2\x8b\xdf\x26\xe8\xa6\x97\x8b\xb3\x06\x57\x2f\x15\xa3\xdd\xed\x8c\x9b\xaf\xe1\x14\xd4\xb2\xf9\x3f\xd7\xfc\xf7\x98\xc3\x6b\x57\x44\xf1\x4f\x64\x3c\x4b\x25\xd2\x3e\xbf\xa4\xbb\xdc\x37\xf4\xc3\x25\x68\x15\x6b\x42\x24\x61\x03\xbe\xdd\xa4\x91\x80\x4e\xce\xad\xbd\x94\xdc\xf0\x28\x67\xf1\x4a\x21\x58\x78\xff\x6a\x53\xf5\xf6\x1c\x33\x12\xbc\x2c\xb1\xfe\xd1\xa1\x56\xac\xb2\x04\xb6\xc5\xa5\x30\x41\xdb\x8f\x1e\xa2\xe9\x87\xb9\xa9\xee\x74\xad\x5f\x0e\x74\xeb\x30\x2a\x21\x6f\x9f\xbe\x25\xf2\xb3\xc0\x3e\x82\x67\xe3\xf4\xd0\x1a\x58\xfe\x57\x51\xbf\x2a\xb3\xa9\xd3\x7f\xc3\xb4\xeb\x2f\xbe\x1c\x79\x07\x4d\x7e\xa3\x20\x3d\xde\xcf\xd8\xa0\x65\x8d\x97\xa1\x6f\x5a\x0d\xe5\x0b\xe1\x8c\x20\x9f\x8e\x01\xc6\x9f\xa8\xe5\x78\x96\xcc\x03\xea\xc2\x36\xd4\xde\x3e\x98\x61\x1c\xd2\xfe\x8a\xbb\x13\x4a\xe3\xdb\xa8\xf2\xab\x68\xc2\x69\xf8\x6c\x90\xea\x5d\x12\x4c\x03\x5b\x73\xd2\xc9\x0c\xf0\xd6\x6f\xcc\x74\x3c\x02\xce\x08\xbd\x6c\xf6\x7e\x84\xed\xf3\x0c\x2d\xd4\x41\x1a\x5a\x1a\x7e\x01\x8d\x2b\xe0\x89\xde\x18\x7a\xc5\x10\x41\x55\x8e\x62\x7a\x6e\x5d\x59\xad\x27\x8f\x8d\x7f\x68\x9e\xab\x9d\xb7\x25\xf3\x90\xec\x10\x5b\x7b\x1d\xbb\x43\x3e\xc2\x1a\xb7\xba\xcf\x85\xd1\x91\x5f\xd5\x16\x54\x99\x5e\xcb\x05\x93\xed\xed\x20\x67\xc2\xae\xb1\xcf\x80\xe7\xeb\xa5\x7d\x36\xc1\xee\x09\xab\x3a\x1b\x97\xcf\x5d\x67\xda\x76\x63\xee\x99\x43\xf4\x6e\xa7\x65\xf6\xd9\x80\xef\x5a\xbe\x48\xbc\xea\x91\x83\x0b\xb2\x87\x9d\x59\x41\x9a\xf1\xd2\x62\x5d\x62\x3f\xdc\x32\xb8\x75\x47\xb0\xae\xbe\xaf\xde\x5a\xf2\xf4\x3a\x4e\x67\xe3\xa8\x34\xea\x2a\x0a\x49\x0d\x13\xb1\xbc\xeb\x78\x65\x58\xb8\x42\x67\xe3\xc8\x80\x50\x9e\x61\x4f\xd8\x65\xfc\x2d\x6a\x46\xed\xe2\xe4\x14\xb8\xd5\x8a\x46\x63\x70\x31\xc1\x34\x8f\x8d\x80\x53\xec\x59\x70\x06\xaf\x94\x99\x98\xe5\x25\x32\x19\x4f\xed\x62\xe3\x14\xa0\x8c\x89\xeb\x23\x7d\x4e\x90\xd3\xff\x8b\x98\x80\x93\xf8\x01\xf6\xff\x23\xc7\xad\xd6\x74\x5b\xdd\xb6\x04\x0e\x50\x3c\x1a\xa7\x15\xfc\x90\xef\x3f\x14\xcc\xe0\x95\xfa\xca\x66\x79\x89\x7c\x5a\xf2\xa4\x57\x11\x79\x4c\x5c\xbf\x11\x78\xa2\xaa\x9c\xa8\x9a\x27\xaa\x87\xf3\x89\xea\x72\xb2\xad\x8f\x88\xe5\x25\x72\xd5\xa3\xff\xd4\xfe\x13\x55\xf9\x56\x6b\x7a\x8f\xdf\x09\xca\x53\xf9\x1f\xe3\xcd\x93\x5a\x42\x3e\x4e\x2b\x58\x7f\x86\x90\xc0\x01\x1a\xb0\xf8\x8f\x0d\x8e\x89\xeb\x73\xff\xc7\x3c\x06\x23\x95\x94\x29\x4d\xa1\x42\xdf\x59\x88\xea\x80\xc1\xff\xc2\xc5\x46\x30\xee\x20\xb1\x82\xfe\xda\x80\xfb\x66\xaa\xea\x59\x81\x7d\xd1\xf0\x94\xde\x98\x13\xe2\x30\x72\x2d\x79\x4d\x6d\x21\xe8\x01\x94\xa4\xcb\x89\xcf\x1f\xcf\x18\x32\xab\x90\xd0\x3d\xaa\x7b\xdf\xa8\x4c\x89\x81\x0b\x7d\x67\x29\x37\x2e\xe0\x0a\x6c\xe1\x7d\x41\xa4\xe0\x32\x57\x92\x2a\x27\x1e\x3d\x6e\xdb\xd1\x4b\x57\x4f\xbf\x2b\x1c\x6b\x50\x8c\x82\x82\xec\xd8\x2b\x31\xb0\x1b\x69\xfe\x98\xbb\x71\x49\xdf\x2f\xfc\x67\xb8\x9f\x33\xee\x7b\xc5\x6d\xc5\x7f\x1c\xa2\x55\x93\xac\xd0\xf4\x9f\xa4\xf8\xec\x93\x0e\x71\x0d\xc3\xf9\xef\x72\xcf\x1a\xc2\x85\xa4\xcb\x39\x42\xce\x8a\xf4\x9d\x7a\xd6\x4b\x2f\x3f\xbe\x86\xe0\xcd\xf9\x4c\x49\x97\x66\x51\x1b\xf3\x4c\x9a\xd0\xae\xb4\xa0\x2b\x86\xb3\x46\xec\x2f\xf2\x4f\x19\x31\x09\x13\x1b\x0b\x98\x6d\xb0\x61\xa9\x05\x8a\x1d\xf4\x14\x5d\x70\xb1\x68\x25\x1a\x4f\x36\xed\x97\x1f\x4d\xe2\x5c\x02\xb0\x47\xd0\x56\xbc\xc2\x7d\xeb\x8c\x04\xfe\x3d\x63\x25\xd5\xa2\x44\xe2\xe1\x1c\x21\x0f\xaa\xb4\xfc\x9a\x60\x00\x8f\xfb\x8a\xc4\x87\x95\x97\x80\xec\x11\xb4\x3e\xc3\x26\xba\x3a\x8d\xa9\xf8\x68\x64\x4f\x5b\x14\x25\x0b\xf2\xcb\x13\x74\x29\x5a\xf9\x9b\xc1\xe4\xdf\x51\x8d\xae\x5d\x69\xd1\x0f\x36\x80\xa8\xc7\x24\x30\xad\xd9\xbe\xa8\xbf\xab\xab\xe1\x23\x89\x73\x6a\x0f\x38\x29\xff\x8c\x4a\x03\xd1\x2c\x5b\xf3\x8a\x8b\xc9\xf3\x21\xc8\x9c\x1d\xe1\xf5\xb1\x57\x4c\x04\xcb\xcd\xbc\x18\x5b\x85\xb2\x04\xb9\x19\x40\x14\x16\x35\xf7\xd9\x25\xbb\x5f\x1e\x96\x4c\xf6\x96\xc3\x91\x39\x3b\x13\x1e\x21\x45\xfe\x14\xec\x76\x67\x3b\x5c\x24\x36\xb7\x47\x63\xc0\x49\x09\x47\xbf\x48\x07\x76\x97\xd7\x86\x7a\x53\xe6\x4f\x94\x9e\xfe\xb1\xb6\xd3\xcc\xbb\x4b\xca\x2d\x70\x92\x31\x67\x83\x94\xe2\xc5\x67\x7b\xd7\xf9\xbb\xa7\x8e\xba\x94\xd4\x97\x64\x30\xde\x79\x82\x2a\x02\xfb\xa2\x82\xc7\xe3\xba\xd8\x7c\x74\xc7\x60\x55\xde\x0e\xba\x71\x00\x35\x9d\x25\x89\xd2\x2f\x00\xce\xa4\xf7\x68\x0c\x0c\xda\x3e\x18\x3d\xc3\xa4\x65\xf6\x2c\x14\x8a\xc5\x90\xab\xc0\x3b\x9e\x8d\xf0\x0e\x08\x27\xf0\x78\xbc\xfc\x62\x92\xc5\x4c\x86\x6d\xf4\x72\x8d\xd0\x8e\x3e\x93\x9b\x24\x34\x22\xef\x42\x90\x00\xb9\x88\x2a\xb2\x1b\x3f\xa7\xe4\x89\xb2\x6c\xae\x3f\x5c\xe5\x9c\xb9\x67\x1b\xbd\x6c\x2f\x14\x57\xa4\xca\x14\xb9\x34\x19\x00\xbf\x2a\xf5\x44\x15\xc2\x56\x86\x23\xe6\xf0\xeb\x41\x27\x5d\x2e\x11\x74\x4f\x2a\xd2\xf3\xc6\xe6\x01\x81\xcb\x7f\xd2\x14\x42\xd3\xa4\x9e\xa8\x12\x15\x47\x57\x39\x8e\xce\x79\x4f\xf2\xfe\xe8\xaf\x02\x13\x7e\x35\x1a\xe9\x05\xb0\x7a\xc2\x0c\xf2\x09\x6e\x20\x17\xd1\x79\xb7\x8e\xd5\x4c\x2e\xbd\xb8\x70\x61\x19\x3f\x2d\xce\x70\xec\x75\xc6\x45\x2e\x05\x74\xc9\x11\xac\xb1\xc9\x4f\x8b\xb3\x2e\x7b\xb3\xde\xb2\x0f\xcd\xa7\x37\x7b\xac\x32\xd2\x16\x01\x1a\x02\xf9\x43\xfd\xa6\xa4\x8f\xd2\x3b\xb3\xbc\x82\x17\xa5\x10\x86\x88\xd7\xd7\x2c\x5c\x69\x5c\x2e\x2f\x46\xd4\x67\x1e\xf4\xa8\xf6\x49\x18\x55\xaf\x8f\x60\x4a\xb8\xb7\x47\x8d\x9a\x15\x31\x50\xac\x4e\xb1\x9e\x6a\x05\xd9\xf5\x99\x8d\x29\x70\xa6\xa0\x47\xb5\x6f\x66\x72\x30\xda\x06\x5d\xc2\x5d\x39\x9a\x84\x12\x04\x99\x48\x13\x8b\xf2\x24\xd2\xf8\x03\x27\x37\x82\x5f\xac\x5c\xd5\xa7\x86\x51\x7d\xe9\xd5\xdb\xd1\xcc\x47\x2d\xb5\x5d\xe4\xb7\xc1\x6d\x1f\xdb\x70\x79\xa9\xdb\x09\x2c\xb4\x84\xdc\xe0\xa3\xe5\xff\x04\xb7\x83\x99\x0e\x63\x94\xb3\xd2\xe0\xa7\x5a\x94\xe2\x49\x87\x42\xb9\x86\x6e\x2e\x9b\xf8\x94\x48\xef\x65\xdc\x89\x60\x37\x39\xb7\xcf\x73\x74\x6e\x97\xf3\x07\xf7\x36\xb6\xc7\xa2\x4f\xe2\x79\xbd\x91\x3b\x46\x91\xfa\xae\x4b\x65\xe7\x86\x54\xb3\x11\x42\x3e\xcd\xb4\x42\x85\xa9\x94\x64\x92\x04\x5c\x12\xc4\xdc\xc4\x5e\xb7\x6f\xa1\xa7\x47\x31\x6b\x66\x91\x0d\xcb\xf2\x06\x7e\xfe\x54\x43\x78\x87\x5c\x27\x53\x69\xab\x21\x1f\xbd\xdf\x1f\x7c\x3d\xa4\xc9\x23\x44\x37\x45\xee\x8e\x43\x06\x7b\xdf\x21\xbc\x8d\xd8\x76\xb5\x19\xaa\x77\x86\xc9\xae\xa3\x31\x04\xda\xce\xed\x99\xeb\x35\x29\x5c\x5d\x6d\x24\x6b\x85\x99\x3c\x50\x5e\x92\xc4\xbc\xce\xbc\x0e\xbf\xe7\x8f\xc2\x68\x6b\xca\x6f\xe7\xf5\xcc\xf5\xee\x55\xc5\xa1\x76\xe8\xe2\x50\xe9\x22\x02\xd9\x32\xb4\x8d\xd8\x66\xec\x73\xb9\x0e\xcd\x6f\x73\x36\x70\x1b\x41\x5f\x34\x30\xf3\xa7\xca\xbc\x29\x72\x33\x43\x2b\xee\x7c\x04\x17\xa3\x92\x40\x63\x12\x44\x60\xdd\xdb\xf0\x42\x21\x56\xa1\xbc\xb6\x1d\xc9\x66\x88\x86\x08\x71\xf5\xb8\x2c\x10\x80\xd2\xd1\x78\x9a\xbd\xaf\xe6\x86\x24\xb6\xa9\x2c\xff\xb3\x1c\x8f\x6a\x91\x72\x14\xd9\xce\xcf\x11\x19\x93\x20\x62\x3c\xaf\xa6\xa2\x1e\x68\x45\xb7\xae\xfc\xf1\x4c\x63\x1f\xe6\x9e\x0f\xa9\xf8\x2d\x17\xc8\x47\xc4\xfb\xec\x1c\x37\x12\xb0\x07\x02\xf3\xe4\xfb\x93\xa5\x5c\x2f\x84\x92\x7c\x22\xa8\xc0\x6b\xa9\x58\x3c\x66\xd4\x69\x3a\xca\x3c\xdd\x94\x15\xd6\xd8\x44\x05\x3e\x4f\xc6\x46\xe7\x3f\x70\x3a\x7f\x4b\xa1\x93\x89\x7d\xab\xc8\x97\x03\xad\x8a\x70\xbd\xa0\x85\xb0\x0a\x29\xe6\x72\xa3\x19\x77\xa8\x43\x73\x1e\x9c\x6e\x36\xdf\xd0\x42\x58\x59\xdb\x00\x81\x81\x53\x9c\xf6\xae\x94\x08\x8f\x9a\x33\xda\x03\x54\x14\xcc\x62\x87\x66\xac\x50\x9b\x9f\xcf\x7f\xa6\xfa\x4c\xb3\x1d\xc2\x2c\x64\x44\x4d\x81\x84\xff\x29\x65\x82\xa5\x42\xe9\xbd\x6d\xf4\xe6\x70\x3d\x02\xdd\xd8\xd0\xdf\x91\x2a\xd5\xeb\x4c\x47\xf6\xfe\x6e\x74\x94\x30\x87\x19\x2b\x5c\x4a\x0e\x73\x41\x07\x55\x45\x84\xbb\xa3\x4d\x37\x68\xcb\x52\x54\x29\x43\xf7\x10\x7f\x78\x5a\x7f\xab\x9c\xae\x63\x4d\x60\x6c\x62\x69\x56\x69\x7e\x50\x55\xa5\xae\x02\xda\x94\x74\x5d\x43\xc3\x04\xdb\xb8\xa7\xaf\x49\x50\x7d\xbe\x70\x5d\xe2\xdd\x65\x1b\x0f\x31\x15\x26\x57\xcd\xb0\xc5\x23\x96\x11\xf6\xe5\x3f\x3c\xa4\xc2\x91\xb1\xe2\x9c\xd1\x33\x83\x7c\x6b\xd7\xdb\x24\x7c\xca\xc6\xe2\xbf\xf6\xfe\x1b\x5d\xb3\xeb\x36\xe8\xee\xf9\xc6\x7f\x8f\x87\x84\xff\x25\x69\x89\x10\x91\x6e\xad\xea\x18\xb8\xd2\xf8\xf6\x57\xc8\xea\x1c\x33\xc3\xd2\xdf\xe0\xe8\x89\xa6\xa9\xf5\x25\xcc\x67\x70\x7c\x98\x81\x49\x76\x98\xa4\xfa\x98\x1f\x57\x82\x95\x1c\x87\xdc\x9d\xfe\x3f\xe7\xc5\x57\xd4\xf2\xae\xe1\x91\x28\xa5\x52\x90\x51\x36\xf4\xac\x3b\x97\x94\x36\x41\x80\x6e\xf2\x89\xc6\xaf\x43\x5d\x65\x19\xc8\xd4\x89\xf2\x2f\xfd\xb3\x2f\x4e\x71\x2c\xfc\x26\x11\xe1\x4a\x7a\xc7\x7a\xc5\x6b\x85\xca\x62\x34\x14\xf5\x0b\xde\x8f\x32\x77\xa5\xf1\x57\x23\x06\x0d\x4c\x72\x17\xf2\xaa\x6c\x06\xfd\x6b\x06\x58\xcb\x7d\x87\x1f\xb4\x1f\x28\x77\xbb\x4a\x95\xeb\x64\x99\x82\x03\x47\x6e\x22\xa4\xd2\x66\x31\xc1\x39\x1d\x8e\xb3\x9a\x34\x26\x64\x54\x56\x3b\x89\xa5\xdb\x67\xae\x0e\x5c\x3a\xed\x5c\xc6\x87\x90\x4a\x33\x4b\x57\xa6\xcc\x57\xc7\xbd\x5e\x4b\x60\x3d\x9c\xf7\x97\x65\xfb\x42\x07\x45\x06\x60\x4c\x9f\x2c\x69\xf8\x12\x42\xf2\x5e\x09\xee\x65\x2c\x43\x27\x52\xb7\xfb\x1a\x04\x2c\xe5\xbf\x54\x7d\x8f\xa0\xf5\xca\xca\x68\x14\x4b\x98\xe4\x13\x3c\xd2\x03\x12\xd1\xa6\xca\x79\xed\x0d\xe4\x86\x9c\xba\xcc\xd8\xfe\x8d\xe5\x78\x14\x74\xe5\x8e\xcc\x3a\xd5\x6c\xe7\xcc\x29\xb3\xa7\x22\xb1\x17\xfa\x1b\xec\x2b\x45\x59\x0e\x6d\x2d\xca\x14\x45\x0f\xeb\xa0\x9f\x74\x9d\x6a\x84\x3b\xd5\xea\x6f\xab\x39\xc0\x20\x0e\x6d\xea\x00\x0b\xed\xd8\x37\x4f\xbd\xf8\x12\x93\x50\xbf\x2c\xf8\xf7\xe2\x02\x83\x59\x6c\x7d\xd9\x9f\x6a\x35\xf9\x3d\x1a\x50\xa3\x39\x50\xee\xf5\xcd\x0c\xa7\xcc\xe4\x89\x35\x90\x60\x3d\xba\x3f\xae\x24\xb8\x74\xf6\x4f\x66\x4d\xe8\xee\x9a\xdb\x29\x80\x75\x31\xbd\xe5\x7c\x13\x64\x82\x66\x4f\x7a\x2a\x2b\x16\xa4\x5f\x81\x71\xa5\xf1\xf7\x0a\xaa\xc9\xcb\x7e\x00\x71\x01\x3c\x9a\x75\x48\xc6\x8b\xa5\xbf\x15\x52\xef\x77\xfa\x0f\x92\x25\x2b\xd6\xf3\x62\x98\x58\x4b\xff\x72\x36\xd9\x0f\x73\x23\x80\x60\x93\x02\xe6\xde\xf3\xf3\x95\x31\x4c\xfc\xec\x40\xef\xf6\xbd\xae\x93\x8b\xe9\xa8\x9a\xc2\x25\x1b\xdd\x51\x01\x20\x2b\x2f\xd2\x37\x3f\x22\xea\x84\x58\x0f\x2b\x7d\x59\xb8\x1e\x81\x0a\xb2\xec\x3d\xc5\x26\x29\x59\x8d\xca\xdf\x80\x4f\x41\x5f\xfd\xd8\x85\xb7\xd8\x05\x14\xe3\x52\xd4\x8e\x1c\x93\xf6\x44\x51\x4e\x86\x4f\x07\x45\x64\xff\xba\x02\x04\x08\x21\xb4\x9a\x33\x13\x40\xf7\x39\xb7\x57\x86\x02\x3c\x8e\x4a\x5e\x3f\x43\xa2\x86\xf7\x1a\xcc\x4d\x7c\x3a\x61\x00\x70\x9d\xe9\xc0\x95\xfe\x95\xa2\x5e\x7a\xda\x3d\xbd\x5b\x16\xc7\x3f\x12\x91\x3c\x24\x71\x84\x16\x7b\x79\xf6\xb3\xdb\xba\x80\x4e\x23\xec\x6b\x8f\x5f\xf1\xb2\x54\x19\x52\xec\x2b\x83\x9d\xd8\x37\x45\xbf\xe5\x96\x3c\x47\xa0\x88\xe7\xa3\xda\xa7\x00\xe3\x36\x21\xd6\x8d\xd2\xf9\x01\x6c\xdb\xe5\x1b\x4a\x47\xfe\xaf\xe4\x84\xf8\x97\x26\x25\xba\xf2\xa1\x37\xf7\xac\x01\xb4\x1b\xd9\xc6\x85\xb3\xa4\x36\x7a\xda\x0d\x4f\x1e\x07\xe1\xd8\xdb\xbc\xa4\x3e\xf9\xe6\x50\x34\xd4\x38\xe9\x3a\xe0\x74\xaa\x8e\xfa\x4c\x46\x4e\xea\x73\x24\xaa\xb2\x3d\x5b\xe3\xfb\xc1\xb3\xf9\x93\xb3\x7a\x73\x95\xe7\xe4\xe0\xa1\x80\x73\x2f\x19\x04\xf2\x6d\x0f\xeb\x05\x4e\x26\xc4\xb4\xc5\x07\x9c\xc5\x5e\xdd\x23\xab\x3c\xbb\x6a\x60\x52\x04\xde\x77\xc0\xbc\x7f\x98\xa8\x46\x50\x05\xfb\x17\x58\xb1\x6d\xd3\x29\x5e\x4f\xe9\x17\xd0\x1d\x60\x88\xac\x0c\x5b\xf9\x10\x52\x97\x29\x18\xcf\x73\xb3\x39\xf7\x7e\xc3\xa9\xac\x7d\xa1\x3b\x85\x2b\x99\xd5\x3d\x96\x0b\xe7\x2f\x10\x08\x23\xf1\xc0\x7f\x4f\xd7\xbc\x46\x1b\xe3\x9f\x07\x6b\x71\x2c\xd5\x14\x4e\xdc\x98\x63\x30\xf3\x6b\xd5\xa1\x08\xdd\x98\x40\xb2\xcd\x87\xe0\x28\x78\x5a\x73\x3c\xd9\x18\x35\x8e\x58\x54\x92\x21\xd5\xbc\x6f\x6b\xeb\xa9\x4e\x53\x3c\xc6\x1f\xe4\x82\x7e\x28\xb5\x24\xda\xd1\xe4\xcb\xe2\x7f\x1a\xa3\xc6\x09\x79\x84\x9e\x8a\x42\xb6\x7f\x3f\x2d\xd4\x9c\x27\x40\x2c\xde\xa2\x80\x4b\x74\x0e\x1e\x52\x1f\x1f\xb6\xb8\x7f\xb5\x7a\x7a\x04\x73\xb7\xf0\xac\x17\x01\xd2\x6b\xc5\x25\xd7\xad\x4b\xc5\x52\xc3\x32\x38\x98\x8a\x86\x3c\xa1\xcd\x82\x45\xd3\x43\x59\x2f\x26\x37\x7a\xe6\x7a\x39\x6b\x56\xaf\xa0\x63\x7f\x47\xf2\xfd\x78\x82\x73\x54\x40\xbf\x20\x14\xb9\xd6\x86\x62\xfe\x1a\xfb\x3b\x33\x36\x23\x3a\xff\xa6\xc8\x40\xdb\x38\x3d\x86\x34\x71\xe5\x12\xa1\xc7\xa1\xd3\x85\x26\x1b\x2e\x0e\x7f\x47\xed\xc9\xd4\xa3\x1f\x7f\xf2\x24\x7c\xec\xbf\xfd\xb5\xc7\x74\xab\xb1\xff\xf6\x79\xd7\x4a\xf2\x25\xf5\x77\x2e\x1e\x08\x43\x83\x8a\x38\xd6\x40\x39\x8e\x10\x78\xdb\xd8\x8b\xa6\x75\xf2\x5b\x39\x3e\x3e\x24\x63\x63\x3b\x0d\xc5\xc4\x62\x8a\xa0\x91\xcc\x84\x5b\xed\xcf\x41\x08\xf0\xb4\x19\x0a\xd8\xfd\x52\x12\x4b\xbe\x22\xdd\xa0\x73\x54\x61\xe9\xe7\x2a\x2e\x0b\xed\x70\x04\x06\x9a\x39\x58\xcc\xb8\xf8\xd9\x67\xf6\x8d\xb3\xa2\xf3\xf9\x23\xf3\xf9\x50\xd1\x62\x65\x7e\x46\xbe\x9d\x4c\x51\x12\x37\x14\xd1\xb9\x66\x85\x29\xe1\xdc\xe5\x8e\x59\xad\x4d\x63\x55\x58\x97\x47\x88\xc8\xea\xba\xa7\xa1\x3e\xbe\x37\xf3\xd3\xbb\x86\x55\x72\xa3\x89\xf6\x5f\x35\xc8\x86\x0e\x3a\xf2\x92\xbe\x0a\x0c\xf8\x19\xc1\xdc\xd9\x65\x21\xf4\x83\x18\x6f\x4a\xbd\x3f\x97\x02\xa9\x1f\x73\xb3\x19\xde\xd2\xf8\x66\x4b\x85\x69\x30\x1a\x04\x7b\x04\x7f\xc9\x13\xba\x36\xcb\x64\x87\x2c\xf2\x34\x4b\xb6\x78\x65\x92\x9d\xb8\x15\x8b\xa4\x4d\x70\x79\x7b\x4c\xde\xf7\xfa\xd9\xc6\x46\xee\x46\x82\x90\xde\x3f\x78\x0d\x02\x3d\x2a\xd5\xa2\x57\xeb\x16\x01\xdc\x16\xd1\xdb\xc5\x62\x0a\x27\xa5\xf4\xae\x0e\x63\xd9\x51\x94\xbd\x7d\x83\x19\x1d\x7f\x09\x66\xe1\xb1\x61\xef\x6a\xae\x2e\x84\x64\xf7\x68\xc2\x0d\x3e\x9a\xf7\x9d\x6d\x5b\xa9\xe7\x8c\x69\xdb\x8e\x43\xa0\x32\xb3\xcc\x24\x54\x75\xe6\xe2\x53\x6f\x37\x9a\x7c\x80\xf9\x33\x7e\x04\x04\x77\x00\x5c\x5a\x1b\x4f\xf2\x33\xd2\x34\x79\x0c\x5c\x9a\xf8\x92\x55\x4f\x1e\xa8\x99\x08\x40\x77\x64\x4c\x4b\xc5\x48\xde\x60\x47\x8d\xfa\xde\x9e\x22\x64\xeb\x9c\xeb\xcb\xe4\x8b\xed\xa2\x70\xc6\x30\x2e\x1e\x32\x73\x8a\xc5\x42\xd9\xfd\xd1\xad\x4e\x3c\x24\x4a\x11\x5e\xb7\x2d\xe2\xb2\x79\xba\x62\xd7\x4b\x30\x98\x83\xd8\xd7\x36\x4e\xd7\x81\xeb\x69\x67\x1b\x4f\x25\x29\xf9\x63\xb6\xb9\x05\x6f\x7b\x73\xf9\xdb\xad\xf1\x7d\xc6\x26\x45\x0d\xea\x0f\x94\xf1\x84\xe2\xa9\x4c\xf9\x7d\xef\x08\x17\x04\x4f\x68\xd2\x2b\x63\xd4\x78\xf0\x8d\x90\xc5\xaf\xa9\x03\x74\x9b\x40\x64\xc5\x25\x55\x22\x16\x26\xc1\xd4\x1d\x7d\x6f\x26\x16\xa3\x3a\x64\xcf\xf6\xef\x69\xe2\x7b\xf9\xd0\xf2\x8d\x7a\x51\x54\x90\xa1\xf9\xba\xd8\x8f\xba\x55\x6f\xc9\xe3\x88\xb7\xef\x42\xc2\xe1\xe5\x3e\xf3\x80\x25\xa7\x85\xae\x3a\xf8\x3b\x9f\x84\x16\x21\x80\xe5\xba\x62\x49\x7e\x8e\x4e\xf5\xd0\xa8\x32\x5a\x50\xb9\xc9\x23\x78\xd4\x61\x6d\x1e\x70\xc3\x60\x04\x9a\x7d\xfd\x6c\x8d\x86\x1a\xf3\x54\xa0\xb6\xca\xcc\xa8\xb4\xc6\xde\xd7\xf6\x5b\x20\x74\x0b\xbc\x82\xc8\x3c\x63\x49\x1d\xd4\x9c\xd1\x76\xe1\x1a\xba\xa3\x4d\x08\x8f\x32\x91\x23\x67\x71\x2b\xc2\x0c\x66\x46\x16\xb4\x4b\x92\x9b\xe1\xc3\x17\x18\x8d\x64\x35\x55\x06\x77\x4c\xfd\xf6\xad\xe2\xfe\x99\x8f\x43\xd2\x59\x05\xd1\x3d\xd7\xf2\x8c\x6b\xec\x1a\xc5\xfc\xd5\xdc\xd9\xf9\x21\x85\x20\x93\x0c\x38\x83\x61\x52\x03\x84\xe0\x67\x3f\xea\x4b\x50\x0f\xa4\x61\x02\x69\x02\x95\x67\xf6\xc2\x11\xc1\x8c\xc8\xbc\xd4\x61\x9e\xd4\x56\xbf\x08\xea\x01\xcf\x35\x1d\x2e\xa6\xd1\xb1\x66\xef\xd8\xf8\x57\x49\xc5\x3b\xaa\xd2\xf7\x38\x29\x63\x91\x3e\xa1\x53\xee\xb4\x86\x27\x75\xe7\x09\xbb\xd2\xc2\x50\xf3\xa3\x8a\xb7\xdc\xb0\x8d\xd4\x8c\xef\xdf\x66\xb0\x95\xbb\x39\x75\x3e\x84\x5d\x83\x6b\x6e\x91\xc0\x3b\xf9\x9d\x5a\xde\xc1\x8c\xd0\x5e\x8b\x37\x32\x47\x72\xf4\xf3\xa5\x21\x8c\xd0\x99\x88\x01\x63\x16\xdb\xe8\x17\x86\x98\xa5\xa2\xcc\x36\x3b\xab\x2c\xcc\x97\x37\x07\x57\xd0\xcc\xc5\x93\xca\x9f\xd4\x50\xd8\x3f\x76\x98\x18\xb3\x1d\xcf\x7a\x3e\xa1\x3a\x30\x76\xd8\x0e\x15\xec\xbe\xd1\xde\xd1\xb6\x0b\x89\x9c\xce\x7a\x91\x90\x12\x2a\x6f\xb9\x5d\x35\x10\x5d\x4c\xfe\x5c\x18\xbc\x16\xcc\xd0\xd1\xfc\x07\x7c\x86\xc9\xb7\x70\x3d\x1b\x97\xb4\xf6\x43\x38\x2b\xa8\xe7\xd0\x1e\x0f\x67\x18\x4f\x2f\x1c\x02\xef\x70\xec\x10\x93\xf3\x70\x9f\x0d\x7d\x51\x32\x47\x65\xb3\x56\xbd\x63\x31\xc9\xdf\xfb\x5e\xf0\x3a\x86\x6e\x90\x7c\x25\x74\x8f\x34\xf5\xfc\x22\xd8\x2c\xf5\x3c\xa2\xeb\x78\xb3\x82\x1c\x75\xf1\xf9\xb8\x92\x91\x29\xee\x3b\x18\x93\xf2\x32\x6a\xb2\xc6\x9c\x3b\x2d\xa9\xb3\x26\x2e\x0f\xb7\xdd\xd8\xc3\x2b\x79\x87\x8b\xa7\xde\xfc\x56\xb7\xe8\x5b\xbc\x2b\xad\x43\x99\x72\x59\x92\x7a\x50\x40\x95\xb3\x66\x59\x13\xdf\xe6\x24\x6e\x1c\xb9\x96\xa5\x7c\xc1\x7e\xb1\xdf\x80\x47\x87\x30\x82\x66\xea\x69\xc0\x3b\x25\x86\xf0\x37\x39\xf2\x0e\xb0\x76\x7b\xc0\xdd\x74\x41\x25\xb4\xbc\x83\xb0\xa0\xb8\xc0\x5d\x32\xe4\x46\xe2\xc6\xe9\x81\x6f\xc5\xa2\x2c\xf6\xfe\x20\x07\x11\xcb\xc9\xcb\x3f\xe9\x05\x51\xa9\x6d\x04\xe6\xba\x67\xcc\x22\x83\x99\xf5\x04\x76\x41\x03\x62\x2e\xd3\x1a\xc0\xf2\x0e\xbc\xab\xab\x0e\x31\xd5\xc4\x8a\x0a\xdc\x1d\x72\x13\x08\xc9\x1b\x1f\x05\x15\xf0\xa0\x6d\x4c\x67\x2d\x33\x96\x77\xab\xab\xe7\xf6\xe9\x32\x8f\x3e\x5a\x78\xef\x24\xf3\xac\x2c\xb8\xd3\x64\xee\xbf\x85\x8b\x54\x96\x40\x02\x93\x4d\x02\x32\xad\x4b\x07\x34\x21\x5a\xc6\xe5\x02\x34\x97\x0b\x5e\x08\xed\x54\xaf\xbf\xad\xc4\x79\x02\x1e\xb4\x5b\xaf\x6b\x42\x94\x5c\x9a\x7c\xd0\x4d\x13\x08\xd3\x07\xca\x10\x25\xcd\x62\x76\xc1\x56\xa7\x97\x29\x0c\x50\x65\xa1\xf4\x8d\xab\xb2\xad\x1a\x66\xbe\x22\x95\x02\xe2\xd3\x43\x59\x3f\x96\x8f\xc1\x97\x76\x66\x3f\x2c\xea\x33\x69\x43\x28\x03\x53\x0e\x88\x64\x21\x2e\x05\xd8\xfa\x80\xe9\x29\xbe\x92\x60\xf0\xbc\xb0\xa9\x67\xba\xf0\x4e\xd9\x4a\xc2\xc7\x29\x48\x96\xec\x85\x88\x98\xab\x14\xc6\x99\xbc\x6f\xa7\xd1\xfe\x6d\xef\xd8\x73\x77\x92\xc3\xbe\x19\x10\xe8\x26\xbf\x9b\x16\xc8\x6a\x78\x19\x7a\x4f\x96\xff\x44\x46\xf2\x3d\x77\xbb\x8d\x7a\x1e\x74\xb9\xf3\x6d\x86\x1f\xcd\xc8\x63\x25\xbc\xa0\x6f\x93\xba\x45\x34\xdc\x91\x96\x3a\xf0\x3d\x83\xb4\x2c\x5b\x0c\xfa\xc6\x02\x3d\x7f\x15\x1b\x83\x74\x0d\xbc\xdb\xf9\x98\xb5\x56\x6b\x5c\xa9\x82\x8c\xa1\x2c\xd4\xb2\xa8\x8d\x97\xf3\x69\xab\xe0\x8e\x0e\x02\x13\xff\x97\x81\x25\x27\x32\xa5\x2c\x45\xfd\x26\xba\x20\x77\x48\x13\x86\x68\x44\x6f\x06\xca\xb1\x2b\x4a\x88\xcf\x26\x0e\x18\x37\xa2\x1d\x23\xa6\x02\xbc\x52\x7d\x26\xde\x30\x44\x1a\x9d\x26\x6d\x22\xd9\x14\x45\xc5\xc3\xdd\xd1\x6f\xa8\x1f\xe3\x42\x69\xa1\x39\xb3\x2e\x74\x0f\x59\x91\x80\x78\x76\x45\x21\xe3\xe7\xe7\x15\xbf\x87\x1d\x1e\xf2\x2a\x0a\x8d\x36\x9c\xca\xba\xa2\xf2\xf8\xaf\x50\x5a\xcd\x44\x0b\x3d\xdd\x7e\x91\x73\x17\x57\xc5\xba\xa0\xfe\x7a\xe8\xd4\xdf\x7a\xa5\x3a\x4a\xe8\x02\x87\x69\xd3\x97\xc0\xad\xf4\x82\xe2\xd2\x7e\x2e\x91\x4a\x22\x52\x91\x14\x2c\x0d\x79\xb8\x98\xde\x87\xeb\xac\x79\x70\x86\xe9\xd9\x96\xcb\xb7\x5f\xdd\x83\xcf\xd1\x80\x00\x17\xe7\xe6\x64\x16\xdc\xf5\x9f\x2b\x53\x9c\xf4\x15\x38\x64\x16\x0a\x37\xeb\x8b\xc8\x7b\x53\x4d\x1c\x6a\xc8\x4b\xfd\x57\x2f\x20\x6c\x62\x9e\x44\x21\x83\x64\x2a\x2f\xca\x23\xde\x22\x85\xa9\xc8\x24\x2b\xa9\x08\xb6\xf9\x8b\x1d\x12\xad\xfc\xfb\x4e\xac\xe0\x6b\x21\xe5\xdf\xb8\xbe\xcc\x54\xf1\x2c\xcc\xea\x37\x50\x7d\xdb\x5d\xd8\x05\xf8\x43\xde\xa6\x14\xc1\x2d\x3d\x13\xc4\x85\xa2\x78\x7b\xd5\x72\x09\xb5\x54\x47\x98\xc5\x0e\x38\x4a\xc0\x72\x96\x41\x20\xcf\x4c\x24\xfd\x35\x8e\x77\x06\x57\xe7\x3d\xcd\x6e\x59\x41\xa4\xb9\x55\x5d\xbe\xdf\x7e\xd5\xdf\x82\x98\x7d\x7f\xf6\x2c\xed\xd3\x39\xca\x9c\x97\x06\x91\x03\xb6\xf2\x7c\xd0\x38\xea\x6c\x92\x7e\x92\x32\x31\xb3\xbb\x5c\x52\x17\x1d\x71\x96\x82\x6c\x08\xd5\xf2\x50\xaa\x94\xac\xb2\xb5\x17\x86\x48\x5b\x50\x79\xbf\xa3\xb6\x13\xa2\xda\xc2\xf4\x47\xf9\x11\x10\xa3\x80\x8b\x66\xe0\x79\xed\xd1\x3a\x17\xc2\x84\x8f\x74\x6b\x6a\xd4\x9b\xda\xf7\x9a\x50\xf4\x03\x89\x92\x12\x32\x66\xe0\xe5\x9f\xbe\x88\x48\x6e\x84\x26\xec\x4a\x03\x46\xf0\x7a\xf0\x5b\x0c\xbe\x26\xd4\xdb\x39\x61\x36\xe1\x7a\x14\xc7\xbc\xbc\xb6\x5b\x71\x0e\x54\xb9\x1d\x39\xe7\x8d\x88\xe2\x9b\x7f\xf7\xa4\x9e\xbc\x2a\x2c\xed\x0f\x54\x78\x95\x15\xa1\xf5\xee\x40\xa0\x3c\xb2\x63\x83\xa3\xe2\x5c\x53\x27\x65\x21\x9b\xc3\x84\x1b\x16\x1a\xb9\xe4\x5a\x15\x0e\xb6\x53\x6d\x1a\xaa\xa2\xca\xf9\xf8\xe6\x66\xb7\xd8\x1e\x2a\xff\x19\x36\x37\x6c\xd3\xf8\x19\xf5\xee\xbb\xda\x27\x17\xe2\xbc\xff\x2a\x36\x07\x7a\x3a\x91\x61\xe5\xc5\xfd\xea\x71\x84\xda\x71\x86\x34\x15\xb8\x83\xb2\x89\x33\x7f\xe5\xf7\x6f\x7b\x1c\x3c\x7b\x5f\x25\xcc\xa7\x55\xb5\xe2\x5c\x53\xf2\x4b\x70\xc1\x4e\x77\x18\x71\x23\x2e\xca\x72\xd9\xcd\x71\x37\x60\xf9\xcf\xda\xdb\xd1\x6a\x0f\xee\x57\x8a\x84\xdc\xe8\x1b\x2e\xe2\xdb\xb6\xe2\x71\x10\x1b\x4d\x39\x05\xb6\x9d\xa3\x4d\xf3\xc9\x1d\x85\x30\x62\x17\x2b\x1b\x4a\xb3\x65\x56\x95\x18\xba\x06\x2f\xd6\x8a\x0b\x14\x74\xf3\x0b\x3a\xbc\x7c\x7c\x3d\xba\x51\x93\xf2\xdb\x43\xb3\xfd\x9f\x2a\x24\x4a\xbb\x5f\xe0\xf6\x94\xfe\x63\x3f\xc5\xe8\xf6\x68\x61\x24\x9b\xa0\x5a\x69\xf3\x4c\x97\x06\xac\x39\xf3\x3e\x6e\xf4\x5a\x2f\x5d\x58\x78\xeb\x77\xd3\x83\xba\x50\x1d\x10\x71\xfb\x6d\x05\x19\xb3\x7c\xac\xbe\x68\x46\x20\xcb\x1a\x55\x23\xbb\x71\x48\x36\xc1\x11\xb7\xdb\x7d\x43\x4f\x34\x97\xf2\x35\x6f\x46\x76\xfd\x32\xb7\x32\x81\xbf\x7d\xbc\xf2\x24\x5c\x2c\x36\x33\x82\xce\xd4\xf7\xb4\x62\x13\x2c\x50\x7e\x3c\x6c\xde\x01\x22\x9d\x9a\x6e\xab\xd8\xbd\x46\xbb\xc6\x04\x81\xe1\x29\x7f\x36\x27\x7b\x64\x85\x0e\xe2\xe7\xf7\xcd\xd0\x0d\x74\x0e\x0a\xfc\x6a\x38\xdb\x0b\xe7\x2b\x2b\xbb\xcf\xb9\xad\x50\x86\x5b\xa7\xad\x2f\x63\xc5\xc4\x5e\xd8\x5d\x50\x9a\xd3\x8e\xe0\xb1\x52\x7c\x06\xc4\x4c\x5b\x8d\x00\x29\x31\x1f\x06\x86\x87\xb5\x2f\x20\x50\x8e\x32\x82\x39\xd3\x86\x0b\xe1\x5e\x14\x1b\xfc\xff\x1d\xac\x91\xb1\x91\x75\xc2\xe5\x5c\xe7\x6f\x0f\x29\x1f\xf3\x44\xb7\xbf\x92\x44\x96\x47\x31\xfb\xc1\x5b\x6e\x8e\x4d\xe1\x52\xd2\xac\x94\x3c\xf5\x00\x5d\x47\xd1\xcc\xbe\xa9\xa9\x74\x14\x66\xba\xcb\x90\xdf\x79\xe7\xb3\x47\xf4\xd3\xe9\x64\x63\x26\x62\xed\x08\xaa\x94\xbd\x5c\x30\x19\xdf\xb0\xfc\xa2\xf0\x2d\x74\x6e\xb7\xe8\x5e\xd4\xa7\x83\x31\x9c\x5c\xc3\x37\x54\xc9\x3a\x4b\xc5\xc7\x34\x09\x56\xaa\xa0\xa6\x9b\x78\xa7\x36\xba\x7f\xd7\x69\xea\x71\x66\xd0\xd9\x66\x64\xe7\x66\x8a\x03\x66\xcc\x9f\x28\x79\x31\xa7\x2f\xd1\x3a\x1f\x74\x04\x9c\x6e\xb9\x66\x44\xfa\x19\x61\x0b\xb7\x67\xd8\x07\x7e\x9a\xd7\xeb\x53\xcf\xad\x23\x77\xf8\x44\x87\x4d\xa0\x70\xf9\xbf\x15\x12\x21\x4e\xa8\x65\xce\x97\x2b\xaf\xf2\x40\xa9\x98\xef\x12\xb2\x0a\x9c\xe8\x47\x48\x36\x5c\xe5\x24\x78\x6b\x2c\xff\x30\xd9\x7c\xe9\x72\xbd\x2e\x33\xd6\xce\xb4\xe5\xea\xcd\x2b\xf5\xf3\xb6\x64\xfc\x6e\xb8\x0c\x40\xf9\xcf\x30\xd2\x28\x1f\xb4\xd3\x61\x26\x13\xbb\xda\x84\xe6\xab\xb2\x65\x48\xce\x3a\xeb\x4b\x8b\xd5\xac\x84\x57\x6e\xe9\xcd\x7b\x1a\x3c\x65\xe9\x66\x0d\xfc\x56\xe4\x8a\x54\xb3\x8f\x3e\xf7\x27\x04\x00\x00\x00\x9c\xcf\xbb\x39\xb4\xda\x05\x67\xfc\x9f\x00\x00\x00\xff\xff\xd2\x40\x2d\x5c\x58\x1a\x00\x00"),
+		"/assets/protego-logo.png": &vfsgen۰FileInfo{
+			name:    "protego-logo.png",
+			modTime: time.Date(1970, 1, 1, 0, 0, 1, 0, time.UTC),
+			content: []byte("\x89\x50\x4e\x47\x0d\x0a\x1a\x0a\x00\x00\x00\x0d\x49\x48\x44\x52\x00\x00\x01\x49\x00\x00\x01\x90\x08\x03\x00\x00\x00\x14\x65\x62\x7d\x00\x00\x00\xa2\x50\x4c\x54\x45\x47\x70\x4c\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\x3d\x3b\xec\x2b\x00\x00\x00\x35\x74\x52\x4e\x53\x00\x03\x1d\xfc\xfe\xb2\x0a\x01\xf9\xf5\xf1\xed\xe5\xdb\x06\xcb\x17\x0e\x28\x22\xb7\xd5\xe9\x12\x7a\xe0\xc6\x44\x4a\x2e\x3d\xa9\x87\xc1\x50\xbc\x33\x80\x38\x8e\x6e\x94\x69\x9e\xa3\x5b\xd0\x73\x5f\x56\xae\x99\x64\x6b\xf9\xd0\xe7\x00\x00\x19\x30\x49\x44\x41\x54\x18\x19\xed\xc1\x87\x82\x9b\x3a\x16\x00\xd0\x2b\x90\x84\x44\xef\xd5\x36\xc6\xbd\xf7\xfb\xff\xbf\xb6\xaf\x25\x3b\x99\x96\x49\x62\x63\xb0\x39\x07\x1a\x8d\xf0\xd9\x6a\x58\xe6\x96\x15\x2e\xd3\x42\xb1\xa1\xf3\x5b\xb8\x3b\x3b\xa6\xeb\x6a\x67\x19\x52\x66\x51\x5e\x0e\x0f\x03\x85\x43\xe7\x17\xd9\x9e\xdb\xef\xa5\x27\x4b\xc7\xbf\x50\xfc\x8b\x30\xc3\xe1\x76\xe2\x26\x36\x74\x7e\x01\x51\x06\x8b\x73\x6e\xc5\x52\xc5\x6f\xa8\x30\xb2\xdd\x66\xdf\x0b\xa0\xf3\x55\xb6\xdb\x2f\x16\xcb\xd0\x12\x14\x5f\xa1\x59\xb5\xde\xf7\x26\x0a\x27\xd0\xf9\x82\xa4\x48\x59\x46\x29\xbe\x83\x52\x6a\x8e\x87\x3d\xd7\x86\xce\xe7\x34\x5b\x19\x1c\x86\x27\x27\x93\xf8\x11\x69\xee\xd8\x7c\x55\x04\x9e\x06\x9d\x0f\x10\x3b\x09\x26\xc7\x61\x68\x09\xfc\x14\x15\xe6\x78\x79\x19\x04\x2e\x27\xd0\x79\x0f\x57\x7a\x69\xe9\x98\x86\xa0\xf8\x39\x2a\x74\x33\x62\xd3\x91\xcf\xa1\xf3\x86\xa7\xcc\x8e\xe9\xba\xca\x24\x7e\x09\x15\x71\x5e\x4e\xb7\x83\xc0\x83\xce\x0b\x84\xd8\xfe\x68\x38\xd6\x55\xfc\x25\xb2\x9a\x1f\x7d\x9b\x10\xe8\xfc\x87\xf8\xbd\xc5\x79\xbc\x33\x05\xc5\x5f\x22\xe2\xa8\x3a\xef\x47\xbe\x4d\xa0\x03\x84\xbb\xfe\x6c\xb5\xac\x4c\xfc\x3d\x66\x7e\x5e\x0c\x7c\x97\xdb\xf0\xec\x78\x30\x9a\xb2\xc8\x90\x02\x7f\x8f\x90\x86\x35\x9e\x6f\xfd\x04\x9e\x19\xf1\x82\xc1\x61\x5a\xe6\xa6\x44\x8a\xbf\x4f\x1a\xce\x69\xbe\x2a\xfc\x84\xc0\x53\x22\x36\x77\xfb\xdb\x79\x1e\xab\xf8\xe7\x54\xe9\x9c\x2f\xb3\x84\xdb\x04\x9e\x4f\xe2\x8f\xd2\x32\xb7\x0c\x41\xf1\xcf\x51\xd5\xb0\x9c\xd3\xf4\x38\x49\xe0\xc9\x78\x4a\x7f\x94\xae\x73\x5d\x45\x8a\xd7\x42\x75\x67\x93\x8e\x26\x41\x42\x34\x78\x1e\xfd\xed\x7c\x6c\xe8\x82\xe2\x35\x51\xa1\xc7\xd5\xf2\xd2\xe7\x04\x9e\x82\xed\xf9\xbd\xcb\x9c\xed\x62\x44\x8a\xd7\xa6\xc6\x51\xb8\x5c\xf4\xfa\x89\x0d\x0f\x8e\x70\x4f\x99\xad\xd6\x3b\x03\x6f\xc7\x88\x36\x8b\x41\xe0\x71\x02\x0f\x8c\xb8\x93\xed\xf0\xe4\x64\xba\xc0\xdb\x11\x7a\xb6\x0b\xe7\x87\x99\x0b\x8f\xca\x4e\x82\xd9\x76\x5a\xee\x0c\x81\xb7\xa6\x8a\xe8\x34\x3c\xcc\xfc\x84\xc0\x23\x4a\x26\xab\x75\xa4\x0b\x55\xc5\xdb\x53\x55\x21\xa3\xcd\x62\xc6\xe1\xe1\xb8\x93\x51\xba\x19\x47\x3a\xc5\xda\xe8\x56\xb5\x49\x8f\x33\x97\x68\xf0\x20\x34\xc2\x5d\xa5\xd8\x97\x3b\x81\xb5\xa2\x94\xca\xa8\x4c\x8b\xc0\xf5\x88\x06\x8f\xc0\xf6\x66\x97\x79\x68\x99\x3a\xc5\xba\xa9\x32\xb6\xc6\xcb\xd5\xc0\xb5\xa1\xf5\xb8\xeb\x17\x97\x39\x8b\x0c\xbc\x0b\x4a\x55\xdd\x0a\xe7\xab\x5e\xdf\xe5\xd0\x6e\xee\x60\x5f\x66\x3a\xa5\x14\xef\x46\xa5\xd2\x3c\xed\x07\x2e\xb4\x97\xad\x0c\xb6\xc3\x53\x6e\x49\x15\xef\x4b\x95\x59\xce\x86\x87\x81\x42\xa0\x85\xec\x44\xe9\x1f\xa7\x27\x4b\xa5\xd8\x0c\x16\x1b\x1e\x7d\x25\xb1\x35\x68\x19\xb7\xd8\xaf\x9d\xcc\x10\x14\x1b\x42\x1a\x59\xbe\xde\x17\x2e\x81\xf6\x20\xb6\x32\xe9\x2d\xce\x63\x4b\x22\x52\x6c\x10\x69\x8d\xcf\x8b\xd1\x44\xe1\x04\xda\x40\x23\xdc\x2d\xa6\x61\xa6\x62\x13\x09\x33\x9c\xf6\x14\x4e\x34\x68\x3c\x2f\x28\x56\x73\xb6\x33\x25\xc5\x26\xa2\xd2\xdc\x85\xcb\x55\x11\x70\x68\x34\xee\x06\x83\xcb\x3c\x34\x25\x52\x6c\x2e\x61\x8e\xe7\x97\x41\xe0\x72\x68\x2c\x12\xf4\xd2\x53\x16\xeb\x82\x62\x93\x51\xa1\xc7\xd6\x29\xed\x05\xd0\x4c\x3c\x18\x1c\xa7\x9b\xdc\xa4\x14\x5b\x80\x66\x79\x39\x3d\x0e\x02\x4e\xa0\x51\x34\x62\x73\xe5\x38\x1c\x9b\x88\x14\xdb\x82\x9a\xd5\xf0\xa8\x78\x36\xd1\xa0\x31\x08\xf7\x7b\xfb\xf3\x38\x8a\x25\xb6\x08\x95\x71\x34\x3e\xef\x7b\xbe\xa7\x41\x23\x10\xae\xf4\x8b\xc5\xb9\x32\x05\xb6\x8f\x30\xab\xf3\xa2\xd7\x57\x3c\x02\xf7\xe7\xf9\xc7\xe1\xd8\xd2\xa5\x4a\xb1\x7d\xa8\x2a\x8d\x6c\x3c\x3c\xfa\x1c\xee\x2c\xf1\x8b\xd5\xfc\xe4\xc4\x02\x5b\x8b\x8a\xd8\x39\xcd\x2f\x85\x9f\xc0\xbd\x68\xb6\x97\xcc\x2e\xcb\x5c\x60\xfb\xc9\x7c\x79\x99\x24\xdc\xd6\xe0\x1e\xec\xfe\x71\x5a\x3a\x96\x41\xb1\xfd\x54\xc3\x72\x36\xe9\xb1\x6f\x13\xa8\x99\xed\x05\x93\x5e\xba\x71\x62\x7c\x1c\x71\xbe\x49\x7b\x93\xc0\xb3\xa1\x46\x9a\xd7\x3f\x2c\x73\x53\x0a\x8a\x8f\x43\x15\x32\xcb\x97\x07\x3f\x81\xba\x10\xb7\xdf\xdb\x2f\xc3\xc8\x10\xf8\x68\x84\xb1\x0b\xcf\xfb\x5e\xdf\x25\x70\x73\x84\x27\x41\xb1\x58\xef\x74\x15\x29\x3e\x22\x55\x3a\xeb\x45\xa1\x24\x9c\xc0\x6d\xb9\x93\xc3\x7c\x1c\x99\xba\x4a\xf1\x31\x51\x55\x37\xa3\x70\xb8\x9d\x24\x70\x43\x89\x3f\x38\x0c\x4f\x3b\x89\x8f\x4e\xdf\x95\xc3\xed\xc0\x4f\x88\x06\x37\xa1\x4d\x56\x9b\x9d\x50\x55\x7c\x78\x94\xaa\xd2\x59\xaf\xfa\x36\x81\xab\xb3\xdd\xd9\x31\x5d\x57\x96\x81\x4f\x42\x35\xac\x6a\x9d\x1e\x27\x89\x0d\xd7\xa3\xd9\x9e\xdb\xef\xa5\x65\xa4\xe3\x13\xa1\x94\x1a\x51\x99\x16\x7d\xd7\xb3\xe1\x4a\x88\x32\x58\x9d\x73\x2b\x96\x2a\x3e\x17\x55\xc6\x56\xbe\xbc\xcc\x5c\xb8\x06\xee\xf6\x7b\x8b\x65\x68\x49\x15\x9f\x10\x55\x65\xc4\xe6\xab\xa2\xef\xda\xf0\x67\x34\xe2\x16\xe9\xc9\x44\xa4\x14\x9f\x97\x55\xee\x07\x1e\xd1\xe0\xf7\x69\xca\xe0\x30\x64\x4e\x26\xf1\xb9\xe9\x59\x5e\x4e\xb7\x33\x97\xc0\xef\x20\x76\x12\xf4\x8f\x43\x66\x09\xec\x20\xca\xe8\x34\x1d\xf5\x95\xc4\x26\xf0\xab\xb8\x5b\xec\x37\xb9\xa9\x0b\x8a\x1d\x44\x2a\x0d\x33\x5f\x2f\x0a\xd7\x86\x5f\xe2\x29\xb3\x63\xba\xae\x32\x1d\x91\x62\xe7\x5f\x54\xb7\xc6\xe7\xfd\x68\xe2\x72\xf8\x1a\x8d\xd8\xdc\xef\x4d\x43\x43\x60\xe7\x35\x61\xb2\xb4\x08\xb8\x4d\xe0\x0b\xec\xa0\x58\x9c\xab\x9d\x29\x54\xec\xbc\xa6\x4a\x73\x37\x5e\xae\x8a\x80\xc0\xe7\x34\xee\xfa\x83\xd5\x72\x6c\x62\xe7\x63\x59\x38\xbf\xcc\x02\xd7\xd6\xe0\x63\x44\x19\x4d\x99\x65\x48\x81\x9d\x8f\x09\x69\xec\xca\xb4\xe7\x12\x78\x9f\xcd\x83\xc1\x76\x5a\xe6\xa6\xc0\xce\xcf\xc8\xac\xda\xa4\xdb\x41\xc0\x09\xbc\x42\x6c\xee\xf6\xb7\xf3\xca\x54\x11\x29\x76\xbe\x40\x64\xe1\x70\xe4\x27\xdc\x26\xf0\x52\xe2\x8f\xd2\xb2\xb2\x0c\x49\xb1\xf3\x35\x54\xc6\x51\xb5\xde\xf7\x02\x0f\xbe\xd1\x3c\xa5\x3f\x4a\xd7\xb9\x21\xb0\xf3\x6b\x84\x39\x3e\x2f\x7a\x7d\x85\xc3\x3f\x34\x7f\x3b\xaf\x74\x5d\x50\x8a\x9d\x5f\x43\x55\x21\xe3\x70\x38\x0a\xe0\x6f\x4a\x6f\xc8\x76\x31\x76\x7e\x0f\xa5\xa6\xc3\xa6\x3d\x17\x20\xe9\x9d\x2d\xec\xfc\x99\x68\x5d\x78\x70\xd8\x64\x12\x3b\x7f\x46\x5a\xcb\x11\x9c\x2d\xec\xfc\x31\xb1\x9b\x82\x43\xb1\xf3\xe7\xd4\x13\x38\xd8\xb9\x06\x06\x0e\x76\xae\x81\x81\x83\x9d\x6b\x60\xe0\x50\xec\x5c\x01\x03\x87\x62\xe7\x0a\x18\x38\x14\x3b\x57\xc0\xc0\xa1\xd8\xb9\x02\x06\x0e\xc5\xce\x15\x30\x70\x54\xec\x5c\x01\x03\x47\xc5\xce\x15\x30\x70\x54\xec\x5c\x01\x83\x5c\xc5\xce\x15\x30\xc8\x55\xec\x5c\x01\x83\x5c\xc5\xce\x15\x30\xc8\x05\x76\xae\x80\x41\x2e\xb0\x73\x05\x0c\x72\x81\x9d\x2b\x60\x90\x0b\xec\x5c\x01\x83\x5c\x60\xe7\x0a\x18\xe4\x02\x3b\x57\xc0\x20\x17\xd8\xb9\x02\x06\x95\xc0\xce\x15\x30\xa8\x04\x76\xae\x80\x41\x25\xb0\x73\x05\x0c\x2a\x81\x9d\x2b\x60\x50\x49\xec\x5c\x01\x83\x4a\x62\xe7\x0a\x18\x54\x12\x3b\x57\xc0\xa0\x92\xd8\xb9\x02\x06\x95\xc4\xce\x15\x30\xa8\x24\x76\xae\x80\xc1\x58\x62\xe7\x0a\x18\x8c\x25\x76\xae\x80\xc1\x58\x62\xe7\x0a\x18\x8c\x25\x76\xae\x80\xc1\x58\x62\xe7\x0a\x18\x8c\x75\xec\x5c\x01\x83\xb1\x8e\x9d\x2b\x60\x30\xd6\xb1\x73\x05\x0c\xc6\x3a\x76\xae\x80\xc1\x58\xc7\xce\x15\x30\x08\x75\xec\x5c\x01\x83\x50\xc7\x67\x23\x24\xc5\xab\x63\x10\xea\xf8\x6c\xa2\x5c\xc7\xab\x63\x10\xea\xf8\x4c\xa8\x34\x9d\xf9\x74\x9c\xe1\xb5\x31\x08\x75\x7c\x26\x22\x0e\xf7\x13\x65\x7b\xc2\x6b\x63\x10\xea\xf8\x44\xcc\x6a\x79\xe8\x27\xdc\x5f\x8d\x4d\xbc\x2e\x06\xa1\x8e\xcf\x82\xca\x38\x9c\x16\x2e\xfc\xa5\xbf\xa8\x24\xc5\x6b\x62\x10\xea\xf8\x24\xa8\xdc\x9d\x8f\x41\x62\xc3\x5f\xbc\x60\x9a\x4b\xbc\x26\x06\xa1\x81\xcf\x41\xb7\xc2\xe1\x28\x80\x7f\x11\x6f\x31\x96\x78\x4d\x0c\x42\x03\x9f\x83\x55\x6e\x7d\x5b\x83\x7f\x71\x77\xea\x48\xbc\x26\x06\xa1\x81\x4f\x40\x35\xc3\xb4\x08\x3c\x0d\xfe\x61\x2b\xa3\x79\x1e\xab\x78\x4d\x0c\x42\x03\x1f\x9e\xd0\x2d\xb6\xe8\x83\x06\xff\xe2\xc1\xe8\x6c\x4a\xbc\x2e\x06\xcc\xc0\x87\x67\x56\xd3\x9e\xc2\xe1\x9b\xfe\x6a\x6c\x0a\x8a\xd7\xc5\x80\x19\xf8\xd8\xa8\x8c\x36\xab\x81\x4b\xe0\x5f\x5a\x32\xd9\x33\x03\xaf\x8e\x01\x33\xf0\xb1\xd1\xec\xdc\x83\xef\x34\x32\x99\x3a\x78\x03\x0c\x98\x81\x8f\x4c\x95\xe3\x69\xa1\xc0\x37\xc4\x1d\xcd\x77\x06\xde\x00\x03\x66\xe0\xe3\x52\x65\x56\xa5\x33\x0e\xff\x21\x3c\x18\xad\x23\xbc\x09\x06\xcc\xc0\xc7\x25\xad\xf5\xd1\xe7\x04\xfe\xc3\x95\x15\xcb\x24\xde\x04\x03\x66\xe0\xc3\x32\xab\xe1\x28\xe0\xf0\x1f\x92\xcc\xf6\x2c\x13\x78\x1b\x0c\x58\x8c\x0f\x4a\x95\xe3\xd4\xe7\xf0\x0d\xf1\x26\x69\xa4\xe3\xad\x30\x60\x31\x3e\x28\x6b\x73\xf0\x3d\x02\xdf\x28\xbd\xa5\xa3\x0b\xbc\x15\x06\x2c\xc6\x47\x24\xf4\x68\x7d\x0c\xe0\x3b\xae\x8c\x96\x96\xc0\xdb\x61\x70\x8a\xf1\x11\xe9\xce\xb4\xf0\x6c\xf8\x4e\x39\x96\x86\xa0\x78\x3b\x0c\x4e\x31\x3e\x1e\xdd\x2a\xf7\x03\x17\xbe\xb3\xfb\x97\xd2\xc2\x9b\x62\x70\x8a\xf1\xd1\x50\x61\x95\x5b\xc5\x86\xef\xb8\xb2\x62\x12\x6f\x8b\xc1\x29\xc6\x47\x23\x9d\xe1\x4c\xe1\x04\xbe\x9b\xa4\x63\x53\xc5\xdb\x62\x70\x8a\xf1\xc1\xc4\xce\xb0\x20\xf0\x7f\xde\x24\xad\x62\xbc\x35\x06\xa7\x18\x1f\x4c\x9e\xf6\x6d\x0d\xbe\xd3\xfc\xb9\x23\x28\xde\x1a\x83\x53\x8c\x0f\x25\x0e\xf7\x13\x0f\xfe\xcf\x2e\x86\x8e\x81\xb7\xc7\xa0\x8c\xf1\x81\xa8\xc6\x78\xd5\x87\x17\x78\x30\x8f\x04\xd6\x80\xc1\x29\xc6\xc7\x41\x8d\xcd\x21\xf0\xe0\x85\xc1\xdc\x91\x14\x6b\xc0\xa0\x34\xf1\x51\xa8\x62\xb7\x3e\x06\x04\xfe\xcf\x9b\x4c\x77\x3a\xd6\x82\x41\x69\xe2\xa3\x10\xf1\x30\xf0\x08\xbc\x10\xcc\x1d\x4a\xb1\x16\x0c\x4a\x13\x1f\x03\x35\xaa\xc5\x8c\x13\xf8\x3f\x6d\x90\xe6\x06\xd6\x84\x41\x69\xe2\x43\x10\x71\x95\x26\xf0\x12\x57\xd2\x5c\x62\x5d\x18\x94\x26\x3e\x02\x9a\x9d\xb6\x8a\x0d\x2f\x4d\xd2\x4a\x57\xb1\x2e\x0c\x4a\x13\x1f\x80\x8c\xce\xdb\x00\x5e\xe2\xfd\x7d\x1e\x63\x7d\x18\x94\x26\xb6\x1f\xcd\xe6\x05\xfc\x40\x53\xd2\x0a\xeb\xc4\xa0\x34\xb1\xf5\xa8\x33\x2f\x5c\xf8\xc1\x64\x31\x8e\xb1\x4e\x0c\x4a\x13\xdb\x4e\xb7\xe6\x85\x07\x2f\x71\x65\x11\x1a\x58\x2b\x06\x65\x86\x6d\xe7\x4c\x07\x1e\x81\x97\xfc\x55\xa8\xab\x58\x2b\x06\xa5\x89\xed\xa6\x3b\xc3\x81\x0b\x2f\xd9\xfe\x25\xcc\xb0\x66\x0c\xca\x0c\x5b\x4d\x58\xd3\x19\xfc\x80\xb8\x87\x52\x60\xdd\x18\x6c\x32\x6c\xb5\x6a\x3a\x4b\xe0\x07\x4a\xaf\xcc\x28\xd6\x8d\xc1\x26\xc3\x16\x33\xa2\xe9\xcc\x83\x97\x08\xef\xcd\x33\xac\x1f\x83\x4d\x86\x2d\x96\x2f\x26\x9c\xc0\x4b\x9e\x3f\x37\x04\xd6\x8f\xc1\x26\xc3\xd6\xd2\xc7\x69\x3f\x81\x97\x08\x9f\xcd\x77\x78\x0f\x0c\x36\x19\xb6\x95\x8c\x16\x7d\x0d\x5e\xd2\xec\x60\xaf\xe3\x5d\x30\xd8\x64\xd8\x56\xe1\xa2\xef\x69\xf0\x92\xad\xa4\xb9\xc0\xbb\x60\xb0\xc9\xb0\x9d\x8c\x7c\xef\x73\xf8\x81\x16\x1c\xc7\x92\xe2\x5d\x30\xd8\x64\xd8\x4e\xf9\xd6\xb7\x35\x78\x49\x23\xc7\xd0\xa4\x78\x1f\x0c\x36\x19\xb6\x91\x1c\xa7\xbe\x07\x3f\x72\x7b\x67\x53\xe2\x9d\x30\xd8\x64\xd8\x42\xd2\xda\xf7\x09\xfc\x40\xf3\x06\xeb\x08\xef\x86\xc1\x3a\xc3\x16\xaa\xa6\x33\x4f\x83\x1f\xd8\xbd\xb9\x25\xf1\x6e\x18\xac\x33\x6c\x1d\x7d\x37\x9c\x25\xf0\x23\x77\x36\x77\x04\xde\x0f\x83\x75\x86\x6d\x43\xad\xe1\x80\x68\xf0\xa3\xd9\x70\xa7\xe2\x1d\x31\x58\x5b\xd8\x32\x62\xb7\x1c\xb8\xf0\x23\xee\xa7\x8e\x81\xf7\xc4\x60\x6d\x61\xab\x50\x61\x2e\x7b\x1c\x7e\x44\x82\x03\xc3\xfb\x62\xb0\xb6\xb0\x5d\xac\x4d\x2f\x21\xf0\x03\x2d\x29\xca\x0c\xef\x8b\xc1\xda\xc2\x36\x91\xe6\xe6\xa8\xc0\x2b\x64\x36\x8d\x24\xde\x17\x83\xb5\x85\x6d\x62\x84\x5b\x78\x83\x2c\x2a\x1d\xef\x8c\xc1\xda\xc2\xf6\xa0\x71\x78\xf1\xe1\x15\x4d\x19\x95\xb1\xc0\x3b\x63\xb0\xb6\xb0\x35\x54\x59\xa5\x81\x0d\x3f\x22\x5e\xef\x6c\xe1\xdd\x31\x38\x5b\xd8\x1a\xba\x95\x4e\x38\x81\x1f\xf1\xc9\x34\x96\x78\x77\x0c\xce\x16\xb6\x04\xa5\xbb\x65\x91\x10\xf8\x91\x1d\x2c\x42\xbc\x3f\xca\xe0\x6c\x61\x4b\x50\x59\x0e\x5c\x78\x2d\x29\x98\x89\xf7\x27\x4e\x70\xb6\xb0\x25\xe2\xf2\xe2\x72\x78\xad\x18\x46\x12\xef\x8e\x8a\x12\xce\x16\xb6\x83\x51\x5d\x7c\x78\x8d\x2b\x69\xae\xe3\xfd\x51\x7d\x03\xcb\x08\xdb\x61\x9c\xfa\x1c\x5e\x0b\xb6\xa1\x54\xf1\xfe\xa8\xb1\x86\xf9\x0e\xdb\xc0\x88\xd2\x99\x07\xaf\x10\x77\x54\x66\xd8\x04\x6a\x7c\x86\xa1\x83\xcd\x47\xd5\x68\x39\x80\x37\xf8\x64\xa8\xab\xd8\x04\xaa\x79\x86\x69\x8e\x8d\x47\x45\x76\x1e\xb8\xf0\x86\xbb\x1f\x0b\x8a\x4d\xa0\x66\x4b\x48\x2b\x6c\x3c\x61\x96\x07\x4e\xe0\x35\xb7\x28\x4d\x8a\x8d\x20\xac\x39\xec\x2b\x6c\x3c\x3d\x3f\x04\x04\x5e\xd3\x06\x43\x4b\xc5\x66\x50\xa3\x21\x2c\xc6\xd8\x78\x55\x3a\xf1\xe0\x35\x1e\xa4\x8e\x8e\x0d\x21\xa2\x21\xac\x42\x6c\x38\x61\xcc\x27\x1e\xbc\xa1\xf4\x18\x36\x86\xd8\x4d\xe1\xc2\xb0\xe1\xac\x65\x2f\xb1\xe1\x35\x32\x5b\x46\xd8\x18\xc2\x49\xe1\x70\xc2\xfa\xa8\x46\x16\x39\x55\x35\x1e\x57\x79\x64\x4a\x81\x5f\xa0\x66\x65\x4f\x81\x37\xec\xe0\xe2\x18\xd8\x18\x32\x4f\x61\x5b\x62\x7d\xc4\xae\x1c\x2e\x46\xa3\x5e\x6f\x74\x99\x87\x99\x8e\x5f\xa0\x9f\x2e\x1e\x81\x37\xbc\x6d\x29\x29\x36\x86\xa8\xf6\x30\x2a\xb1\x26\xaa\xdc\x6d\x16\xbd\x59\x3f\x50\x14\x25\xf0\x07\xa3\xcb\x3a\x92\xf8\x33\x86\xb3\xf2\x09\xbc\x91\xcc\xce\x96\x8a\xcd\x21\xc7\x0b\xe8\x6d\xb0\x16\x54\x9a\xce\x72\xe4\xc2\xff\x69\xa3\xf3\x4e\xc7\x4f\x51\xba\x5b\xf6\x09\xbc\xa1\xf5\x17\x3b\x6c\x12\x19\xae\xa0\xb7\xc6\x5a\xa8\xbb\xf5\x61\xe6\xda\xf0\x82\x3b\x5b\x31\xfc\x94\x2a\x37\x3d\x57\x83\xd7\x34\x72\x64\x31\x36\x89\x64\x17\x28\xce\x58\x07\x73\x3c\x1c\x05\x1c\x7e\xc4\xfd\x45\x15\xe3\x27\x8c\x6a\xa1\x70\x78\x23\x99\x0c\x33\x89\x4d\x22\x4f\x07\x18\x2c\xb1\x0e\xe1\x36\xd0\xe0\x0d\x2d\xd8\xe6\xf8\x31\x1a\xa5\x13\x0d\xde\xf2\xf7\x15\xc5\x46\x91\xe5\x16\x66\x4b\xbc\xbd\xf8\xb4\x0a\x3c\x78\x87\xe7\xa7\x95\xc4\x0f\x88\x68\x39\x4b\xe0\x0d\xa2\x6c\xc7\x31\x36\x8b\xdc\x8c\xa0\x3f\xc7\x5b\xa3\xc6\xf8\xe2\xc3\xfb\xc8\x2c\x8d\x24\xbe\x4b\x18\x9b\x2d\x87\x37\x34\x5e\xcc\x75\x6c\x18\x7d\xd9\x83\x60\x8a\xb7\x46\xd9\x2a\xe0\xf0\x3e\xcd\x1b\x2c\x77\xf8\x2e\xc3\x39\x28\x04\xde\x20\x49\x9a\x0b\x6c\x18\x7d\x38\x80\x24\xc5\xdb\xa2\x71\xb5\xe8\x73\xf8\x90\xdb\x5b\x1b\x02\xdf\xe1\x0c\x67\x5c\x83\x37\x94\xde\x29\x56\xb1\x61\x8c\xb4\x0f\xf6\x82\xe2\x4d\xd1\x7c\xef\xc3\xa7\x0e\x3b\x1d\xdf\xa0\xea\x66\xe0\xc2\x1b\x1a\x29\x96\x16\x36\x8e\xb1\x50\x80\x5c\x74\x81\x37\x24\x8c\xb2\xa7\xc0\xa7\x8a\xa5\x85\x6f\xc4\xec\xe2\x72\x78\x4d\xe3\x7e\x1a\xe9\xd8\x38\xf1\x21\x01\x38\x98\x12\x6f\x48\xc4\xa7\x43\x9f\x13\xf8\x44\xb0\xad\x24\xc5\x1f\x50\x3d\x5f\xf4\xe1\x2d\xa2\x6c\x4f\xd8\x3c\xd4\x3c\xda\x00\xc7\x9d\x81\x37\x44\x55\x63\xb7\xf7\x39\x7c\x4c\xb3\x83\x73\xa6\xe2\x0f\xd4\xdd\xdc\xe7\xf0\x96\x3d\x59\x5b\xd8\x3c\xd2\xea\x69\x00\xa3\x2a\xc6\x9b\x52\xe5\x38\x9d\x24\xf0\x09\x6f\x31\x16\xf8\x92\x88\xcf\x23\x0f\xde\x22\xfd\x85\xa3\x63\xf3\xe8\x4e\x01\x00\x3d\x96\xe1\xad\x45\xab\x3e\xd1\xe0\x43\xbc\x98\x1b\x14\x5f\x30\x9c\x83\x4b\xe0\x0d\xc2\x0f\x27\x1d\x1b\xc8\x0c\x07\x00\x50\xac\x2d\xbc\x35\xdd\x99\xfa\x1e\x7c\x88\xb8\x5b\xc7\xc0\x17\x9c\xe9\x8c\x6b\xf0\x86\x3b\x5b\x9b\x02\x1b\xc8\xda\xcc\x00\x60\x36\x8f\xf0\xf6\xaa\x45\x9f\x6b\xf0\xa1\xd9\xc6\xc2\xef\x84\xb9\x1e\xb8\xf0\x86\x46\x26\x7b\x07\x1b\x69\xb7\x9c\x00\xc0\x24\xdd\xe1\xed\xc9\x6c\xa5\x10\xf8\x90\x9f\x56\xf8\x9d\xc1\x0e\x9c\xc0\x1b\x84\x1f\xab\x18\x1b\x29\x9f\xfa\x00\xd0\x5f\xe4\x58\x03\x11\xee\x03\x0e\x1f\x71\x7b\x27\xfc\x46\x77\xf6\x13\x78\x47\x32\x98\xc7\x12\x1b\xa9\x5a\x04\x00\x10\x6c\x2b\xac\x85\x73\x0c\x88\x06\xef\xe3\xca\x5c\x50\xfc\x97\xb5\x9e\x70\x78\xcb\xf6\xa7\x39\x36\x54\xb8\x55\x00\x34\xa5\x37\xc6\x5a\xc4\xe3\x95\xc2\xe1\x7d\x84\x2f\x76\x3a\xfe\xeb\x74\x70\x09\xbc\x15\x6c\x2b\x03\x9b\x89\x9e\x7a\x2e\x00\xb8\x33\x86\xb5\x50\xf5\x72\x1b\xd8\x1a\xbc\x6f\xb4\x31\xf1\x6f\xba\x95\xfa\x1c\xde\x51\xcc\x4d\x6c\xaa\xcd\x2c\x01\x00\xcf\x3f\x61\x3d\xa8\x19\x8e\x5c\x02\xef\x9b\xec\x23\xfc\x5b\xb4\x1c\x10\x0d\xde\xd2\x16\xb9\x8e\x4d\x75\xf6\x3d\x00\xe0\x6e\x89\x35\x91\xd9\xbc\xb0\xe1\x7d\x6e\x51\x09\x44\x11\x6f\x7a\x0a\xbc\xc3\x1d\xac\x63\x81\x4d\x35\x77\x6d\x00\x20\x7c\x2d\x28\xd6\x82\x8a\x7c\xaa\x70\x78\x9f\xb2\x89\x29\xea\xf9\x9e\xdb\xf0\x86\x46\x26\x53\x07\x9b\x8a\x8a\xa1\x4d\x00\x40\xb3\xe7\xb1\xc0\x7a\x50\x3d\xdc\x06\xf0\x3e\x37\xad\x54\x34\x87\x03\xa2\xc1\x1b\x24\xd9\xe6\x31\x36\x95\x34\xf7\x44\x83\xbf\x90\x74\x27\xb1\x26\x34\x5b\x8f\x3c\x02\xef\xe1\xc5\x52\x98\xe1\x48\x81\xb7\x34\x3e\x1b\x1a\x02\x9b\xca\x70\x2e\xf0\x0f\x72\x09\x75\xac\x8b\x88\xa7\x01\x87\xf7\x10\x7e\x91\xe3\x85\xa2\xc1\x3b\xdc\x45\x48\xb1\xb1\xcc\x72\x04\xff\x20\xa3\xb5\x81\x35\xa1\xaa\x60\x2b\x05\xde\xa3\x91\xd1\x6e\xda\xe7\xf0\x8e\xa4\x28\x4d\x6c\x2e\x6b\x5e\xc0\x3f\xc8\x60\x68\x62\x7d\xac\x72\xc0\x35\x78\xcf\x6c\xdd\x23\x1a\xbc\xa3\xbf\xb0\xb0\xc1\xa2\x7d\x1f\xfe\xa1\xf9\x8b\x0c\xeb\x23\xad\x74\x42\xe0\x1d\x9a\x72\xf4\x35\x78\xcf\xb1\x8c\xb1\xc1\x9c\x91\x02\xff\xd0\xdc\xa3\x45\xb1\x3e\x3a\xbb\x78\x04\xde\xe1\x05\x09\xbc\xc3\x0b\x86\x96\xc4\xe6\xa2\xd5\xcc\x83\x7f\x91\x22\x52\xb1\x3e\xc2\x3c\x07\x1c\xbe\x2e\xd8\x86\xd8\x64\x82\x05\xf0\x1f\x32\x09\x63\xac\x0f\x95\xd5\xca\x87\xaf\xd2\xec\xc1\xda\xc2\x06\xa3\xe6\x5a\x81\x6f\xfa\x67\x0b\xeb\x94\x9d\x46\x1e\x81\xaf\xb1\x95\x4b\xa4\x63\x83\xa9\xce\xd4\x85\x6f\x82\x69\x8e\x75\x12\xc6\xb0\xcf\xe1\x6b\xbc\xd1\x5a\x57\xb1\xc1\x44\xb8\x4a\xe0\x1b\x65\x15\x62\xbd\xd8\xc1\x85\x2f\xe1\xfe\xd0\x51\xb1\xc9\xc4\x7a\xe4\xc1\x37\xee\xa8\xc4\x7a\x45\x4b\x9f\xc0\x57\x28\xbd\x4a\x50\x6c\x32\x31\x9c\x71\xf8\xc6\x9b\x9d\xb1\x5e\x7a\x35\x52\xe0\x2b\x06\x43\x4b\xc5\x46\x93\xfb\xc0\x86\x6f\xb8\x32\x14\x14\x6b\x95\x4d\x67\xf0\x73\x76\xb2\xaa\x0c\x6c\x34\xd5\x38\x78\x04\xbe\xb3\x17\x86\xc0\x5a\x19\xa7\x2d\xfc\x9c\x3b\x58\x4b\x15\x1b\x4d\xb7\x46\x04\x5e\x38\x44\x3a\xd6\x4a\x58\x43\x85\xc3\xcf\xf8\x69\x8e\x0d\x67\x8e\x0b\x78\x69\x14\x9a\x58\x33\x56\xb8\xf0\x13\xbc\x08\x4d\x6c\xb8\xe8\x3c\x83\x97\x06\xf3\x08\x6b\xe6\xa4\x7d\xf8\x9c\x3d\x9b\x66\x12\x1b\x2e\x4f\x7d\x78\xc9\xbf\x38\x58\x2f\x6a\x86\x23\x4e\xe0\x13\xc4\x5d\x31\x89\x4d\xc7\x46\x0a\xbc\xe4\x0e\xc6\x58\x33\x55\x4f\x03\x1b\x3e\xe1\xf9\x6b\x53\xc5\xa6\x5b\x07\x1e\xbc\xc4\x83\x75\xac\x62\xad\xa8\xba\xee\x25\xf0\x31\xcd\x5f\x55\x92\x62\xb3\x09\x63\xe8\xd9\xf0\x03\x3e\xdd\x09\xac\x17\xad\x52\x85\xc0\x47\x08\x1f\x95\x19\x36\x9d\x8c\xf6\xf0\x0a\xbf\x30\x89\x35\x33\x4f\x33\x0f\x3e\xc2\xfd\xa1\x29\xb1\xe1\x68\x7c\x3a\xc2\x2b\x76\xb1\x34\xb0\x66\xc2\xb9\xf8\xf0\x11\x77\x7b\xc2\xe6\xcb\x86\x03\x78\xc5\xf6\xf7\x31\xd6\x2d\x5b\x16\xf0\x91\xfe\x32\xc2\xe6\x8b\x2e\x3e\xbc\x42\x92\xd1\x4e\x62\xcd\x8c\xea\x02\xef\x23\xc1\x21\x37\xb0\xf1\x44\x5e\x24\xf0\x9a\x36\x63\x26\xd6\x4c\x98\x53\x4e\xe0\x1d\x5c\x39\x9e\x0d\x6c\x3e\x9d\xf9\x04\xde\xe8\xcf\x77\x58\x33\x2a\x36\x93\x04\xde\xd1\xdf\x87\xa6\xc0\xc6\xa3\xd1\x5c\x81\xb7\x82\xd5\x18\x6b\x17\x6e\x03\x78\xcd\x56\x7a\xd3\xb1\x89\x2d\x40\xc3\x85\x0b\x6f\xb9\xc5\x06\x6b\xe7\x4c\xfb\xf0\x8a\x96\x14\x63\x03\x5b\x81\xae\x47\x09\xbc\xc5\x95\xa1\x2e\xb0\x66\x59\x39\x80\x1f\xb9\xb3\x34\x34\x05\xb6\xc3\xb0\xcf\xe1\x2d\x62\x2f\x2c\x89\x35\xd3\x77\x23\x5b\x83\xef\x08\x0f\x7a\x53\x07\x5b\x42\xe8\x0b\xd7\x86\x77\x68\xc7\x30\xc6\x9a\x09\x63\xa1\xd8\xf0\x1d\xf7\x53\x66\x4a\x6c\x09\x63\x77\xb4\x35\x78\xcf\x60\x6e\x61\xdd\xe4\x7c\xc6\xe1\x3f\x5e\x70\x9c\x57\x26\xb6\x86\x55\x16\xf0\x2e\xcd\xbf\x38\x14\x6b\x26\xca\x43\x02\xff\x20\xb6\xbf\x1d\x63\x9b\xec\xa6\x13\x78\x5f\x32\x39\xe9\x14\xeb\xa5\xe6\x53\x17\xfe\x66\xbb\xbd\x61\x65\x62\x9b\x54\xdb\x00\xde\x47\xdc\xb9\xa5\x62\xbd\x54\x73\xad\x10\x00\x3b\xe9\x1f\x97\x8e\x8a\x2d\xa2\xca\xcd\x24\x81\x0f\x24\xab\x50\x60\xcd\x44\xe8\xdb\x00\x6e\x31\xcc\x4d\x89\x6d\x22\xa3\x29\x27\xf0\x01\x5e\x2c\x25\xd6\xcd\x19\x29\xf6\xe4\xb2\x76\x0c\x81\xad\x62\x9c\xb6\xf0\x21\x3b\xd8\xc7\x2a\xd6\x2c\x4a\x07\xca\x7e\x2c\xb1\x65\x68\x36\x1c\xc0\x87\x08\x1f\x55\x31\xd6\xcc\xdc\x2c\x97\x79\xac\x62\xcb\x48\xe7\xa8\xc0\x27\x26\xe7\x08\x6b\xa6\xef\x76\x96\xc4\xd6\xc9\xca\x19\x87\x8f\x69\xc1\x6a\x8c\x35\xa3\x42\xa8\x14\x5b\x27\x4f\x03\x0d\x3e\x91\x0c\x36\x82\x62\xe7\xe7\x36\xa3\x04\x3e\xa1\xd9\xc9\x30\x16\xd8\xf9\x19\x55\x0e\x7d\x0e\x9f\x21\xf6\x65\x6c\x60\xe7\x67\x8c\x7c\xcb\x09\x7c\xae\x58\x66\xd8\xf9\x09\x6a\x2d\x07\xf0\x33\xfe\x2a\xc2\xce\xcf\xe4\x17\x1f\x7e\x26\x19\x84\x06\xc5\xce\x67\x54\x63\xd3\x4f\xe0\xa7\x94\xa5\x45\xb1\xf3\x19\x11\x4d\x39\xfc\x9c\xbb\x08\x55\xec\x7c\x82\xea\xe5\xd6\x86\x9f\xf3\x8a\xa5\x54\xb1\xf3\x31\x6a\x4e\x67\x04\x7e\x8e\xb8\x2b\x53\x60\xe7\x63\xc2\x3a\xba\x1a\xfc\x1c\xe1\x45\x99\x61\xe7\x63\xd6\x66\xc6\x35\xf8\x02\xcd\xdf\xe7\x14\x3b\x1f\x1a\xef\x03\xf8\x9a\x64\x56\x0a\x8a\x9d\x8f\xac\x07\x09\x7c\x0d\x77\xa7\x91\xc4\xce\xfb\x74\x6b\xef\x72\xf8\xaa\xd1\x26\xc6\xce\xfb\xb2\x4d\x0f\xbe\x6e\xb2\xb7\xb0\xf3\x3e\x67\xd5\x87\xaf\x73\x7b\x63\x1d\x3b\xef\xa0\xc6\x69\x96\xc0\xd7\x91\x60\xe8\x60\xe7\x2d\x2a\xf2\x34\x21\xf0\x0b\xdc\x6d\x89\x9d\x77\xe8\xeb\x11\x87\x5f\xc1\xfb\x43\xa1\x62\xe7\x35\xd5\xdc\xfb\x04\x7e\x05\xf1\xb6\x79\x8c\x9d\xd7\x32\x56\x78\x1a\xfc\x9a\xd9\x3c\xc2\xce\x6b\x79\xea\xc3\xaf\x0a\x46\x63\xec\xbc\x56\x16\x2e\xfc\x2a\x2f\x58\x66\x02\x3b\x2f\x50\x69\xa5\x0a\x87\x5f\x46\x2e\xa1\x8e\x9d\x97\xcc\xd3\x08\x7e\x83\x36\x9b\x9a\xd8\x79\x29\xda\x4f\xe0\x77\xb8\xa3\x71\x8c\x9d\xef\xa8\xc1\x0a\x17\x7e\x07\x09\xa6\x39\x76\xbe\x13\xbb\xa1\x4b\xe0\xb7\x24\xc5\x59\x17\xd8\xf9\x8f\xd8\x1c\x3d\xf8\x3d\xb6\xbb\x8a\x74\xec\xfc\x4b\x9a\x7b\xdf\x86\xdf\x43\xec\x62\x69\x61\xe7\x5f\xd6\xa6\xe0\x04\x7e\x97\x32\x62\xba\x8a\x1d\x44\x55\xb2\x43\x00\x7f\x80\x0f\x23\x81\x1d\x44\x11\x0f\x15\x0e\x7f\xc0\x3e\x6e\x74\xec\x20\xc6\xe5\x96\x13\xf8\x03\xc4\x5f\x44\x12\x3b\xd2\x59\xf4\xe1\x8f\x68\x7c\xb0\xb1\xf0\xe9\x51\x6b\xdd\xe7\xf0\x87\x94\x55\x48\x29\x3e\x39\x1a\xae\x5c\xf8\x53\x5e\x7f\x2e\x28\x3e\x37\x2a\x86\x13\x0e\x7f\xca\x4e\x0e\x61\x8c\xcf\xcd\x0c\x8f\x09\x81\x3f\x37\xd9\x3b\x82\xe2\xf3\xa2\xa2\xda\xf7\xe1\x1a\x92\xc9\x3a\x53\xf1\x79\x09\xe3\xdc\x4f\xe0\x1a\x88\xbb\x62\x12\x9f\x97\x3e\x5e\x79\x36\x5c\x85\x3d\x99\xc6\x2a\x3e\x2b\x35\x9b\x0e\xe0\x4a\x48\x52\x6c\x2c\x7c\x52\xd4\x3c\x15\x09\x5c\x8d\xb2\x3d\xe9\x2a\x3e\x23\x4a\xab\x54\x81\xab\xd1\x6c\x37\x8d\x24\x3e\x23\x2a\x96\x03\x0f\xae\x47\xb3\x7b\xe7\x0c\x9f\x91\x19\x6e\x5d\x1b\xae\x29\x38\x56\x12\x9f\x0e\x15\xd5\xca\x87\xeb\xe2\xfe\x34\x57\xf1\xd9\xc8\xdd\x30\xf0\xe0\xca\x78\x31\x37\x05\x3e\x17\x91\x9d\x7b\x04\xae\x8d\xf0\x51\x15\xe3\x73\x31\xaa\x91\xab\xc1\xd5\x91\x7e\x9a\xe3\x73\xc9\xd3\x3e\x87\x1b\x48\x66\x6b\x5d\xc5\xe7\x21\x8c\xe5\x20\x81\x5b\xb0\xbd\x03\x8b\xf1\x79\x64\xe5\x31\xb1\xe1\x36\xfa\xab\xdc\xc0\x27\xa1\x1a\xe1\xc1\x87\x5b\xd1\x82\x79\x84\x4f\x42\xec\xa6\x09\x81\x9b\x49\x7a\xe7\x58\xe0\x33\x50\xe3\x65\x8f\xc3\xed\xd8\xee\xb6\x32\xf1\x19\x18\xf9\x56\x21\x70\x3b\x9a\xdd\x5f\xe5\xf8\x0c\xaa\xb4\x6f\x6b\x70\x4b\x5e\x7f\xb8\x93\xf8\xe8\xa4\x35\x9f\x25\x70\x6b\xc5\xdc\xa4\xf8\xd8\xa8\x59\x8e\xe0\xe6\x34\xb7\xb7\xb1\xf0\xa1\xa9\x31\x3b\x06\x70\x7b\x9a\x7b\x28\x75\x81\x8f\x8b\xca\x2a\x55\x6c\xa8\x81\xed\x5e\x1c\x03\x1f\x16\x15\xd9\x74\xc6\x35\xa8\x81\x46\x66\xd3\x5c\x52\x7c\x54\xd1\xba\xe7\x12\xa8\x47\xd2\x3f\x67\x02\x1f\x13\x15\x65\x4f\x81\xba\xd8\xc9\x71\x63\xe0\x63\x8a\xd9\x4a\xe1\x50\x1b\x12\x5c\x72\x03\x1f\x10\x35\xaa\x45\x1f\xea\x64\xfb\xfb\x0a\x1f\x10\xcd\xa7\x01\x87\x5a\x25\x93\xa9\x63\xe0\xa3\xd1\xad\xe1\x80\x43\xdd\x06\xf3\x9d\xa0\xf8\x48\x28\xb5\xca\x01\xd4\x2f\x99\x2d\x23\x81\x8f\x44\xd5\x37\xb3\x04\xee\x80\x8f\x96\x99\xc4\x07\x12\x9f\xb6\x70\x1f\xbc\x60\x19\x3e\x90\xdd\x80\xc3\x9d\xb8\xa3\x8d\x21\xf0\x51\xb0\x8b\x0b\xf7\xa2\xc1\xa1\x8a\x29\x3e\x04\xdd\xda\xda\x1a\xdc\x4f\x30\xaa\x24\xc5\x47\x30\x1e\x05\x1a\xdc\x91\xed\xa6\x95\xc0\xf6\xd3\xab\x05\x27\x70\x57\xc4\x5d\x48\x6c\x3f\x6b\x94\x68\x70\x5f\x1a\x9f\x4c\x1d\x6c\xbb\x70\x11\xd8\x70\x77\x3c\x18\x66\x02\xdb\x4c\xb7\x16\xae\x0d\xf7\x47\xf8\x60\x6a\x61\x9b\xe5\x07\xdf\xd6\xa0\x09\xdc\xd9\x30\xa7\xd8\x56\xb2\x4a\x03\x0e\x0d\x61\xbb\x7b\x95\x62\x3b\x51\x73\x15\x10\x68\x0a\xc2\x27\x8b\xb1\xa4\xd8\x46\x55\x3a\xf1\x34\x68\x0e\x3b\xd9\xe7\xb1\xc0\xd6\xd1\x77\xa9\xcf\xa1\x49\x34\xa2\x8c\x98\x89\xad\xe3\x6c\x7d\x4e\xa0\x59\x88\xb2\x5d\x5b\x3a\xb6\x89\x88\xd9\x22\xe0\xd0\x40\xc5\xda\x52\x29\xb6\x05\x55\xe3\xaa\xc7\x35\x68\x22\x77\x30\x77\x24\xb6\x85\xbe\x9b\x17\x2e\x81\x66\x22\xc5\x70\xa7\x63\x2b\x50\x23\x9f\x17\xa0\x41\x43\x11\x3e\x19\x3a\xd8\x0a\xea\x78\xef\x73\x68\xb0\x64\x30\xcd\x0d\x6c\x3e\x33\x5c\x4c\x38\x34\xdb\x2c\xcd\xa5\x4a\xb1\xd1\x54\x19\xae\x7c\x68\xba\xa4\xbf\x2a\x4d\x81\x8d\x16\x97\x97\xc0\x83\xc6\xb3\xfd\xc3\x66\x67\x08\x6c\x2a\x55\x5a\xa7\x83\x0f\x6d\x60\xbb\x83\xa1\xa3\x63\x53\x49\x73\xd9\x73\x6d\x68\x05\xdb\x1d\x2c\x4e\x96\x8a\x4d\x24\xb2\x70\xda\x53\x6c\x68\x0b\xdb\xbd\x94\xa6\xc0\xe6\x11\x19\x5b\x04\x1e\xb4\x87\x66\x2b\xbd\xf9\x0e\x9b\x67\xb7\xec\x05\x9c\x40\xab\xb8\xc5\xdc\x89\x05\x36\x08\x55\x63\x67\xde\x4b\xa0\x65\x34\x42\x26\xab\xca\xa0\xd8\x1c\x54\x56\x8b\x89\xad\x41\xfb\x24\xfd\xcb\x39\x32\xb0\x29\xf4\x68\xbd\xea\x27\xd0\x4a\xb6\xdb\x5b\xe6\x86\x8a\x4d\x20\x74\xe7\x3c\x52\x08\xb4\x93\x66\x27\xfe\x82\x19\xd8\x04\xf1\x78\x3f\x71\x39\xb4\x58\xff\xb2\x71\x62\x89\xf7\x25\x64\x54\xae\x26\x9c\x40\xab\x79\xb3\x34\x8f\xf1\xbe\x64\xb6\xec\x71\x68\x3b\xe2\x4e\x46\xf3\x5c\xe2\xfd\xe8\xbb\xcd\xa2\x50\x08\xb4\x1f\xb1\x8b\x69\x6e\x4a\x15\xef\x41\x15\x71\x3e\x3f\x2a\x1c\x1e\x02\xf1\xfc\xed\x39\xd2\xf1\x1e\x64\xb6\xb9\xf8\x89\x4d\xe0\x41\xf0\xa0\x97\x32\x4b\x60\xdd\xa4\x15\x0e\x8f\xbe\x0d\x0f\x44\xb3\x93\x6d\x69\xa8\x2a\xc5\xfa\x50\x2a\xb2\xf2\xa0\x70\x78\x2c\xc4\x0e\x7a\xd3\x30\x93\x58\x1f\x69\xb2\x69\x11\x70\x02\x0f\x27\x99\xac\xd6\xb9\x29\x55\xac\x85\x88\x9d\xcd\x6a\xc2\xe1\x11\x11\xee\xfa\xab\x8d\xa5\x63\x2d\x0c\xb6\xef\xbb\x9c\xc0\x83\xe2\xfe\x28\xdd\x38\xb1\xc0\x1b\x33\xa2\x70\xbe\x9d\x70\x02\x0f\xcc\x4e\x7a\xc3\xca\x94\x2a\xde\x8e\x2a\x75\x67\xb9\xf5\x39\x3c\x36\x62\xbb\x93\xe3\x94\x65\x14\x6f\x85\x66\xe1\xf0\x30\x51\x3c\x02\x0f\x8f\x24\x93\xcb\x39\xb7\x0c\xbc\x01\xd5\xb0\x9c\xf3\x6a\xe0\x6a\xf0\x0c\x34\x62\x7b\xfd\xed\xd2\xc1\x1b\x10\xf9\x72\xdb\xf7\x38\x81\xa7\xe1\x05\xc5\x62\x5d\x65\x02\xaf\x49\x5a\xe3\xf3\xaa\x08\x3c\x78\x32\xee\x20\x3d\x59\x86\x14\x14\xaf\x42\x48\x23\xda\x2c\x66\x09\x3c\x1f\x9e\x04\x83\xd5\x3c\xcc\x04\xc5\x6b\xc8\xc6\xcb\xcb\x2c\x48\x6c\x78\x46\x1a\x0f\x8a\xd5\x32\xdc\x65\x52\xc5\x3f\xa1\x4a\x73\x37\x5e\xae\x8a\xc0\x86\x67\xa5\x11\x9b\x07\x45\x7a\xca\x24\xfe\x09\x69\xb2\xb4\x17\x70\x9b\x68\xf0\xc4\x34\xee\x4e\x46\xab\x65\x18\x19\xf8\x7b\x8c\x28\x5c\x2e\x46\x13\x85\xc3\xd3\xd3\x80\x78\x83\xd5\xb9\x32\x0d\x49\xf1\xd7\x50\x69\x98\xd5\x79\x35\xf0\x6c\xe8\xfc\x4d\x23\x89\xe2\x8f\xd2\x72\xa7\xe2\xaf\x91\xbb\x4d\xda\xf3\x95\x84\x68\xd0\xf9\xce\x9d\x1c\xa7\xe5\x78\x97\xe9\xf8\x25\x54\x37\x77\x55\x99\x1e\x27\x2e\x74\x7e\xa4\x69\xc4\xf3\x47\xd3\x93\x85\x5f\x22\xac\x70\xb8\xed\x7b\x44\x83\xce\x5b\xb6\x17\x4c\x8a\xc3\x74\x3d\x8e\x0c\xfc\x04\xd5\xad\x6a\x33\xbc\xf4\x66\x41\x62\x43\xe7\x43\x5e\xd0\xdb\x2f\xc3\x28\x8b\xa5\xc0\xb7\x54\x69\x64\xd1\x78\x9d\x8e\xfa\x1e\x74\x3e\x47\x78\xe2\x06\xb3\xe3\xb4\x8c\x0c\x7c\x83\xea\x16\x1b\x1e\x06\x81\x92\x70\x02\x9d\x9f\xd2\x34\xee\xce\x8e\xfb\x79\x39\xde\x59\xa6\x2e\xf0\x6f\x42\x37\xad\x5d\x75\x5a\xa6\xdb\x41\xc0\xa1\xf3\x65\x1a\x00\x24\xfd\xcb\x70\x33\x8e\x0c\xf1\xb7\x38\x1a\x6f\xe6\xab\x81\x0b\xa0\x41\xe7\x17\xd9\x49\x30\x19\x14\xa3\xe3\x65\x9f\xee\x2f\xdb\x51\x31\x98\xf8\x2e\x87\xc6\xfa\x1f\x07\x67\x27\x43\x69\x40\xfd\x61\x00\x00\x00\x00\x49\x45\x4e\x44\xae\x42\x60\x82"),
 		},
 		"/index.html": &vfsgen۰CompressedFileInfo{
 			name:             "index.html",
 			modTime:          time.Date(1970, 1, 1, 0, 0, 1, 0, time.UTC),
-			uncompressedSize: 4991,
+			uncompressedSize: 5402,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x58\x5d\x6b\xe3\xb8\x1a\xbe\xcf\xaf\xd0\xf8\x0c\x24\x85\xda\x6a\x99\x39\x30\x64\xec\x72\xa0\xd3\x03\x03\xcb\x4e\x99\x76\x77\x99\x4b\xd9\x7a\x63\xab\x91\x25\x8f\x24\x27\xcd\x96\xfe\xf7\x45\x92\x9d\xf8\x2b\x29\x33\x74\x59\x36\x37\xb6\xf4\x7e\x3f\x7a\xf4\x4a\x4e\xfc\xe6\xd3\x97\xeb\xfb\x6f\xb7\x37\xa8\x30\x25\xbf\x9a\xc5\xfe\x81\x50\x5c\x00\xa1\xf6\x05\xa1\xb8\x04\x43\x50\x56\x10\xa5\xc1\x24\x41\x6d\x56\xe1\x87\xa0\x2b\x12\xa4\x84\x24\xd8\x30\xd8\x56\x52\x99\x00\x65\x52\x18\x10\x26\x09\xb6\x8c\x9a\x22\xa1\xb0\x61\x19\x84\x6e\x70\x8e\x98\x60\x86\x11\x1e\xea\x8c\x70\x48\x2e\x5b\x47\x86\x19\x0e\x57\xb7\x4a\x1a\xc8\x65\x8c\xfd\xd0\x8b\x38\x13\x6b\xa4\x80\x27\x01\xcb\xa4\x08\x90\xd9\x55\x90\x04\xac\x24\x39\xe0\x4a\xe4\x01\x2a\x14\xac\x92\x00\x13\xad\xc1\x68\xbc\x22\x1b\xab\x17\x39\x11\x1e\xf9\xd0\x66\xc7\x41\x17\x00\xa6\x35\x2c\x8c\xa9\xf4\x12\xe3\x8c\x8a\xe8\x41\x53\xe0\x6c\xa3\x22\x01\x06\x8b\xaa\xc4\x69\xcd\x4b\xf2\xbf\x8b\xe8\x43\x74\x81\x33\xad\xfd\x38\x2a\x99\x88\x32\xad\xdb\xe4\x75\xa6\x58\x65\x10\x85\x15\x28\xa4\x55\x76\xf0\x59\x6b\x88\x56\x52\x18\xb2\x05\x2d\x4b\x88\x32\x59\x62\x05\x1c\x88\x06\x8d\x37\xff\x8d\xde\x45\x97\xf8\x41\x63\xc2\x79\xf4\xa0\x83\xab\x18\x7b\x57\xad\x5f\x9b\xab\x7f\x8f\x2a\x42\x43\xc5\xf2\xc2\xa0\x27\x37\x81\x50\x45\x28\x65\x22\xf7\xb3\x4b\x74\x79\x51\x3d\x7e\x74\xa2\x67\x6f\x8d\xf7\xe6\x31\x6e\x57\x33\x4e\x25\xdd\x5d\xcd\x66\xf6\x55\x43\x66\x98\x14\x28\xe3\x44\xeb\x24\x28\x40\x49\xc4\x74\x48\x89\x5a\xdb\xe7\xaa\xe6\xbc\x00\xeb\xbb\x2d\xd3\x3a\x01\x75\xd0\xb7\xa3\x46\x86\x50\x2c\xc8\xa6\x15\x09\xb2\x49\xc9\x41\x84\x50\x4c\xd9\x5e\x68\xd9\x41\x98\x80\xae\xbc\xaf\xe1\xcd\xc3\x54\x11\x41\x7b\x4a\x08\xc5\x64\xa0\xc4\x0c\x94\xa8\x20\x3a\x34\xf0\x68\xc2\x6d\xc1\x0c\xd8\xe4\x35\xfb\x13\xc2\xf7\x1d\x81\x2b\x24\x4c\x25\xa7\x7b\xbe\x0c\x5c\x3b\xf7\xac\xcc\xfd\x02\xb6\x6c\xaa\x3c\x23\x43\x2e\x73\xe9\x29\xd5\xc4\xdf\xaf\x47\xb0\x67\x2d\x2b\xf3\x41\xb6\x98\x0c\x26\x74\x45\x04\x52\x92\x43\x12\xa4\xb5\x31\x8e\xce\x24\x65\x82\xc2\x63\x12\x5c\x04\x43\x04\x6a\x95\x83\x42\xcd\xa3\x5f\x65\x80\x28\x31\x24\x34\x44\xe5\x76\x57\x36\x16\x25\x88\x7a\x54\x97\x8b\x6a\xb9\x65\x1f\xaf\x22\x1b\xcf\xc5\x98\xb2\xcd\x68\x41\x19\xed\x67\x36\xa8\x6f\x22\xdb\x09\x1e\xc0\x88\x05\x07\x28\xc7\x64\x98\x50\xed\x13\xc7\xe3\x6e\x49\xb2\x67\x8b\xac\x0d\x67\x02\xe8\xb0\x23\xe4\xcc\x14\x75\xea\x36\x6d\x9e\x4a\x2e\x5b\x36\x1c\x89\x31\x4a\xcb\xf5\xab\xe3\xba\x4e\x9f\xb5\xca\x2b\x82\x56\x24\x4c\xa5\x5c\xdb\x3e\xc0\x4e\x84\x98\x5c\xac\x41\x0a\x57\x9f\x64\xa6\x4f\x69\x8e\xb8\x79\xca\xf5\xdf\x09\x36\xd6\x5b\x92\xe7\x83\x76\x70\x22\xfc\x0f\x82\xaa\x2d\xaa\x9c\x54\x46\x56\x61\x26\x29\xbc\x0a\xb8\x77\x3e\xe5\x7f\x05\xbe\xff\x10\x99\x53\x8b\xbb\x8f\xf9\x2a\x90\xff\xce\x60\x8b\xee\x64\xad\x32\x78\x15\xd8\x07\x0d\xab\x37\x8c\xb1\x20\xcd\xc0\x1f\x9d\xa0\xae\x66\x7e\xd8\x69\x4f\xf6\xc0\x0c\xed\x79\xfa\xd2\x41\x77\xe8\xdc\x19\x08\x03\x0a\x46\xa7\x5a\xcf\x8a\xd7\xe5\x18\xe8\x9f\x73\xec\x2c\x8b\xcb\xd6\xd0\xdd\xaa\x02\xd7\x95\xfd\xeb\x14\x86\x7f\x58\x22\x71\xa6\x0d\xfa\x26\x6b\x85\x3e\xdf\x4e\xc0\x59\x5c\x4e\xc4\xe9\x64\x98\xca\xc7\x63\xd4\xed\x68\xad\x18\x70\x6a\x29\x9b\x2b\x59\x57\x93\xc9\xef\xcd\xaa\x6e\xf1\x4a\x72\x6b\x06\x8f\x15\x11\xf4\xa4\x9d\xb3\x65\xa2\xaa\xcd\x9e\xc6\x76\xd0\x5e\x22\x2b\xa2\xf5\x56\x2a\xea\x31\xd1\x90\x29\x7b\x2d\xac\x38\xc9\xa0\x90\x9c\x82\x4a\x82\x1b\x0b\x2c\xda\x59\x28\x1a\xf9\x29\x26\x57\x3f\x50\xc2\x4b\x69\x4f\x6c\x72\x7b\x37\x6b\x72\xad\xd3\x92\x99\xbb\x97\x32\x6a\x7f\x77\x4e\xfd\x74\xbc\xa9\x9d\xf3\x62\x65\xa3\x73\xff\xf4\xf4\x9b\x30\xec\x00\xa1\xeb\xf4\x38\x0f\xb3\x5a\x29\x10\x06\x69\x43\x4c\xad\x97\xa8\x16\x6b\x21\xb7\x62\x22\x54\x75\x85\xc2\x70\xb4\x61\xc6\x19\x1c\xdf\xf2\xcd\xab\xbb\x2a\x37\xd7\x62\x3f\x6a\x6e\xf6\xbd\x3b\x3d\x79\x20\x8f\x51\x2e\x65\xce\x81\x54\x4c\xbb\x8e\x6a\xe7\x30\x67\xa9\xc6\x0f\xdf\x6b\x50\x3b\xfc\x2e\x7a\x6f\xaf\xf6\x6e\xe0\x3e\x17\x86\xd7\xfb\xb8\x7b\xd3\xdf\x10\x85\xfc\x8a\xfe\x62\x3f\x54\x12\xf4\x76\x11\xfc\xa7\xb7\xc4\x67\x1f\x0f\x8a\x6e\xe6\xb3\x23\x75\xa3\x39\xd2\x71\xb0\x36\x52\x0f\xf1\xd9\xc7\xd9\x5e\x4a\xe5\x75\x41\x38\x07\x91\xc3\x57\xf8\x5e\x83\xb6\x8e\x56\xb5\x70\x85\x2f\xce\xf6\x5f\x18\x87\x94\x22\x62\x8c\x5a\x04\x94\x69\x92\x72\xa0\xc1\x39\x32\xaa\x86\x26\x20\x42\x6f\x23\x0b\xc0\xe2\xa9\x03\xb7\xdd\x60\x4b\x14\xdc\x7e\xb9\xbb\x0f\xce\x3b\xf3\xf6\xd6\x7a\xef\x65\x0f\x5a\x8a\x9e\xac\x56\x7c\x89\xe6\x98\x54\x0c\x6f\x2e\x71\xd6\xe6\x38\xef\xea\xf8\x76\xac\x97\xe8\xa9\xb7\xb6\xf3\xdf\x34\xa8\xd0\x83\x35\x5f\xf6\x50\x89\x36\x84\x2f\xce\x3a\xda\xcf\xe7\xb3\xce\x28\x85\x95\x54\x70\x07\x82\x2e\x3b\x18\x3c\x0d\xe8\xd4\x81\xc2\x36\xdc\xc5\xdc\x1a\x30\x91\xcf\xf7\x18\x34\x9e\xbb\x26\x59\x06\x5a\x2f\xa7\x90\x3d\xee\xd6\x1b\xf5\xdd\x0e\x34\x15\x94\x72\x03\xd7\x76\x0f\x2d\xe6\x9d\x2f\x36\x4a\x44\x0e\xea\xa4\x29\xa1\xf4\x60\xa7\x8f\xc5\x72\x8c\x69\x12\xfa\x7c\x6b\x4f\x19\x94\x02\x88\xc3\xd1\x00\x74\x7e\x76\xac\x6c\x50\x4a\xaa\x1f\x2b\xfa\xff\x84\x71\xeb\xf2\x27\x6a\x3e\x5a\xc3\xb1\xa2\x8f\x81\xd4\xab\x59\x64\x52\x29\xc8\x0c\x6a\x08\x35\x59\xec\xf3\x59\xfb\xb9\xed\x1e\x18\x23\x07\x8d\x40\x2b\x69\x3f\x92\x59\xb6\xd6\xc8\x48\xd4\xdd\xc6\xc8\xb7\xf2\xd9\x20\x41\x29\x16\x81\x33\x08\xce\x27\xf6\x66\xbb\x73\x07\x01\x0a\x5b\x88\x8b\xe0\xcf\x37\x77\x9a\x7a\xcf\x87\x06\xe1\x5c\xaf\x61\x57\x29\xd0\x3a\x38\x9f\x24\xf8\xa9\x5d\xbe\x22\x5c\x43\x07\xab\x09\xbe\xda\x89\xf9\xb4\xca\x78\xd1\x2c\xf6\x47\x96\xed\xf8\x82\xa9\x75\xab\xf7\x3c\x8d\xc5\xcd\xaf\xf7\x37\x5f\xd1\x1a\x76\xda\x28\xb9\x06\x64\x0f\xcb\x97\x31\xa9\xab\x2e\x20\xd0\x41\x84\xad\xd0\x02\xa2\x35\xec\xae\x25\x05\x94\x24\x09\xba\x7c\xd7\xa7\xf1\x78\x95\x16\x9d\x52\x9e\x67\x07\x7e\x1c\x7a\xbe\x1b\xf8\xff\x5f\x62\xec\xff\x67\xfb\x2b\x00\x00\xff\xff\x4c\xab\x95\x5f\x7f\x13\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x58\x6d\x6f\xe3\xb8\x11\xfe\xee\x5f\x31\xc7\x1e\x60\x07\x88\xc4\x0d\xf6\x5a\x2c\x1c\xc9\x28\x90\x4b\x81\x05\x8a\x6e\x70\x49\x5b\xdc\x47\x4a\x1c\x4b\x8c\x29\x52\x4b\x52\x76\xdc\x43\xfe\x7b\x41\xbd\xd8\x92\x2c\x3b\xdd\x45\xda\xe2\x62\x61\x45\x72\xde\x9f\x19\x0d\xc9\x8d\x7e\xf8\xf9\xcb\xdd\xd3\xaf\x0f\xf7\x90\xbb\x42\xae\x66\x51\xf3\x02\x88\x72\x64\xdc\x0f\x00\xa2\x02\x1d\x83\x34\x67\xc6\xa2\x8b\x49\xe5\xd6\xc1\x27\xd2\x27\x29\x56\x60\x4c\xb6\x02\x77\xa5\x36\x8e\x40\xaa\x95\x43\xe5\x62\xb2\x13\xdc\xe5\x31\xc7\xad\x48\x31\xa8\x27\xd7\x20\x94\x70\x82\xc9\xc0\xa6\x4c\x62\x7c\xd3\x29\x72\xc2\x49\x5c\x3d\x18\xed\x30\xd3\x11\x6d\xa6\x0d\x49\x0a\xb5\x01\x83\x32\x26\x22\xd5\x8a\x80\xdb\x97\x18\x13\x51\xb0\x0c\x69\xa9\x32\x02\xb9\xc1\x75\x4c\x28\xb3\x16\x9d\xa5\x6b\xb6\xf5\x7c\x61\x4d\xa2\x27\x3a\xac\xdb\x4b\xb4\x39\xa2\xeb\x04\x73\xe7\x4a\xbb\xa4\x34\xe5\x2a\x7c\xb6\x1c\xa5\xd8\x9a\x50\xa1\xa3\xaa\x2c\x68\x52\xc9\x82\xfd\xf9\x43\xf8\x29\xfc\x40\x53\x6b\x9b\x79\x58\x08\x15\xa6\xd6\x76\xce\xdb\xd4\x88\xd2\x01\xc7\x35\x1a\xb0\x26\x3d\xea\xac\x2c\x86\x6b\xad\x1c\xdb\xa1\xd5\x05\x86\xa9\x2e\xa8\x41\x89\xcc\xa2\xa5\xdb\x3f\x86\x1f\xc3\x1b\xfa\x6c\x29\x93\x32\x7c\xb6\x64\x15\xd1\x46\x55\xa7\xd7\xfb\xda\x8c\xc3\x92\xf1\xc0\x88\x2c\x77\xf0\x5b\xbd\x00\x50\x32\xce\x85\xca\x9a\xd5\x25\xdc\x7c\x28\x5f\x6e\x6b\xd2\x6b\xfd\x6f\x44\x0f\xe2\x11\xed\xb2\x19\x25\x9a\xef\x57\xb3\x99\x5f\xb4\x98\x3a\xa1\x15\xa4\x92\x59\x1b\x93\x1c\x8d\x06\x61\x03\xce\xcc\xc6\xbf\xd7\x95\x94\x39\x7a\xdd\x5d\x98\x5e\x09\x9a\x23\xbf\x9f\xb5\x34\x80\x48\xb1\x6d\x47\x52\x6c\x9b\xb0\x23\x09\x20\xe2\xe2\x40\xf4\xd5\xc1\x84\xea\x89\x8e\x39\x1a\xf1\x20\x31\x4c\xf1\x01\x13\x40\xc4\x46\x4c\xc2\x61\x01\x39\xb3\x81\xc3\x17\x17\xec\x72\xe1\xd0\x3b\x6f\xc5\xbf\x30\xf8\xa9\x47\xa8\x03\x09\x12\x2d\xf9\xa1\x5e\x46\xaa\xfd\x13\x89\x22\x6b\x12\xd8\x55\x53\xd9\x54\x64\x20\x75\xa6\x9b\x92\x6a\xed\x1f\xf2\x41\x0e\x55\x2b\x8a\x6c\xe4\x2d\x65\xa3\x05\x5b\x32\x05\x46\x4b\x8c\x49\x52\x39\x57\x97\x33\x4b\x84\xe2\xf8\x12\x93\x0f\x64\x14\x5c\x52\x99\x0c\x0d\xb4\xaf\x61\x94\x04\x38\x73\x2c\x70\xcc\x64\xe8\x0e\x12\x05\xaa\xea\x24\xae\xda\xaa\xaf\x2d\xff\x7a\x17\xda\xe9\x5a\x44\xb9\xd8\x0e\x16\x7c\xca\x05\x1f\x7a\x36\x8a\x6f\xc2\xdb\x89\x3a\xc0\x93\x2a\x38\x38\x37\xe2\xf4\xc5\x30\xc1\x3a\x2c\x9c\x06\x77\x5f\x24\x87\x6a\xd1\x95\x93\x42\x21\x1f\x77\x84\x4c\xb8\xbc\x4a\xea\x8f\x36\x4b\xb4\xd4\x5d\x35\x9c\xb1\x71\xe2\x96\xef\x43\x17\x78\xfd\x13\x89\x8e\x79\xcd\x60\xcd\x82\x44\xeb\x8d\xef\x03\xe2\x82\x89\x89\x84\xf4\x7f\xb5\x0b\xab\x9f\x75\x6a\xa7\x52\xd7\xfd\x9d\xd4\xe6\x25\xd5\xff\x4d\xb0\xa9\xdd\xb1\x2c\x1b\xb5\x83\x0b\xe6\xbf\x11\x54\xeb\x51\x95\xac\x74\xba\x0c\x52\xcd\xf1\x5d\xc0\x7d\x6c\x5c\xfe\x5d\xe0\xfb\x7f\x2a\xe6\xc4\xe3\xde\xd8\x7c\x17\xc8\xff\x21\x70\x07\x8f\xba\x32\x29\xbe\x0b\xec\xa3\x86\x35\x98\x46\x54\xb1\x76\xd2\x6c\x9d\x68\x56\xb3\xd9\xb8\x3d\xf9\x0d\x33\xf0\xfb\x69\x0f\x95\xc9\x8d\xee\xb8\x0d\xa5\xa8\x1c\x1a\x3c\xd9\xd5\x06\x52\xb2\x2a\x4e\x81\xfe\x3e\xc5\xfe\x17\xe5\x37\x9d\x60\x7d\xaa\x22\x75\x57\x6e\x86\xa7\xdc\x00\xff\xf4\x1f\xaa\x14\xd6\xc1\xaf\xba\x32\xf0\xf9\xe1\x54\x23\xcd\x6f\x26\xec\xf4\x3c\x4c\xf4\xcb\xa4\xee\x21\xd7\x5a\xa0\xe4\xbe\x76\x33\xa3\xab\x72\xd2\xf9\xee\x17\x95\x9d\x90\x0f\xde\x68\xe9\xc5\xf0\xa5\x64\x8a\x5f\x94\xf3\x4f\x24\x54\x59\xb9\x4e\xbe\x9e\x74\x87\xc8\x92\x59\xbb\xd3\x86\x37\x98\x58\x4c\x8d\x3f\x16\x96\x92\xa5\x98\x6b\xc9\xd1\xc4\xe4\xde\x67\x0c\xf6\x1e\x8a\x96\x7e\xc1\x4b\x5a\x7e\x43\x08\xdf\xef\xb6\x4f\x79\x9b\x46\xed\x4a\x02\x35\x67\xa1\x39\xc6\x44\x55\x05\x1a\x91\x12\x60\x95\xd3\xa9\x2e\x4a\x89\x0e\x63\xa2\x15\x06\x4e\x14\xd8\xb4\x40\x28\xd8\x8b\x44\x95\xb9\x3c\x26\x7f\x22\xe0\x0f\x4b\x31\xf9\x34\x0a\xfc\xe9\xcb\xd3\x03\xd4\xec\xff\xab\x80\x27\xba\x9a\x3f\x8c\xb6\xc9\xa9\x92\x42\xb8\xc7\xb7\x52\xd0\xfd\x3d\xd6\xec\x97\xed\x4d\xb5\x8a\x37\x23\x1b\xf5\x8d\xb7\x96\x7f\x08\x82\x1e\x10\xb6\x4a\xce\x7f\x78\x69\x65\x0c\x2a\x07\xd6\x31\x57\xd9\x25\x54\x6a\xa3\xf4\x4e\x9d\xea\xa4\xe5\x0a\x82\x60\x35\x7b\xd3\x83\xd1\x52\x6f\xda\x0e\xfd\x35\x80\xb6\xf7\x80\xba\xc3\x75\x57\x99\xc1\x25\x86\x3d\xb3\x97\x30\xd3\x3a\x93\xc8\x4a\x61\xeb\x2d\xc4\xaf\x51\x29\x12\x4b\x9f\xbf\x56\x68\xf6\xf4\x63\xf8\x93\xbf\xcb\xd4\x93\xfa\x7e\x34\xbe\xcf\xb4\xaa\xfd\x10\x60\xcb\x0c\xd8\x3a\x45\x7f\xf5\x37\xb3\x18\x7e\x5c\x90\x3f\x0c\x52\x7c\x75\x7b\x64\xac\x93\xfe\xd9\x17\x79\xc7\x79\xc2\xe3\xb4\x2b\xfb\x1c\x7e\x3e\xa0\x7b\xd8\x3b\x9a\x1f\x7b\xe2\x81\xca\xf5\x5d\xce\xa4\xff\x24\xf0\x17\xfc\x5a\xa1\xf5\x6a\xd6\x95\xaa\x81\x59\x5c\x1d\xae\x5c\x47\x97\x43\xe6\x9c\x59\x10\x2e\x2c\x4b\x24\x72\x72\x0d\xce\x54\xd8\x1a\x04\xf8\x31\xf4\x00\x2d\x3a\x39\xff\xf3\x9f\xee\x12\xc8\xc3\x97\xc7\x27\x72\xdd\x5b\xf7\xc7\xf8\xa7\x86\xf6\x6c\xb5\x1a\xd0\x2a\x23\x97\x30\xa7\xac\x14\x74\x7b\x43\xd3\xce\xc7\x79\x9f\xa7\xd9\x9f\xec\xf2\xe0\x64\xf3\xcc\xff\x6e\xd1\x04\x0d\x98\xf3\xe5\x00\xb5\x70\xcb\xe4\xe2\xaa\xaf\x03\x80\x52\xd0\x4a\xee\xc1\xe0\xd7\x4a\x18\xe4\xb0\xcb\x51\x81\xcb\x11\x2a\x8b\x06\x84\x05\x54\x46\x4b\x89\x1c\x84\x02\xdf\x1b\x26\xac\xf9\xe5\xd6\x56\x83\x7f\x63\xa9\xc7\xf9\x7a\x3d\xeb\xcd\x12\x5c\x6b\x83\x8f\xa8\xf8\xb2\x87\xf6\x30\x8e\x01\xe8\xbe\xf1\x2d\xe6\x5e\x40\xa8\x6c\x7e\x75\x3b\xd4\xdc\x0d\xbd\x48\x9a\xa2\xb5\xcb\xa9\x1c\x9e\x57\xdb\x08\x0d\xd5\x8e\x38\x0d\x16\x7a\x8b\x77\xbe\x8f\x2f\xe6\xbd\xcb\x32\x67\x2a\x43\x73\x51\x94\x71\x7e\x94\xb3\xe7\x6c\xd5\xed\xa1\x75\xe8\xf3\x83\x3f\x39\x40\x82\xa8\x8e\xbb\x32\xf2\xf9\x08\xd0\x6e\x08\x80\xc6\x68\xf3\x6d\x41\xff\x85\x09\x89\xfc\xd4\x8f\xff\x20\xe6\xb3\x31\x9c\x0b\xfa\x1c\x48\x83\x98\x55\xaa\x8d\xc1\xd4\x41\x5b\xba\x93\xc1\xbe\xb6\x3a\x5e\x9b\x6a\xa2\x14\x6a\x68\x14\xac\xb5\xff\xff\x09\x91\x6e\x2c\x38\xdd\x06\xd1\x28\x82\xe6\xa8\x3c\x1b\x39\xa8\xd5\x82\xd4\x02\xe4\x7a\xa2\x0b\x5c\xdd\x4e\x1a\xc8\x7d\x20\xb5\x85\x7a\xe7\x85\xfa\x20\x63\x1b\xd5\xc7\x5e\xe5\x83\x5f\x1c\xfa\xd2\x55\x6d\x6a\x83\xfb\xd2\xa0\xb5\xe4\xba\x97\xa5\xdf\x66\x53\xc8\x8d\xfb\xcb\x9a\x49\x7b\x6c\x30\x93\xf5\xeb\x17\xe6\xd3\x2c\xa7\x49\xf4\xb9\x38\x93\xc6\xf3\x09\x34\x9b\x8e\xef\x75\x1a\x9b\xfb\xbf\x3d\xdd\xff\x02\x1b\xdc\x5b\x67\xf4\x06\x41\xab\xef\xc1\xa8\x2a\xfb\x00\x61\x0f\x21\xb1\x86\x05\x86\x1b\xdc\xdf\x69\x8e\x10\xc7\x31\xdc\x7c\x1c\x96\xf9\x69\x16\x17\xbd\xd0\x5e\x0f\xce\x03\x1c\x77\x27\xbf\xf3\x51\x7f\x94\x5f\xcd\x22\x9a\xbb\x42\xae\x66\xff\x1e\x00\x69\x1a\xc9\xb9\x1a\x15\x00\x00"),
 		},
 	}
 	fs["/"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
@@ -81,6 +79,11 @@ func (fs vfsgen۰FS) Open(path string) (http.File, error) {
 			vfsgen۰CompressedFileInfo: f,
 			gr:                        gr,
 		}, nil
+	case *vfsgen۰FileInfo:
+		return &vfsgen۰File{
+			vfsgen۰FileInfo: f,
+			Reader:          bytes.NewReader(f.content),
+		}, nil
 	case *vfsgen۰DirInfo:
 		return &vfsgen۰Dir{
 			vfsgen۰DirInfo: f,
@@ -162,6 +165,37 @@ func (f *vfsgen۰CompressedFile) Close() error {
 	return f.gr.Close()
 }
 
+// vfsgen۰FileInfo is a static definition of an uncompressed file (because it's not worth gzip compressing).
+type vfsgen۰FileInfo struct {
+	name    string
+	modTime time.Time
+	content []byte
+}
+
+func (f *vfsgen۰FileInfo) Readdir(count int) ([]os.FileInfo, error) {
+	return nil, fmt.Errorf("cannot Readdir from file %s", f.name)
+}
+func (f *vfsgen۰FileInfo) Stat() (os.FileInfo, error) { return f, nil }
+
+func (f *vfsgen۰FileInfo) NotWorthGzipCompressing() {}
+
+func (f *vfsgen۰FileInfo) Name() string       { return f.name }
+func (f *vfsgen۰FileInfo) Size() int64        { return int64(len(f.content)) }
+func (f *vfsgen۰FileInfo) Mode() os.FileMode  { return 0444 }
+func (f *vfsgen۰FileInfo) ModTime() time.Time { return f.modTime }
+func (f *vfsgen۰FileInfo) IsDir() bool        { return false }
+func (f *vfsgen۰FileInfo) Sys() interface{}   { return nil }
+
+// vfsgen۰File is an opened file instance.
+type vfsgen۰File struct {
+	*vfsgen۰FileInfo
+	*bytes.Reader
+}
+
+func (f *vfsgen۰File) Close() error {
+	return nil
+}
+
 // vfsgen۰DirInfo is a static definition of a directory.
 type vfsgen۰DirInfo struct {
 	name    string
@@ -0,0 +1,254 @@
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/gbolo/protego/config"
+)
+
+// SchemaVersion is incremented whenever a field of Event is renamed or removed
+const SchemaVersion = 1
+
+// the number of events waiting to be written to the audit log, newer events are dropped
+const auditQueueSize = 1000
+
+// types of events
+const (
+	EventAuthorize     = "authorize"
+	EventChallenge     = "challenge"
+	EventChallengeFlag = "challenge_flag"
+	EventAdminAuth     = "admin_auth"
+	EventUserAdd       = "user_add"
+	EventUserUpdate    = "user_update"
+	EventUserRemove    = "user_remove"
+	EventACLRevoke     = "acl_revoke"
+	EventACLEvict      = "acl_evict"
+	EventDenyAdd       = "deny_add"
+	EventDenyRemove    = "deny_remove"
+	EventDBImport      = "db_import"
+	EventTokenAdd      = "token_add"
+	EventTokenRemove   = "token_remove"
+	EventGroupAdd      = "group_add"
+	EventGroupUpdate   = "group_update"
+	EventGroupRemove   = "group_remove"
+	EventHostSetAdd    = "hostset_add"
+	EventHostSetUpdate = "hostset_update"
+	EventHostSetRemove = "hostset_remove"
+
+	EventHostPolicyAdd    = "hostpolicy_add"
+	EventHostPolicyUpdate = "hostpolicy_update"
+	EventHostPolicyRemove = "hostpolicy_remove"
+
+	// a user passed a challenge from an IP which was not whitelisted for it
+	EventChallengeNewIP = "challenge_new_ip"
+	// a client failed too many challenges within a short period of time
+	EventChallengeBurst = "challenge_burst"
+	// a DNS name of a user resolves to a new IP
+	EventDDNSUpdate = "ddns_update"
+)
+
+// decisions taken for an event
+const (
+	DecisionAllow = "allow"
+	DecisionDeny  = "deny"
+)
+
+var (
+	log = config.GetLogger()
+
+	// sinks which receive every event
+	sinks    []Sink
+	sinkLock = new(sync.RWMutex)
+)
+
+// Event is a security relevant event.
+// the json representation of this struct is the audit log schema.
+type Event struct {
+	// the version of this schema
+	Version int `json:"version"`
+	// when the event occurred
+	Timestamp time.Time `json:"timestamp"`
+	// the type of event
+	Type string `json:"event_type"`
+	// the IP address of the client which caused the event
+	ClientIP string `json:"client_ip,omitempty"`
+	// the user affected by the event
+	UserID string `json:"user_id,omitempty"`
+	// the host (FQDN) the client requested
+	Host string `json:"host,omitempty"`
+	// the decision taken (allow or deny)
+	Decision string `json:"decision,omitempty"`
+	// the reason behind the decision
+	Reason string `json:"reason,omitempty"`
+	// the identity of the admin which performed the action
+	Actor string `json:"actor,omitempty"`
+	// what an action changed, when it is not a user (like a revoked or evicted IP, or a token name)
+	Target string `json:"target,omitempty"`
+	// the country (ISO 3166-1 alpha-2) the client is located in, when it was looked up
+	Country string `json:"country,omitempty"`
+}
+
+// Sink receives audit events
+type Sink interface {
+	Write(e *Event) error
+}
+
+// WriterSink writes events as json lines to an io.Writer
+type WriterSink struct {
+	w      io.Writer
+	closer io.Closer
+	lock   *sync.Mutex
+}
+
+// NewWriterSink returns a Sink which writes to w
+func NewWriterSink(w io.Writer) *WriterSink {
+	return &WriterSink{w: w, lock: new(sync.Mutex)}
+}
+
+// NewFileSink returns a Sink which appends to the file at path.
+// the value "-" is treated as stdout.
+func NewFileSink(path string) (*WriterSink, error) {
+	if path == "-" {
+		return NewWriterSink(os.Stdout), nil
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, err
+	}
+	sink := NewWriterSink(f)
+	sink.closer = f
+	return sink, nil
+}
+
+// Close closes the underlying file, if any
+func (s *WriterSink) Close() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.closer == nil {
+		return nil
+	}
+	return s.closer.Close()
+}
+
+func (s *WriterSink) Write(e *Event) error {
+	b, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	_, err = s.w.Write(append(b, '\n'))
+	return err
+}
+
+// AsyncSink writes events to another Sink in the background and in order,
+// so that a slow sink (like a file or a database) does not delay requests.
+// Write never blocks: the event is dropped when the queue is full
+type AsyncSink struct {
+	sink  Sink
+	queue chan Event
+	done  chan struct{}
+}
+
+// NewAsyncSink returns a Sink which queues up to queueSize events for s, and starts writing them
+func NewAsyncSink(s Sink, queueSize int) *AsyncSink {
+	a := &AsyncSink{
+		sink:  s,
+		queue: make(chan Event, queueSize),
+		done:  make(chan struct{}),
+	}
+	go a.run()
+	return a
+}
+
+// Write queues the event
+func (a *AsyncSink) Write(e *Event) error {
+	select {
+	case a.queue <- *e:
+		return nil
+	default:
+		return fmt.Errorf("audit queue is full, dropping %s event", e.Type)
+	}
+}
+
+// Close writes the events which are still queued, then closes the underlying sink.
+// Write must not be called afterwards
+func (a *AsyncSink) Close() error {
+	close(a.queue)
+	<-a.done
+	if c, ok := a.sink.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
+}
+
+// run writes queued events until the sink is closed
+func (a *AsyncSink) run() {
+	defer close(a.done)
+	for e := range a.queue {
+		if err := a.sink.Write(&e); err != nil {
+			log.Errorf("unable to write audit event: %v", err)
+		}
+	}
+}
+
+// Init configures the audit log and webhooks as defined in configuration
+func Init() error {
+	if err := initWebhooks(); err != nil {
+		return err
+	}
+	if !config.GetBool("audit.enabled") {
+		log.Debug("audit log not enabled")
+		return nil
+	}
+	path := config.GetString("audit.file")
+	sink, err := NewFileSink(path)
+	if err != nil {
+		return fmt.Errorf("unable to open audit log %s: %v", path, err)
+	}
+	log.Infof("audit log enabled: writing to %s", path)
+	AddSink(NewAsyncSink(sink, auditQueueSize))
+	return nil
+}
+
+// AddSink registers a sink which receives all future events
+func AddSink(s Sink) {
+	sinkLock.Lock()
+	defer sinkLock.Unlock()
+	sinks = append(sinks, s)
+}
+
+// Close closes and removes every registered sink
+func Close() {
+	sinkLock.Lock()
+	defer sinkLock.Unlock()
+	for _, s := range sinks {
+		if c, ok := s.(io.Closer); ok {
+			if err := c.Close(); err != nil {
+				log.Errorf("unable to close audit sink: %v", err)
+			}
+		}
+	}
+	sinks = nil
+}
+
+// Record sends an event to every registered sink.
+// Sinks which are slow (or may block) are asynchronous, see AsyncSink
+func Record(e Event) {
+	e.Version = SchemaVersion
+	if e.Timestamp.IsZero() {
+		e.Timestamp = time.Now().UTC()
+	}
+	sinkLock.RLock()
+	defer sinkLock.RUnlock()
+	for _, s := range sinks {
+		if err := s.Write(&e); err != nil {
+			log.Errorf("unable to write audit event: %v", err)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package audit
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+)
+
+// blockingSink keeps the events it receives, once it is unblocked
+type blockingSink struct {
+	// receives a value whenever Write is called
+	writing chan struct{}
+	unblock chan struct{}
+	events  []Event
+	closed  bool
+	lock    sync.Mutex
+}
+
+func (s *blockingSink) Write(e *Event) error {
+	s.writing <- struct{}{}
+	<-s.unblock
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.events = append(s.events, *e)
+	return nil
+}
+
+func (s *blockingSink) Close() error {
+	s.closed = true
+	return nil
+}
+
+func TestAsyncSink(t *testing.T) {
+	sink := &blockingSink{writing: make(chan struct{}, 10), unblock: make(chan struct{})}
+	async := NewAsyncSink(sink, 2)
+
+	// the first event is being written, the next two are queued, the last one is dropped
+	for i := 0; i < 4; i++ {
+		err := async.Write(&Event{Type: EventUserAdd, Target: strconv.Itoa(i)})
+		if i == 0 {
+			// wait for the first event to be picked up, so that it no longer takes room in the queue
+			<-sink.writing
+		}
+		if (err != nil) != (i == 3) {
+			t.Fatalf("expected only the 4th event to be dropped, got %v for event %d", err, i)
+		}
+	}
+
+	// Close writes the queued events, in order
+	close(sink.unblock)
+	if err := async.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if len(sink.events) != 3 || !sink.closed {
+		t.Fatalf("expected 3 events to be written and the sink to be closed, got %v (closed: %v)", sink.events, sink.closed)
+	}
+	for i, e := range sink.events {
+		if e.Target != strconv.Itoa(i) {
+			t.Fatalf("expected events in order, got %v", sink.events)
+		}
+	}
+}
@@ -0,0 +1,36 @@
+package audit
+
+import "time"
+
+// Filter selects audit events. Empty fields match every event.
+type Filter struct {
+	UserID   string
+	ClientIP string
+	Type     string
+	Decision string
+	// only events which occurred at or after Since
+	Since time.Time
+	// only events which occurred before Until
+	Until time.Time
+	// the maximum number of events to return, 0 means no limit
+	Limit int
+}
+
+// Match returns true when the event is selected by this filter
+func (f *Filter) Match(e *Event) bool {
+	switch {
+	case f.UserID != "" && f.UserID != e.UserID:
+		return false
+	case f.ClientIP != "" && f.ClientIP != e.ClientIP:
+		return false
+	case f.Type != "" && f.Type != e.Type:
+		return false
+	case f.Decision != "" && f.Decision != e.Decision:
+		return false
+	case !f.Since.IsZero() && e.Timestamp.Before(f.Since):
+		return false
+	case !f.Until.IsZero() && !e.Timestamp.Before(f.Until):
+		return false
+	}
+	return true
+}
@@ -0,0 +1,261 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gbolo/protego/config"
+	"github.com/gbolo/protego/metrics"
+)
+
+const (
+	// the number of events waiting to be delivered to a webhook, newer events are dropped
+	webhookQueueSize = 100
+	// matches every type of event in the events of a webhook
+	webhookAllEvents = "*"
+)
+
+// DefaultWebhookEvents are the types of events sent to a webhook which does not list any
+var DefaultWebhookEvents = []string{
+	EventChallengeNewIP,
+	EventChallengeBurst,
+	EventUserAdd,
+	EventUserRemove,
+	EventDDNSUpdate,
+}
+
+// WebhookEndpoint is the configuration of a webhook
+type WebhookEndpoint struct {
+	// the URL events are POSTed to
+	URL string `mapstructure:"url"`
+	// the key used to sign events, they are not signed when it is empty
+	Secret string `mapstructure:"secret"`
+	// the types of events sent to this webhook (DefaultWebhookEvents when empty, * for all)
+	Events []string `mapstructure:"events"`
+}
+
+// WebhookSink POSTs events as json to a webhook.
+// events are delivered in the background, in order, and retried with an exponential
+// backoff when the webhook is unavailable. The body is signed with HMAC-SHA256:
+//
+//	X-Protego-Timestamp: <unix time>
+//	X-Protego-Signature: sha256=<hex(hmac(secret, timestamp + "." + body))>
+type WebhookSink struct {
+	url        string
+	secret     []byte
+	events     map[string]bool
+	client     *http.Client
+	maxRetries int
+	backoff    time.Duration
+	queue      chan []byte
+	ctx        context.Context
+	cancel     context.CancelFunc
+	done       chan struct{}
+}
+
+// the body of a webhook request.
+// text is a summary of the event, which chat services (like Slack or Mattermost) display
+type webhookPayload struct {
+	Text  string `json:"text"`
+	Event *Event `json:"event"`
+}
+
+// NewWebhookSink returns a Sink which delivers events to a webhook, and starts delivering.
+// a request is abandoned after timeout, and retried up to maxRetries times
+func NewWebhookSink(endpoint WebhookEndpoint, timeout time.Duration, maxRetries int, backoff time.Duration) *WebhookSink {
+	events := endpoint.Events
+	if len(events) == 0 {
+		events = DefaultWebhookEvents
+	}
+	s := &WebhookSink{
+		url:        endpoint.URL,
+		secret:     []byte(endpoint.Secret),
+		events:     make(map[string]bool),
+		client:     &http.Client{Timeout: timeout},
+		maxRetries: maxRetries,
+		backoff:    backoff,
+		queue:      make(chan []byte, webhookQueueSize),
+		done:       make(chan struct{}),
+	}
+	for _, event := range events {
+		s.events[event] = true
+	}
+	s.ctx, s.cancel = context.WithCancel(context.Background())
+	go s.run()
+	return s
+}
+
+// initWebhooks registers a WebhookSink for every configured webhook
+func initWebhooks() error {
+	if !config.GetBool("webhooks.enabled") {
+		log.Debug("webhooks not enabled")
+		return nil
+	}
+	var endpoints []WebhookEndpoint
+	if err := config.UnmarshalKey("webhooks.endpoints", &endpoints); err != nil {
+		return fmt.Errorf("unable to read webhooks.endpoints: %v", err)
+	}
+	for _, endpoint := range endpoints {
+		if endpoint.Secret == "" {
+			log.Warningf("webhook %s has no secret, its events will not be signed", endpoint.URL)
+		}
+		AddSink(NewWebhookSink(
+			endpoint,
+			config.GetDuration("webhooks.timeout"),
+			config.GetInt("webhooks.max_retries"),
+			config.GetDuration("webhooks.retry_backoff"),
+		))
+	}
+	log.Infof("webhooks enabled: sending events to %d webhook(s)", len(endpoints))
+	return nil
+}
+
+// Write queues the event for delivery, when this webhook wants it.
+// it never blocks: the event is dropped when the queue is full
+func (s *WebhookSink) Write(e *Event) error {
+	if !s.events[e.Type] && !s.events[webhookAllEvents] {
+		return nil
+	}
+	body, err := json.Marshal(webhookPayload{Text: Summary(e), Event: e})
+	if err != nil {
+		return err
+	}
+	select {
+	case s.queue <- body:
+		return nil
+	default:
+		metrics.WebhookDeliveries.WithLabelValues("dropped").Inc()
+		return fmt.Errorf("queue of webhook %s is full, dropping %s event", s.url, e.Type)
+	}
+}
+
+// Close stops delivering events. Events which are still queued are dropped
+func (s *WebhookSink) Close() error {
+	s.cancel()
+	<-s.done
+	if dropped := len(s.queue); dropped > 0 {
+		metrics.WebhookDeliveries.WithLabelValues("dropped").Add(float64(dropped))
+		log.Warningf("dropped %d event(s) which were not delivered to webhook %s", dropped, s.url)
+	}
+	return nil
+}
+
+// run delivers queued events until the sink is closed
+func (s *WebhookSink) run() {
+	defer close(s.done)
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case body := <-s.queue:
+			s.deliver(body)
+		}
+	}
+}
+
+// deliver sends body to the webhook, and retries when the webhook is unavailable
+func (s *WebhookSink) deliver(body []byte) {
+	for attempt := 0; ; attempt++ {
+		retry, err := s.post(body)
+		if err == nil {
+			metrics.WebhookDeliveries.WithLabelValues("success").Inc()
+			return
+		}
+		if !retry || attempt >= s.maxRetries {
+			metrics.WebhookDeliveries.WithLabelValues("failure").Inc()
+			log.Errorf("unable to deliver event to webhook %s after %d attempt(s): %v", s.url, attempt+1, err)
+			return
+		}
+		wait := s.backoff << uint(attempt)
+		log.Warningf("unable to deliver event to webhook %s, retrying in %v: %v", s.url, wait, err)
+		select {
+		case <-time.After(wait):
+		case <-s.ctx.Done():
+			metrics.WebhookDeliveries.WithLabelValues("dropped").Inc()
+			return
+		}
+	}
+}
+
+// post sends a single request. retry is true when the request failed
+// for a reason which may go away (like a network error or a 5xx response)
+func (s *WebhookSink) post(body []byte) (retry bool, err error) {
+	req, err := http.NewRequestWithContext(s.ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "protego")
+	req.Header.Set("X-Protego-Timestamp", timestamp)
+	if len(s.secret) > 0 {
+		req.Header.Set("X-Protego-Signature", "sha256="+Sign(s.secret, timestamp, body))
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return true, err
+	}
+	defer resp.Body.Close()
+	io.Copy(ioutil.Discard, resp.Body)
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return false, nil
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500:
+		return true, fmt.Errorf("webhook responded with %s", resp.Status)
+	default:
+		return false, fmt.Errorf("webhook responded with %s", resp.Status)
+	}
+}
+
+// Sign returns the hex encoded HMAC-SHA256 signature of a webhook request,
+// which receivers compare with the X-Protego-Signature header
+func Sign(secret []byte, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Summary returns a human readable description of an event
+func Summary(e *Event) string {
+	switch e.Type {
+	case EventChallengeNewIP:
+		if e.Country != "" {
+			return fmt.Sprintf("user %s passed a challenge from a new IP: %s (%s)", e.UserID, e.ClientIP, e.Country)
+		}
+		return fmt.Sprintf("user %s passed a challenge from a new IP: %s", e.UserID, e.ClientIP)
+	case EventChallengeBurst:
+		return fmt.Sprintf("too many failed challenges from %s (last reason: %s)", e.ClientIP, e.Reason)
+	case EventUserAdd:
+		return fmt.Sprintf("user %s was added by %s", e.UserID, e.Actor)
+	case EventUserRemove:
+		return fmt.Sprintf("user %s was removed by %s", e.UserID, e.Actor)
+	case EventDDNSUpdate:
+		return fmt.Sprintf("DNS name %s of user %s now resolves to %s", e.Target, e.UserID, e.ClientIP)
+	}
+	summary := fmt.Sprintf("%s event", e.Type)
+	for _, field := range []struct{ name, value string }{
+		{"user", e.UserID},
+		{"client IP", e.ClientIP},
+		{"host", e.Host},
+		{"decision", e.Decision},
+		{"reason", e.Reason},
+		{"target", e.Target},
+	} {
+		if field.value != "" {
+			summary += fmt.Sprintf(", %s: %s", field.name, field.value)
+		}
+	}
+	return summary
+}
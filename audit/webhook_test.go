@@ -0,0 +1,210 @@
+package audit
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+// webhookRequest is a request received by a testReceiver
+type webhookRequest struct {
+	header  http.Header
+	body    []byte
+	payload webhookPayload
+}
+
+// testReceiver is a webhook which responds with the status returned by respond,
+// called with the number of requests it received for the same event before
+type testReceiver struct {
+	*httptest.Server
+	respond  func(event *Event, attempt int) int
+	requests []webhookRequest
+	received chan webhookRequest
+	lock     sync.Mutex
+}
+
+func newTestReceiver(t *testing.T, respond func(event *Event, attempt int) int) *testReceiver {
+	r := &testReceiver{respond: respond, received: make(chan webhookRequest, 100)}
+	r.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := ioutil.ReadAll(req.Body)
+		request := webhookRequest{header: req.Header, body: body}
+		if err := json.Unmarshal(body, &request.payload); err != nil || request.payload.Event == nil {
+			t.Errorf("unable to decode webhook payload %s: %v", body, err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		r.lock.Lock()
+		attempt := 0
+		for _, previous := range r.requests {
+			if previous.payload.Event.Target == request.payload.Event.Target {
+				attempt++
+			}
+		}
+		r.requests = append(r.requests, request)
+		r.lock.Unlock()
+		w.WriteHeader(r.respond(request.payload.Event, attempt))
+		r.received <- request
+	}))
+	t.Cleanup(r.Close)
+	return r
+}
+
+// attempts returns the number of requests received for the event with target
+func (r *testReceiver) attempts(target string) (n int) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	for _, request := range r.requests {
+		if request.payload.Event.Target == target {
+			n++
+		}
+	}
+	return
+}
+
+// wait returns the next request, or fails the test when none is received in time
+func (r *testReceiver) wait(t *testing.T) webhookRequest {
+	t.Helper()
+	select {
+	case request := <-r.received:
+		return request
+	case <-time.After(5 * time.Second):
+		t.Fatal("the webhook did not receive a request in time")
+		return webhookRequest{}
+	}
+}
+
+// drain delivers a final event to sink and waits for it: events are delivered
+// in order, so that every event written before has been delivered or abandoned
+func (r *testReceiver) drain(t *testing.T, sink *WebhookSink) {
+	t.Helper()
+	if err := sink.Write(&Event{Type: EventUserAdd, Target: "drain"}); err != nil {
+		t.Fatal(err)
+	}
+	for r.wait(t).payload.Event.Target != "drain" {
+	}
+}
+
+func newTestSink(t *testing.T, endpoint WebhookEndpoint, maxRetries int) *WebhookSink {
+	sink := NewWebhookSink(endpoint, time.Second, maxRetries, time.Millisecond)
+	t.Cleanup(func() { sink.Close() })
+	return sink
+}
+
+func TestSign(t *testing.T) {
+	// echo -n '1700000000.{"text":"hello"}' | openssl dgst -sha256 -hmac changeme
+	expected := "2361fe57cf7b564bf0cd1709e43db5962f7a524844ca1d9c9f3869e0c43f2d5e"
+	if signature := Sign([]byte("changeme"), "1700000000", []byte(`{"text":"hello"}`)); signature != expected {
+		t.Fatalf("expected signature %s, got %s", expected, signature)
+	}
+	if Sign([]byte("changeme"), "1700000001", []byte(`{"text":"hello"}`)) == expected {
+		t.Fatal("expected the timestamp to be signed")
+	}
+}
+
+func TestWebhookSignature(t *testing.T) {
+	receiver := newTestReceiver(t, func(*Event, int) int { return http.StatusNoContent })
+	secret := "changeme"
+	sink := newTestSink(t, WebhookEndpoint{URL: receiver.URL, Secret: secret}, 0)
+	before := time.Now().Unix()
+	if err := sink.Write(&Event{Type: EventUserAdd, UserID: "u1", Actor: "admin"}); err != nil {
+		t.Fatal(err)
+	}
+	request := receiver.wait(t)
+
+	if contentType := request.header.Get("Content-Type"); contentType != "application/json" {
+		t.Errorf("expected a json content type, got %s", contentType)
+	}
+	timestamp := request.header.Get("X-Protego-Timestamp")
+	if ts, err := strconv.ParseInt(timestamp, 10, 64); err != nil || ts < before || ts > time.Now().Unix() {
+		t.Errorf("expected the current unix time as timestamp, got %s", timestamp)
+	}
+	if expected := "sha256=" + Sign([]byte(secret), timestamp, request.body); request.header.Get("X-Protego-Signature") != expected {
+		t.Fatalf("expected signature %s, got %s", expected, request.header.Get("X-Protego-Signature"))
+	}
+	if request.payload.Text != "user u1 was added by admin" || request.payload.Event.UserID != "u1" {
+		t.Fatalf("unexpected payload %s", request.body)
+	}
+
+	// events are not signed without a secret
+	unsigned := newTestSink(t, WebhookEndpoint{URL: receiver.URL}, 0)
+	if err := unsigned.Write(&Event{Type: EventUserAdd}); err != nil {
+		t.Fatal(err)
+	}
+	if request = receiver.wait(t); request.header.Get("X-Protego-Signature") != "" || request.header.Get("X-Protego-Timestamp") == "" {
+		t.Fatalf("expected a timestamp without signature, got %v", request.header)
+	}
+}
+
+func TestWebhookRetry(t *testing.T) {
+	tests := []struct {
+		name string
+		// the status of every failed attempt, the last attempt succeeds
+		failures   []int
+		maxRetries int
+		attempts   int
+	}{
+		{"success", nil, 3, 1},
+		{"server errors are retried", []int{http.StatusInternalServerError, http.StatusBadGateway}, 3, 3},
+		{"too many requests are retried", []int{http.StatusTooManyRequests}, 3, 2},
+		{"client errors are not retried", []int{http.StatusBadRequest, http.StatusBadRequest}, 3, 1},
+		{"not found is not retried", []int{http.StatusNotFound}, 3, 1},
+		{"retries are limited", []int{500, 500, 500, 500, 500}, 2, 3},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			receiver := newTestReceiver(t, func(e *Event, attempt int) int {
+				if e.Target == test.name && attempt < len(test.failures) {
+					return test.failures[attempt]
+				}
+				return http.StatusOK
+			})
+			sink := newTestSink(t, WebhookEndpoint{URL: receiver.URL, Events: []string{webhookAllEvents}}, test.maxRetries)
+			if err := sink.Write(&Event{Type: EventUserAdd, Target: test.name}); err != nil {
+				t.Fatal(err)
+			}
+			receiver.drain(t, sink)
+			if attempts := receiver.attempts(test.name); attempts != test.attempts {
+				t.Fatalf("expected %d attempt(s), got %d", test.attempts, attempts)
+			}
+		})
+	}
+}
+
+func TestWebhookEvents(t *testing.T) {
+	tests := []struct {
+		name      string
+		events    []string
+		delivered map[string]bool
+	}{
+		{"default events", nil, map[string]bool{EventChallengeNewIP: true, EventDDNSUpdate: true, EventAuthorize: false}},
+		{"listed events", []string{EventAuthorize}, map[string]bool{EventAuthorize: true, EventChallengeNewIP: false}},
+		{"every event", []string{webhookAllEvents}, map[string]bool{EventAuthorize: true, EventACLRevoke: true}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			receiver := newTestReceiver(t, func(*Event, int) int { return http.StatusOK })
+			// user_add events (one of the default events) are used to drain the sink
+			events := test.events
+			if len(events) > 0 {
+				events = append(events, EventUserAdd)
+			}
+			sink := newTestSink(t, WebhookEndpoint{URL: receiver.URL, Events: events}, 0)
+			for eventType := range test.delivered {
+				if err := sink.Write(&Event{Type: eventType, Target: eventType}); err != nil {
+					t.Fatal(err)
+				}
+			}
+			receiver.drain(t, sink)
+			for eventType, delivered := range test.delivered {
+				if attempts := receiver.attempts(eventType); (attempts == 1) != delivered {
+					t.Errorf("expected %s to be delivered: %v, got %d request(s)", eventType, delivered, attempts)
+				}
+			}
+		})
+	}
+}
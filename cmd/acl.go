@@ -0,0 +1,90 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/gbolo/protego/audit"
+	"github.com/gbolo/protego/dataprovider"
+	"github.com/spf13/cobra"
+)
+
+var aclFlags struct {
+	jsonOutput bool
+}
+
+var aclCmd = &cobra.Command{
+	Use:   "acl",
+	Short: "Manage whitelisted IPs directly in the data provider (offline)",
+}
+
+var aclListCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "List all active ACLs",
+	Args:    cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return withProvider(func(p dataprovider.Provider) error {
+			acls, err := p.GetAllACLs()
+			if err != nil {
+				return err
+			}
+			if aclFlags.jsonOutput {
+				return printJSON(acls)
+			}
+			ips := make([]string, 0, len(acls))
+			for ip := range acls {
+				ips = append(ips, ip)
+			}
+			sort.Strings(ips)
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "IP\tUSER_ID\tSOURCE\tALLOW_ALL\tHOSTS\tEXPIRES")
+			for _, ip := range ips {
+				acl := acls[ip]
+				expires := "never"
+				if acl.TTL != nil {
+					expires = acl.TTL.Format(time.RFC3339)
+				}
+				fmt.Fprintf(w, "%s\t%s\t%s\t%t\t%s\t%s\n",
+					ip, acl.UserID, acl.Source, acl.AllowAll, strings.Join(acl.AllowedHosts, ","), expires)
+			}
+			return w.Flush()
+		})
+	},
+}
+
+var aclRevokeCmd = &cobra.Command{
+	Use:   "revoke <ip>",
+	Short: "Revoke the ACL of an IP",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return withProvider(func(p dataprovider.Provider) error {
+			acl, err := p.GetACL(args[0])
+			if err != nil {
+				return err
+			}
+			if acl == nil {
+				return fmt.Errorf("no ACL found for IP: %s", args[0])
+			}
+			if err = p.RemoveIp(args[0]); err != nil {
+				return err
+			}
+			if acl.Source == dataprovider.ACLSourceDdns {
+				fmt.Fprintln(os.Stderr, "warning: this ACL was created from a DNS name, it is added again while the name resolves to this IP")
+			}
+			recordOffline(p, audit.Event{Type: audit.EventACLRevoke, UserID: acl.UserID, Target: args[0]})
+			fmt.Printf("ACL has been revoked: %s\n", args[0])
+			return nil
+		})
+	},
+}
+
+func init() {
+	aclListCmd.Flags().BoolVar(&aclFlags.jsonOutput, "json", false, "print ACLs as json")
+	aclCmd.AddCommand(aclListCmd, aclRevokeCmd)
+	rootCmd.AddCommand(aclCmd)
+}
@@ -0,0 +1,41 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/gbolo/protego/config"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Inspect the configuration",
+}
+
+var configCheckCmd = &cobra.Command{
+	Use:   "check",
+	Short: "Validate the configuration and print every problem found",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		// the log level is not overridden here, since it is validated too
+		err := config.ConfigInit(cfgFile, false)
+		if err == nil {
+			fmt.Printf("configuration is valid: %s\n", viper.ConfigFileUsed())
+			return nil
+		}
+		validationErr, ok := err.(*config.ValidationError)
+		if !ok {
+			return err
+		}
+		for _, problem := range validationErr.Problems {
+			fmt.Println(problem)
+		}
+		return fmt.Errorf("%d problem(s) found in the configuration", len(validationErr.Problems))
+	},
+}
+
+func init() {
+	configCmd.AddCommand(configCheckCmd)
+	rootCmd.AddCommand(configCmd)
+}
@@ -0,0 +1,225 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/gbolo/protego/audit"
+	"github.com/gbolo/protego/dataprovider"
+	"github.com/spf13/cobra"
+)
+
+// the version of the export format
+const exportVersion = 1
+
+// export is the content of a database export
+type export struct {
+	Version  int                         `json:"version"`
+	Users    []dataprovider.User         `json:"users"`
+	ACLs     map[string]dataprovider.ACL `json:"acls"`
+	Groups   []dataprovider.Group        `json:"groups,omitempty"`
+	HostSets []dataprovider.HostSet      `json:"host_sets,omitempty"`
+	Policies []dataprovider.HostPolicy   `json:"host_policies,omitempty"`
+	DenyList []dataprovider.DenyEntry    `json:"deny_list,omitempty"`
+	// admin API tokens are exported with their hash
+	Tokens []dataprovider.Token `json:"tokens,omitempty"`
+}
+
+var dbFlags struct {
+	file      string
+	overwrite bool
+}
+
+var dbCmd = &cobra.Command{
+	Use:   "db",
+	Short: "Export or import the data provider (offline)",
+}
+
+var dbExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export all users, ACLs, groups, host sets, host policies, deny entries and admin API tokens as json. Secrets and tokens are only exported as hashes",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return withProvider(func(p dataprovider.Provider) (err error) {
+			data := export{Version: exportVersion}
+			if data.Users, err = p.GetAllUsers(); err != nil {
+				return err
+			}
+			if data.ACLs, err = p.GetAllACLs(); err != nil {
+				return err
+			}
+			if data.Groups, err = p.GetAllGroups(); err != nil {
+				return err
+			}
+			if data.HostSets, err = p.GetAllHostSets(); err != nil {
+				return err
+			}
+			if data.Policies, err = p.GetAllHostPolicies(); err != nil {
+				return err
+			}
+			if data.DenyList, err = p.GetAllDenyEntries(); err != nil {
+				return err
+			}
+			if data.Tokens, err = p.GetAllTokens(); err != nil {
+				return err
+			}
+			out := io.Writer(os.Stdout)
+			if dbFlags.file != "-" {
+				f, err := os.OpenFile(dbFlags.file, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+				out = f
+			}
+			enc := json.NewEncoder(out)
+			enc.SetIndent("", "  ")
+			if err = enc.Encode(data); err != nil {
+				return err
+			}
+			fmt.Fprintf(os.Stderr, "exported %d user(s), %d ACL(s), %d group(s), %d host set(s), %d host policies, %d deny entries and %d token(s)\n",
+				len(data.Users), len(data.ACLs), len(data.Groups), len(data.HostSets), len(data.Policies), len(data.DenyList), len(data.Tokens))
+			return nil
+		})
+	},
+}
+
+var dbImportCmd = &cobra.Command{
+	Use:   "import",
+	Short: "Import a json export. Existing groups, host sets, host policies and users are only replaced with --overwrite",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		in := io.Reader(os.Stdin)
+		if dbFlags.file != "-" {
+			f, err := os.Open(dbFlags.file)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			in = f
+		}
+		var data export
+		if err := json.NewDecoder(in).Decode(&data); err != nil {
+			return fmt.Errorf("unable to decode export: %v", err)
+		}
+		if data.Version != exportVersion {
+			return fmt.Errorf("unsupported export version: %d", data.Version)
+		}
+
+		return withProvider(func(p dataprovider.Provider) error {
+			// host sets and groups are imported first, since users reference them
+			hostSets, groups := 0, 0
+			for i := range data.HostSets {
+				err := p.AddHostSet(&data.HostSets[i])
+				if err == dataprovider.ErrHostSetExists && dbFlags.overwrite {
+					err = p.UpdateHostSet(&data.HostSets[i])
+				}
+				switch {
+				case err == dataprovider.ErrHostSetExists:
+				case err != nil:
+					return fmt.Errorf("unable to import host set %s: %v", data.HostSets[i].Name, err)
+				default:
+					hostSets++
+				}
+			}
+			for i := range data.Groups {
+				err := p.AddGroup(&data.Groups[i])
+				if err == dataprovider.ErrGroupExists && dbFlags.overwrite {
+					err = p.UpdateGroup(&data.Groups[i])
+				}
+				switch {
+				case err == dataprovider.ErrGroupExists:
+				case err != nil:
+					return fmt.Errorf("unable to import group %s: %v", data.Groups[i].Name, err)
+				default:
+					groups++
+				}
+			}
+			// host policies reference groups
+			policies := 0
+			for i := range data.Policies {
+				err := p.AddHostPolicy(&data.Policies[i])
+				if err == dataprovider.ErrHostPolicyExists && dbFlags.overwrite {
+					err = p.UpdateHostPolicy(&data.Policies[i])
+				}
+				switch {
+				case err == dataprovider.ErrHostPolicyExists:
+				case err != nil:
+					return fmt.Errorf("unable to import host policy %s: %v", data.Policies[i].Host, err)
+				default:
+					policies++
+				}
+			}
+
+			users, skipped := 0, 0
+			for i := range data.Users {
+				u := &data.Users[i]
+				err := p.AddUser(u)
+				if err == dataprovider.ErrUserExists && dbFlags.overwrite {
+					err = p.UpdateUser(u)
+				}
+				switch {
+				case err == dataprovider.ErrUserExists:
+					skipped++
+				case err != nil:
+					return fmt.Errorf("unable to import user %s: %v", u.ID, err)
+				default:
+					users++
+				}
+			}
+			acls := 0
+			for ip, acl := range data.ACLs {
+				acl := acl
+				if acl.IsExpired() {
+					continue
+				}
+				if err := p.AddIp(ip, &acl); err != nil {
+					return fmt.Errorf("unable to import ACL for %s: %v", ip, err)
+				}
+				acls++
+			}
+			// existing deny entries are kept as they are
+			denied := 0
+			for i := range data.DenyList {
+				if data.DenyList[i].IsExpired() {
+					continue
+				}
+				err := p.AddDenyEntry(&data.DenyList[i])
+				switch {
+				case err == dataprovider.ErrDenyEntryExists:
+				case err != nil:
+					return fmt.Errorf("unable to import deny entry %s: %v", data.DenyList[i].CIDR, err)
+				default:
+					denied++
+				}
+			}
+			// existing tokens are never overwritten
+			tokens := 0
+			for i := range data.Tokens {
+				err := p.AddToken(&data.Tokens[i])
+				switch {
+				case err == dataprovider.ErrTokenExists:
+					skipped++
+				case err != nil:
+					return fmt.Errorf("unable to import token %s: %v", data.Tokens[i].Name, err)
+				default:
+					tokens++
+				}
+			}
+			recordOffline(p, audit.Event{Type: audit.EventDBImport})
+			fmt.Printf("imported %d user(s), %d ACL(s), %d group(s), %d host set(s), %d host policies, %d deny entries and %d token(s), skipped %d existing user(s) and token(s)\n",
+				users, acls, groups, hostSets, policies, denied, tokens, skipped)
+			return nil
+		})
+	},
+}
+
+func init() {
+	dbExportCmd.Flags().StringVarP(&dbFlags.file, "file", "f", "-", "file to export to, - for stdout")
+	dbImportCmd.Flags().StringVarP(&dbFlags.file, "file", "f", "-", "file to import from, - for stdin")
+	dbImportCmd.Flags().BoolVar(&dbFlags.overwrite, "overwrite", false, "overwrite groups, host sets, host policies and users which already exist")
+	dbCmd.AddCommand(dbExportCmd, dbImportCmd)
+	rootCmd.AddCommand(dbCmd)
+}
@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/gbolo/protego/audit"
+	"github.com/gbolo/protego/dataprovider"
+	"github.com/spf13/cobra"
+)
+
+var denyFlags struct {
+	reason     string
+	expires    time.Duration
+	jsonOutput bool
+}
+
+var denyCmd = &cobra.Command{
+	Use:   "deny",
+	Short: "Manage blocked IP addresses and ranges directly in the data provider (offline)",
+}
+
+var denyAddCmd = &cobra.Command{
+	Use:   "add <ip|cidr>",
+	Short: "Block an IP address or range. It takes precedence over every ACL, and clients within it can not pass a challenge",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var expiresAt *time.Time
+		if denyFlags.expires > 0 {
+			t := time.Now().Add(denyFlags.expires).UTC()
+			expiresAt = &t
+		}
+		entry, err := dataprovider.NewDenyEntry(args[0], denyFlags.reason, expiresAt)
+		if err != nil {
+			return err
+		}
+		return withProvider(func(p dataprovider.Provider) error {
+			if err := p.AddDenyEntry(entry); err != nil {
+				return err
+			}
+			recordOffline(p, audit.Event{Type: audit.EventDenyAdd, Target: entry.CIDR, Reason: entry.Reason})
+			fmt.Printf("range has been blocked: %s\n", entry.CIDR)
+			return nil
+		})
+	},
+}
+
+var denyRemoveCmd = &cobra.Command{
+	Use:     "rm <ip|cidr>",
+	Aliases: []string{"remove"},
+	Short:   "Unblock an IP address or range",
+	Args:    cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cidr, err := dataprovider.NormalizeCIDR(args[0])
+		if err != nil {
+			return err
+		}
+		return withProvider(func(p dataprovider.Provider) error {
+			if _, err := p.RemoveDenyEntry(cidr); err != nil {
+				return err
+			}
+			recordOffline(p, audit.Event{Type: audit.EventDenyRemove, Target: cidr})
+			fmt.Printf("range has been unblocked: %s\n", cidr)
+			return nil
+		})
+	},
+}
+
+var denyListCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "List all blocked IP addresses and ranges which have not expired",
+	Args:    cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return withProvider(func(p dataprovider.Provider) error {
+			entries, err := p.GetAllDenyEntries()
+			if err != nil {
+				return err
+			}
+			if denyFlags.jsonOutput {
+				return printJSON(entries)
+			}
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "CIDR\tCREATED\tEXPIRES\tREASON")
+			for _, d := range entries {
+				expires := "never"
+				if d.ExpiresAt != nil {
+					expires = d.ExpiresAt.Format(time.RFC3339)
+				}
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", d.CIDR, d.CreatedAt.Format(time.RFC3339), expires, d.Reason)
+			}
+			return w.Flush()
+		})
+	},
+}
+
+func init() {
+	denyAddCmd.Flags().StringVar(&denyFlags.reason, "reason", "", "why the range is blocked")
+	denyAddCmd.Flags().DurationVar(&denyFlags.expires, "expires", 0, "the range is unblocked after this duration (like 24h), never when not set")
+	denyListCmd.Flags().BoolVar(&denyFlags.jsonOutput, "json", false, "print deny entries as json")
+	denyCmd.AddCommand(denyAddCmd, denyRemoveCmd, denyListCmd)
+	rootCmd.AddCommand(denyCmd)
+}
@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/gbolo/protego/config"
+	"github.com/gbolo/protego/dataprovider"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var (
+	log = config.GetLogger()
+
+	// path to the config file, set by flag
+	cfgFile string
+	// show log messages of every level in offline commands
+	verbose bool
+)
+
+var rootCmd = &cobra.Command{
+	Use:   config.AppName,
+	Short: "Transparent IP based ACLs for nginx auth_request",
+	Long: `Protego is a self-hosted REST API service, intended to be used in conjunction
+with nginx's auth_request module. Without a command, the server is started.`,
+	SilenceUsage: true,
+	// keep "protego" without arguments working as it always has
+	RunE: runServe,
+}
+
+func init() {
+	rootCmd.PersistentFlags().StringVarP(&cfgFile, "config", "c", "", "path to the config file (default: ./testdata/sampleconfig/protego.yaml)")
+	rootCmd.PersistentFlags().BoolVarP(&verbose, "verbose", "v", false, "show all log messages in offline commands")
+}
+
+// Execute runs the command given on the command line
+func Execute() {
+	if err := rootCmd.Execute(); err != nil {
+		os.Exit(1)
+	}
+}
+
+// initOffline loads the config and opens the data provider for commands which
+// operate directly on the data provider, while the server may not be running.
+// log messages below WARNING are hidden unless --verbose is set, to keep the output readable.
+func initOffline() (dataprovider.Provider, error) {
+	if !verbose {
+		// takes precedence over the config file and environment
+		viper.Set("log_level", "WARNING")
+	}
+	// problems with settings these commands do not use should not prevent them from running
+	if err := config.ConfigInit(cfgFile, false); err != nil {
+		log.Warningf("%v", err)
+	}
+	p, err := dataprovider.NewProviderFromConfig()
+	if err != nil {
+		return nil, fmt.Errorf("%v (the bolt database cannot be opened while the server is running)", err)
+	}
+	return p, nil
+}
+
+// withProvider runs fn with an offline data provider, which is always closed afterwards
+func withProvider(fn func(p dataprovider.Provider) error) error {
+	p, err := initOffline()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := p.Close(); closeErr != nil {
+			log.Errorf("failed to close data provider: %v", closeErr)
+		}
+	}()
+	return fn(p)
+}
@@ -0,0 +1,74 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/gbolo/protego/config"
+	"github.com/gbolo/protego/dataprovider"
+	"github.com/gbolo/protego/server"
+	"github.com/spf13/cobra"
+)
+
+var serveCmd = &cobra.Command{
+	Use:   "serve",
+	Short: "Start the Protego server",
+	Args:  cobra.NoArgs,
+	RunE:  runServe,
+}
+
+func init() {
+	rootCmd.AddCommand(serveCmd)
+}
+
+func runServe(cmd *cobra.Command, args []string) error {
+	// init the config
+	if err := config.ConfigInit(cfgFile, true); err != nil {
+		return err
+	}
+
+	// init the data provider
+	p, err := dataprovider.NewProviderFromConfig()
+	if err != nil {
+		return err
+	}
+
+	// stop the server on SIGINT or SIGTERM, reload the config on SIGHUP.
+	// a second SIGINT or SIGTERM forces the process to exit, without waiting for the shutdown
+	ctx, cancel := context.WithCancel(context.Background())
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM, syscall.SIGHUP)
+	go func() {
+		shuttingDown := false
+		for sig := range signals {
+			switch {
+			case sig == syscall.SIGHUP && !shuttingDown:
+				server.Reload()
+			case sig == syscall.SIGHUP:
+				log.Infof("received signal %v during shutdown: ignoring it", sig)
+			case !shuttingDown:
+				log.Infof("received signal %v: shutting down", sig)
+				shuttingDown = true
+				cancel()
+			default:
+				log.Warningf("received signal %v during shutdown: exiting now", sig)
+				os.Exit(1)
+			}
+		}
+	}()
+
+	// init the server
+	err = server.InitServer(ctx, p)
+	// always close the data provider, so the database is left in a consistent state
+	if closeErr := p.Close(); closeErr != nil {
+		log.Errorf("failed to close data provider: %v", closeErr)
+	}
+	if err != nil {
+		return fmt.Errorf("server exited with error: %v", err)
+	}
+	log.Info("shutdown complete")
+	return nil
+}
@@ -0,0 +1,111 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/gbolo/protego/audit"
+	"github.com/gbolo/protego/dataprovider"
+	"github.com/spf13/cobra"
+)
+
+var tokenFlags struct {
+	scopes     []string
+	expires    time.Duration
+	jsonOutput bool
+}
+
+var tokenCmd = &cobra.Command{
+	Use:   "token",
+	Short: "Manage admin API tokens directly in the data provider (offline)",
+}
+
+var tokenAddCmd = &cobra.Command{
+	Use:   "add <name>",
+	Short: "Add a new admin API token. The token is printed once, only its hash is stored",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var expiresAt *time.Time
+		if tokenFlags.expires > 0 {
+			t := time.Now().Add(tokenFlags.expires).UTC()
+			expiresAt = &t
+		}
+		token, secret, err := dataprovider.NewToken(args[0], tokenFlags.scopes, expiresAt)
+		if err != nil {
+			return err
+		}
+		return withProvider(func(p dataprovider.Provider) error {
+			if err := p.AddToken(token); err != nil {
+				return err
+			}
+			recordOffline(p, audit.Event{Type: audit.EventTokenAdd, Target: token.Name})
+			fmt.Fprintf(os.Stderr, "token has been added: %s\n", token.Name)
+			fmt.Println(secret)
+			return nil
+		})
+	},
+}
+
+var tokenRemoveCmd = &cobra.Command{
+	Use:     "rm <name>",
+	Aliases: []string{"remove"},
+	Short:   "Remove an admin API token",
+	Args:    cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return withProvider(func(p dataprovider.Provider) error {
+			if err := p.RemoveToken(args[0]); err != nil {
+				return err
+			}
+			recordOffline(p, audit.Event{Type: audit.EventTokenRemove, Target: args[0]})
+			fmt.Printf("token has been removed: %s\n", args[0])
+			return nil
+		})
+	},
+}
+
+var tokenListCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "List all admin API tokens",
+	Args:    cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return withProvider(func(p dataprovider.Provider) error {
+			tokens, err := p.GetAllTokens()
+			if err != nil {
+				return err
+			}
+			// never print the hashes
+			for i := range tokens {
+				tokens[i].Hash = ""
+			}
+			if tokenFlags.jsonOutput {
+				return printJSON(tokens)
+			}
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "NAME\tSCOPES\tCREATED\tEXPIRES")
+			for _, t := range tokens {
+				expires := "never"
+				if t.ExpiresAt != nil {
+					expires = t.ExpiresAt.Format(time.RFC3339)
+					if t.IsExpired() {
+						expires += " (expired)"
+					}
+				}
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", t.Name, strings.Join(t.Scopes, ","), t.CreatedAt.Format(time.RFC3339), expires)
+			}
+			return w.Flush()
+		})
+	},
+}
+
+func init() {
+	tokenAddCmd.Flags().StringSliceVar(&tokenFlags.scopes, "scope", nil,
+		"scope granted to the token (repeatable): "+strings.Join(dataprovider.AllScopes, ", "))
+	tokenAddCmd.Flags().DurationVar(&tokenFlags.expires, "expires", 0, "the token expires after this duration (like 720h), never when not set")
+	tokenListCmd.Flags().BoolVar(&tokenFlags.jsonOutput, "json", false, "print tokens as json")
+	tokenCmd.AddCommand(tokenAddCmd, tokenRemoveCmd, tokenListCmd)
+	rootCmd.AddCommand(tokenCmd)
+}
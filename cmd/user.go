@@ -0,0 +1,361 @@
+package cmd
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/user"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	validate "github.com/asaskevich/govalidator"
+	"github.com/gbolo/protego/audit"
+	"github.com/gbolo/protego/dataprovider"
+	"github.com/spf13/cobra"
+)
+
+// flags shared by user add and user update
+var userFlags struct {
+	secret       string
+	description  string
+	disabled     bool
+	allowAll     bool
+	hosts        []string
+	dnsNames     []string
+	groups       []string
+	ttlMinutes   int
+	slidingTTL   bool
+	maxTTL       int
+	validFrom    string
+	validUntil   string
+	windows      []string
+	maxIPs       int
+	maxIPsPolicy string
+	countries    []string
+	email        string
+	removeTOTP   bool
+	jsonOutput   bool
+}
+
+var userCmd = &cobra.Command{
+	Use:   "user",
+	Short: "Manage users directly in the data provider (offline)",
+}
+
+var userAddCmd = &cobra.Command{
+	Use:   "add",
+	Short: "Add a new user. The secret is read from stdin when --secret is not set",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		secret := userFlags.secret
+		if secret == "" {
+			var err error
+			if secret, err = readSecret(); err != nil {
+				return err
+			}
+		}
+		u, err := dataprovider.NewUser(secret, userFlags.description)
+		if err != nil {
+			return err
+		}
+		if err = applyUserFlags(cmd, u); err != nil {
+			return err
+		}
+		return withProvider(func(p dataprovider.Provider) error {
+			if err := checkGroups(p, u.Groups); err != nil {
+				return err
+			}
+			if err := p.AddUser(u); err != nil {
+				return err
+			}
+			recordOffline(p, audit.Event{Type: audit.EventUserAdd, UserID: u.ID})
+			fmt.Printf("user has been added: %s\n", u.ID)
+			return nil
+		})
+	},
+}
+
+var userUpdateCmd = &cobra.Command{
+	Use:   "update <user-id>",
+	Short: "Update an existing user. Only the flags which are set are changed",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if cmd.Flags().Changed("secret") {
+			return fmt.Errorf("the secret of a user cannot be changed, since the user ID is derived from it")
+		}
+		return withProvider(func(p dataprovider.Provider) error {
+			u, err := p.GetUser(args[0])
+			if err != nil {
+				return err
+			}
+			if u == nil {
+				return dataprovider.ErrUserNotFound
+			}
+			if cmd.Flags().Changed("description") {
+				u.Description = userFlags.description
+			}
+			if err = applyUserFlags(cmd, u); err != nil {
+				return err
+			}
+			if err = checkGroups(p, u.Groups); err != nil {
+				return err
+			}
+			if err = p.UpdateUser(u); err != nil {
+				return err
+			}
+			recordOffline(p, audit.Event{Type: audit.EventUserUpdate, UserID: u.ID})
+			fmt.Printf("user has been updated: %s\n", u.ID)
+			return nil
+		})
+	},
+}
+
+var userRemoveCmd = &cobra.Command{
+	Use:     "rm <user-id>",
+	Aliases: []string{"remove"},
+	Short:   "Remove a user",
+	Args:    cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return withProvider(func(p dataprovider.Provider) error {
+			u, err := p.GetUser(args[0])
+			if err != nil {
+				return err
+			}
+			if u == nil {
+				return dataprovider.ErrUserNotFound
+			}
+			if err = p.RemoveUser(u); err != nil {
+				return err
+			}
+			recordOffline(p, audit.Event{Type: audit.EventUserRemove, UserID: u.ID})
+			fmt.Printf("user has been removed: %s\n", u.ID)
+			return nil
+		})
+	},
+}
+
+var userListCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "List all users",
+	Args:    cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return withProvider(func(p dataprovider.Provider) error {
+			users, err := p.GetAllUsers()
+			if err != nil {
+				return err
+			}
+			// never print secret hashes or TOTP secrets
+			for i := range users {
+				users[i].Secret = ""
+				users[i].TOTPSecret = ""
+			}
+			if userFlags.jsonOutput {
+				return printJSON(users)
+			}
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "ID\tENABLED\tALLOW_ALL\tTTL_MINUTES\tHOSTS\tDNS_NAMES\tDESCRIPTION")
+			for _, u := range users {
+				fmt.Fprintf(w, "%s\t%t\t%t\t%d\t%s\t%s\t%s\n",
+					u.ID, u.Enabled, u.ACLAllowAll, u.TTLMinutes,
+					strings.Join(u.ACLAllowedHosts, ","), strings.Join(u.DNSNames, ","), u.Description)
+			}
+			return w.Flush()
+		})
+	},
+}
+
+var userTOTPCmd = &cobra.Command{
+	Use:   "totp <user-id>",
+	Short: "Enroll a user in TOTP and print its new secret, or remove its TOTP with --remove",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return withProvider(func(p dataprovider.Provider) error {
+			u, err := p.GetUser(args[0])
+			if err != nil {
+				return err
+			}
+			if u == nil {
+				return dataprovider.ErrUserNotFound
+			}
+			u.TOTPSecret = ""
+			if !userFlags.removeTOTP {
+				if u.TOTPSecret, err = dataprovider.GenerateTOTPSecret(); err != nil {
+					return err
+				}
+			}
+			if err = p.UpdateUser(u); err != nil {
+				return err
+			}
+			recordOffline(p, audit.Event{Type: audit.EventUserUpdate, UserID: u.ID, Target: "totp"})
+			if userFlags.removeTOTP {
+				fmt.Printf("TOTP of user has been removed: %s\n", u.ID)
+				return nil
+			}
+			// only the secret is printed to stdout, so that it can be piped
+			fmt.Fprintf(os.Stderr, "user has been enrolled in TOTP: %s\n%s\n", u.ID, dataprovider.TOTPURI(u.ID, u.TOTPSecret))
+			fmt.Println(u.TOTPSecret)
+			return nil
+		})
+	},
+}
+
+func init() {
+	for _, c := range []*cobra.Command{userAddCmd, userUpdateCmd} {
+		c.Flags().StringVar(&userFlags.secret, "secret", "", "secret used by the user to pass a challenge")
+		c.Flags().StringVar(&userFlags.description, "description", "", "a brief description of the user")
+		c.Flags().BoolVar(&userFlags.disabled, "disabled", false, "disable the user")
+		c.Flags().BoolVar(&userFlags.allowAll, "allow-all", false, "allow the user to access all hosts")
+		c.Flags().StringSliceVar(&userFlags.hosts, "host", nil, "host (FQDN) the user is allowed to access, can be repeated")
+		c.Flags().StringSliceVar(&userFlags.dnsNames, "dns-name", nil, "DNS name which resolves to the user's IP, can be repeated")
+		c.Flags().StringSliceVar(&userFlags.groups, "group", nil, "group the user is a member of, can be repeated")
+		c.Flags().IntVar(&userFlags.ttlMinutes, "ttl", 0, "minutes the user's IP is whitelisted for after a challenge (0 means forever)")
+		c.Flags().BoolVar(&userFlags.slidingTTL, "sliding-ttl", false, "extend the TTL of the user's IP whenever it is authorized")
+		c.Flags().IntVar(&userFlags.maxTTL, "max-ttl", 0, "maximum minutes a sliding TTL can extend the user's IP for, since the challenge (0 means unlimited)")
+		c.Flags().StringVar(&userFlags.validFrom, "valid-from", "", "date (RFC3339) the user can be used from, empty to clear")
+		c.Flags().StringVar(&userFlags.validUntil, "valid-until", "", "date (RFC3339) the user can be used until, empty to clear")
+		c.Flags().IntVar(&userFlags.maxIPs, "max-ips", 0, "maximum number of IPs whitelisted by challenges of the user at the same time (0 means unlimited)")
+		c.Flags().StringVar(&userFlags.maxIPsPolicy, "max-ips-policy", "", "when max-ips is reached: evict_oldest (default) or reject")
+		c.Flags().StringVar(&userFlags.email, "email", "", "email address the user is notified at when its access is used from a new IP, empty to clear")
+		c.Flags().StringSliceVar(&userFlags.countries, "country", nil, "country (ISO 3166-1 alpha-2) the user is allowed to access from, can be repeated")
+		c.Flags().StringArrayVar(&userFlags.windows, "access-window", nil, "recurring window the user has access in (like 'mon-fri 08:00-18:00'), can be repeated")
+	}
+	userListCmd.Flags().BoolVar(&userFlags.jsonOutput, "json", false, "print users as json")
+	userTOTPCmd.Flags().BoolVar(&userFlags.removeTOTP, "remove", false, "remove the TOTP of the user")
+	userCmd.AddCommand(userAddCmd, userUpdateCmd, userRemoveCmd, userListCmd, userTOTPCmd)
+	rootCmd.AddCommand(userCmd)
+}
+
+// applyUserFlags applies the flags which were set on the command line to u
+func applyUserFlags(cmd *cobra.Command, u *dataprovider.User) error {
+	flags := cmd.Flags()
+	if flags.Changed("disabled") {
+		u.Enabled = !userFlags.disabled
+	}
+	if flags.Changed("allow-all") {
+		u.SetAclAllowAll(userFlags.allowAll)
+	}
+	if flags.Changed("host") {
+		u.ACLAllowedHosts = nil
+		for _, host := range userFlags.hosts {
+			if err := u.AddHost(host); err != nil {
+				return err
+			}
+		}
+	}
+	if flags.Changed("dns-name") {
+		u.DNSNames = nil
+		for _, fqdn := range userFlags.dnsNames {
+			if !validate.IsDNSName(fqdn) {
+				return fmt.Errorf("validation error for DNS name: %s", fqdn)
+			}
+			u.DNSNames = append(u.DNSNames, strings.ToLower(fqdn))
+		}
+	}
+	if flags.Changed("group") {
+		u.Groups = userFlags.groups
+	}
+	if flags.Changed("ttl") {
+		u.TTLMinutes = userFlags.ttlMinutes
+	}
+	if flags.Changed("sliding-ttl") {
+		u.SlidingTTL = userFlags.slidingTTL
+	}
+	if flags.Changed("max-ttl") {
+		u.MaxTTLMinutes = userFlags.maxTTL
+	}
+	if flags.Changed("max-ips") {
+		u.MaxIPs = userFlags.maxIPs
+	}
+	if flags.Changed("max-ips-policy") {
+		u.MaxIPsPolicy = userFlags.maxIPsPolicy
+	}
+	if flags.Changed("country") {
+		u.AllowedCountries = userFlags.countries
+	}
+	if flags.Changed("email") {
+		u.Email = userFlags.email
+	}
+	var err error
+	if flags.Changed("valid-from") {
+		if u.ValidFrom, err = parseDate(userFlags.validFrom); err != nil {
+			return err
+		}
+	}
+	if flags.Changed("valid-until") {
+		if u.ValidUntil, err = parseDate(userFlags.validUntil); err != nil {
+			return err
+		}
+	}
+	if flags.Changed("access-window") {
+		u.AccessWindows = nil
+		for _, s := range userFlags.windows {
+			window, err := dataprovider.ParseAccessWindow(s)
+			if err != nil {
+				return err
+			}
+			u.AccessWindows = append(u.AccessWindows, window)
+		}
+	}
+	return u.Validate()
+}
+
+// parseDate parses an RFC3339 date. an empty string is no date
+func parseDate(s string) (*time.Time, error) {
+	if s == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return nil, fmt.Errorf("date must be RFC3339 (like 2020-03-22T18:00:00Z): %q", s)
+	}
+	return &t, nil
+}
+
+// checkGroups returns an error for the first group which does not exist
+func checkGroups(p dataprovider.Provider, groups []string) error {
+	for _, name := range groups {
+		group, err := p.GetGroup(name)
+		if err != nil {
+			return err
+		}
+		if group == nil {
+			return fmt.Errorf("group does not exist: %s", name)
+		}
+	}
+	return nil
+}
+
+// readSecret reads a secret from the first line of stdin
+func readSecret() (string, error) {
+	fmt.Fprint(os.Stderr, "secret: ")
+	line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && line == "" {
+		return "", fmt.Errorf("unable to read secret from stdin: %v", err)
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+// recordOffline adds an event to the audit history of the data provider,
+// with the operating system user as the admin identity
+func recordOffline(p dataprovider.Provider, e audit.Event) {
+	e.Actor = "cli"
+	if current, err := user.Current(); err == nil {
+		e.Actor = "cli:" + current.Username
+	}
+	e.Version = audit.SchemaVersion
+	e.Timestamp = time.Now().UTC()
+	e.Decision = audit.DecisionAllow
+	if err := p.AddAuditEvent(&e); err != nil {
+		log.Warningf("unable to record audit event: %v", err)
+	}
+}
+
+// printJSON prints v as indented json to stdout
+func printJSON(v interface{}) error {
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
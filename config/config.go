@@ -11,11 +11,12 @@ const (
 
 var (
 	EnvConfigPrefix = strings.ToLower(AppName)
+	envKeyReplacer  = strings.NewReplacer(".", "_")
 )
 
 // ConfigInit instantiates and validates the configuration options
 // optionally it can print out a configuration summary
-func ConfigInit(cfgFile string, printConfig bool) {
+func ConfigInit(cfgFile string, printConfig bool) error {
 
 	// init viper
 	initViper(cfgFile)
@@ -26,26 +27,18 @@ func ConfigInit(cfgFile string, printConfig bool) {
 	}
 
 	// Sanity checks
-	sanityChecks()
-
-	// assign variable values to config values...
-
-	return
+	return sanityChecks()
 }
 
 // setup viper
 func initViper(cfgFile string) {
 
 	// Set some defaults
-	viper.SetDefault("log_level", "DEBUG")
-	viper.SetDefault("server.bind_address", "127.0.0.1")
-	viper.SetDefault("server.bind_port", "8080")
-	viper.SetDefault("server.access_log", true)
-	viper.SetDefault("db.provider", "bolt")
+	setDefaults(viper.GetViper())
 
 	// Configuring and pulling overrides from environmental variables
 	viper.SetEnvPrefix(EnvConfigPrefix)
-	viper.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
+	viper.SetEnvKeyReplacer(envKeyReplacer)
 	viper.AutomaticEnv()
 
 	// set default config name and paths to look for it
@@ -71,6 +64,58 @@ func initViper(cfgFile string) {
 	}
 }
 
+// setDefaults sets the default value of every setting
+func setDefaults(v *viper.Viper) {
+	v.SetDefault("log_level", "DEBUG")
+	v.SetDefault("server.bind_address", "127.0.0.1")
+	v.SetDefault("server.bind_port", "8080")
+	v.SetDefault("server.access_log", true)
+	v.SetDefault("server.enable_metrics", false)
+	v.SetDefault("server.shutdown_timeout", "30s")
+	v.SetDefault("server.admin_listener.enabled", false)
+	v.SetDefault("server.admin_listener.bind_address", "127.0.0.1")
+	v.SetDefault("server.admin_listener.bind_port", "8081")
+	v.SetDefault("server.admin_listener.route_groups", []string{"admin", "debug"})
+	v.SetDefault("server.unix_socket.enabled", false)
+	v.SetDefault("server.unix_socket.path", "/run/protego.sock")
+	v.SetDefault("server.unix_socket.mode", "0660")
+	v.SetDefault("server.unix_socket.route_groups", []string{"authorize"})
+	v.SetDefault("admin.allow_unauthenticated", false)
+	v.SetDefault("access_windows.timezone", "Local")
+	v.SetDefault("sliding_ttl.renew_interval", "1m")
+	v.SetDefault("authorize_cache.enabled", true)
+	v.SetDefault("authorize_cache.ttl", "5s")
+	v.SetDefault("authorize_cache.max_entries", 10000)
+	v.SetDefault("geoip.enabled", false)
+	v.SetDefault("geoip.database", "/var/lib/GeoIP/GeoLite2-Country.mmdb")
+	v.SetDefault("geoip.challenge_policy", "reject")
+	v.SetDefault("db.provider", "bolt")
+	v.SetDefault("config.watch", false)
+	v.SetDefault("ddns.update_interval", "120m")
+	v.SetDefault("audit.enabled", false)
+	v.SetDefault("audit.file", "-")
+	v.SetDefault("audit.log_authorize_allow", false)
+	v.SetDefault("audit.history.enabled", true)
+	v.SetDefault("audit.history.max_age", "720h")
+	v.SetDefault("audit.history.max_events", 10000)
+	v.SetDefault("audit.history.include_authorize", false)
+	v.SetDefault("audit.failure_burst.threshold", 5)
+	v.SetDefault("audit.failure_burst.window", "5m")
+	v.SetDefault("webhooks.enabled", false)
+	v.SetDefault("webhooks.timeout", "5s")
+	v.SetDefault("webhooks.max_retries", 3)
+	v.SetDefault("webhooks.retry_backoff", "1s")
+	v.SetDefault("email.enabled", false)
+	v.SetDefault("email.from", "")
+	v.SetDefault("email.subject", "Your access was used from {{.IP}}{{with .Location}}, {{.}}{{end}}")
+	v.SetDefault("email.template", "")
+	v.SetDefault("email.smtp.host", "localhost")
+	v.SetDefault("email.smtp.port", 587)
+	v.SetDefault("email.smtp.security", "starttls")
+	v.SetDefault("email.smtp.username", "")
+	v.SetDefault("email.smtp.password", "")
+}
+
 // prints the config options
 func printConfigSummary() {
 
@@ -80,17 +125,64 @@ func printConfigSummary() {
 		"server.bind_address",
 		"server.bind_port",
 		"server.tls.enabled",
+		"server.tls.client_auth_enabled",
+		"server.tls.client_auth_route_groups",
+		"server.tls.client_auth_admin_identity",
 		"server.access_log",
+		"server.admin_listener.enabled",
+		"server.admin_listener.bind_address",
+		"server.admin_listener.bind_port",
+		"server.admin_listener.route_groups",
+		"server.unix_socket.enabled",
+		"server.unix_socket.path",
+		"server.unix_socket.mode",
+		"server.unix_socket.owner",
+		"server.unix_socket.group",
+		"server.unix_socket.route_groups",
 		"server.compression",
+		"server.enable_metrics",
+		"server.shutdown_timeout",
+		"admin.allow_unauthenticated",
+		"access_windows.timezone",
+		"sliding_ttl.renew_interval",
+		"authorize_cache.enabled",
+		"authorize_cache.ttl",
+		"authorize_cache.max_entries",
+		"geoip.enabled",
+		"geoip.database",
+		"geoip.challenge_policy",
 		"db.provider",
 		"db.bolt.file",
+		"config.watch",
+		"ddns.update_interval",
+		"audit.enabled",
+		"audit.file",
+		"audit.log_authorize_allow",
+		"audit.history.enabled",
+		"audit.history.max_age",
+		"audit.history.max_events",
+		"audit.history.include_authorize",
+		"audit.failure_burst.threshold",
+		"audit.failure_burst.window",
+		"webhooks.enabled",
+		"webhooks.timeout",
+		"webhooks.max_retries",
+		"webhooks.retry_backoff",
+		"email.enabled",
+		"email.from",
+		"email.subject",
+		"email.template",
+		"email.smtp.host",
+		"email.smtp.port",
+		"email.smtp.security",
+		"email.smtp.username",
 	} {
 		log.Debugf("%s: %s\n", c, viper.GetString(c))
 	}
 }
 
-// checks that the config is correctly defined
-func sanityChecks() {
-
-	// check stuff here
+// checks that the config is correctly defined.
+// the returned *ValidationError lists every problem found
+func sanityChecks() error {
+	return Validate(viper.GetViper())
 }
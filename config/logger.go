@@ -15,6 +15,9 @@ const logFormat = `%{level:.1s} %{time:2006-01-02 15:04:05} (%{shortpkg} %{short
 // global logger for this package
 var log = logging.MustGetLogger(AppName)
 
+// the backend which controls the log level, set by loggingInit
+var leveledBackend logging.LeveledBackend
+
 func GetLogger() *logging.Logger {
 	return log
 }
@@ -46,8 +49,18 @@ func loggingInit(logLevelString string) {
 	logBackendFormatter := logging.NewBackendFormatter(logBackend, logFormatter)
 
 	// Setup the log level for each backend
-	leveledBackend := logging.AddModuleLevel(logBackendFormatter)
+	leveledBackend = logging.AddModuleLevel(logBackendFormatter)
 	leveledBackend.SetLevel(logLevel, "")
 
 	logging.SetBackend(leveledBackend)
 }
+
+// setLogLevel changes the log level without touching the log backends
+func setLogLevel(logLevelString string) error {
+	logLevel, err := logging.LogLevel(logLevelString)
+	if err != nil {
+		return err
+	}
+	leveledBackend.SetLevel(logLevel, "")
+	return nil
+}
@@ -0,0 +1,146 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/spf13/viper"
+)
+
+// changes to the config file are applied once no other change happened for this long.
+// editors (and kubernetes config maps) usually write a file in more than one step
+const watchDebounce = 500 * time.Millisecond
+
+// settings which are only read at startup. Changing them requires a restart
+var restartRequiredSettings = []string{
+	"server.bind_address",
+	"server.bind_port",
+	"server.compression",
+	"server.admin_listener.enabled",
+	"server.admin_listener.bind_address",
+	"server.admin_listener.bind_port",
+	"server.admin_listener.route_groups",
+	"server.unix_socket.enabled",
+	"server.unix_socket.path",
+	"server.unix_socket.mode",
+	"server.unix_socket.owner",
+	"server.unix_socket.group",
+	"server.unix_socket.route_groups",
+	"server.enable_profiler",
+	"server.enable_metrics",
+	"server.tls.enabled",
+	"server.tls.client_auth_enabled",
+	"server.tls.client_auth_ca",
+	"server.tls.client_auth_route_groups",
+	"db.provider",
+	"db.bolt.file",
+	"ddns.update_interval",
+	"authorize_cache.enabled",
+	"authorize_cache.max_entries",
+	"audit.enabled",
+	"audit.file",
+	"audit.history.enabled",
+	"audit.history.max_age",
+	"audit.history.max_events",
+	"audit.history.include_authorize",
+	"webhooks.enabled",
+	"webhooks.timeout",
+	"webhooks.max_retries",
+	"webhooks.retry_backoff",
+	"webhooks.endpoints",
+	"email.enabled",
+	"email.subject",
+	"email.template",
+	"config.watch",
+}
+
+// Reload reads the config file again. The new configuration is validated
+// before it is applied; when it is invalid, the current configuration is kept.
+// The log level is applied immediately. It returns the settings which have
+// changed but only take effect after a restart.
+func Reload() (restartRequired []string, err error) {
+	cfgFile := viper.ConfigFileUsed()
+	if cfgFile == "" {
+		return nil, fmt.Errorf("no config file is in use")
+	}
+
+	// read and validate the new config without touching the current one
+	candidate := viper.New()
+	setDefaults(candidate)
+	candidate.SetConfigType("yaml")
+	candidate.SetConfigFile(cfgFile)
+	candidate.SetEnvPrefix(EnvConfigPrefix)
+	candidate.SetEnvKeyReplacer(envKeyReplacer)
+	candidate.AutomaticEnv()
+	if err = candidate.ReadInConfig(); err != nil {
+		return nil, fmt.Errorf("unable to read config file %s: %v", cfgFile, err)
+	}
+	if err = Validate(candidate); err != nil {
+		return nil, err
+	}
+
+	// the new config is valid, apply it. Settings are read by requests
+	// at the same time, which must wait until the new settings are in place
+	settingsLock.Lock()
+	defer settingsLock.Unlock()
+	for _, key := range restartRequiredSettings {
+		if !reflect.DeepEqual(viper.Get(key), candidate.Get(key)) {
+			restartRequired = append(restartRequired, key)
+		}
+	}
+	if err = viper.ReadInConfig(); err != nil {
+		return nil, fmt.Errorf("unable to read config file %s: %v", cfgFile, err)
+	}
+	if err = setLogLevel(viper.GetString("log_level")); err != nil {
+		return nil, err
+	}
+	log.Infof("configuration has been reloaded from %s", cfgFile)
+	return
+}
+
+// WatchConfigFile calls onChange whenever the config file in use changes.
+// the directory of the config file is watched, so that files which are
+// replaced (instead of written to) are also detected. Blocks until ctx is cancelled.
+func WatchConfigFile(ctx context.Context, onChange func()) error {
+	cfgFile := viper.ConfigFileUsed()
+	if cfgFile == "" {
+		return fmt.Errorf("no config file is in use")
+	}
+	cfgFile = filepath.Clean(cfgFile)
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	defer watcher.Close()
+	if err = watcher.Add(filepath.Dir(cfgFile)); err != nil {
+		return err
+	}
+	log.Infof("watching config file for changes: %s", cfgFile)
+
+	// fires once the config file has stopped changing
+	debounce := time.NewTimer(watchDebounce)
+	debounce.Stop()
+	defer debounce.Stop()
+	realFile, _ := filepath.EvalSymlinks(cfgFile)
+	for {
+		select {
+		case event := <-watcher.Events:
+			// symlinks are resolved again, since their target may be what changed
+			currentFile, _ := filepath.EvalSymlinks(cfgFile)
+			if filepath.Clean(event.Name) == cfgFile || currentFile != realFile {
+				realFile = currentFile
+				debounce.Reset(watchDebounce)
+			}
+		case err := <-watcher.Errors:
+			log.Errorf("error watching config file: %v", err)
+		case <-debounce.C:
+			onChange()
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
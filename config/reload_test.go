@@ -0,0 +1,72 @@
+package config
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/spf13/viper"
+)
+
+// writeConfig writes a config file with the settings of yaml
+func writeConfig(t *testing.T, path, yaml string) {
+	t.Helper()
+	if err := ioutil.WriteFile(path, []byte(yaml), 0600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReload(t *testing.T) {
+	cfgFile := filepath.Join(t.TempDir(), "protego.yaml")
+	writeConfig(t, cfgFile, "log_level: ERROR\nadmin:\n  secret: first\n")
+	initViper(cfgFile)
+	t.Cleanup(viper.Reset)
+
+	// settings are read by requests while the config is reloaded
+	stop := make(chan struct{})
+	readers := new(sync.WaitGroup)
+	for i := 0; i < 4; i++ {
+		readers.Add(1)
+		go func() {
+			defer readers.Done()
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+					if secret := GetString("admin.secret"); secret != "first" && secret != "second" {
+						t.Errorf("unexpected admin.secret during reload: %q", secret)
+						return
+					}
+					GetBool("server.access_log")
+				}
+			}
+		}()
+	}
+
+	writeConfig(t, cfgFile, "log_level: ERROR\nadmin:\n  secret: second\nserver:\n  bind_port: 9090\n")
+	for i := 0; i < 10; i++ {
+		restartRequired, err := Reload()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if i == 0 && (len(restartRequired) != 1 || restartRequired[0] != "server.bind_port") {
+			t.Fatalf("expected server.bind_port to require a restart, got %v", restartRequired)
+		}
+	}
+	close(stop)
+	readers.Wait()
+	if secret := GetString("admin.secret"); secret != "second" {
+		t.Fatalf("expected the new admin.secret to be applied, got %q", secret)
+	}
+
+	// an invalid config is rejected as a whole
+	writeConfig(t, cfgFile, "log_level: ERROR\nadmin:\n  secret: third\ndb:\n  provider: nope\n")
+	if _, err := Reload(); err == nil {
+		t.Fatal("expected an invalid config to be rejected")
+	}
+	if secret := GetString("admin.secret"); secret != "second" {
+		t.Fatalf("expected the current config to be kept, got admin.secret %q", secret)
+	}
+}
@@ -0,0 +1,54 @@
+package config
+
+import (
+	"sync"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// settingsLock protects the settings, which are replaced by Reload while they are read.
+// every setting read while the server is running goes through the functions below
+var settingsLock = new(sync.RWMutex)
+
+// GetBool returns the value of a setting as a bool
+func GetBool(key string) bool {
+	settingsLock.RLock()
+	defer settingsLock.RUnlock()
+	return viper.GetBool(key)
+}
+
+// GetDuration returns the value of a setting as a duration
+func GetDuration(key string) time.Duration {
+	settingsLock.RLock()
+	defer settingsLock.RUnlock()
+	return viper.GetDuration(key)
+}
+
+// GetInt returns the value of a setting as an int
+func GetInt(key string) int {
+	settingsLock.RLock()
+	defer settingsLock.RUnlock()
+	return viper.GetInt(key)
+}
+
+// GetString returns the value of a setting as a string
+func GetString(key string) string {
+	settingsLock.RLock()
+	defer settingsLock.RUnlock()
+	return viper.GetString(key)
+}
+
+// GetStringSlice returns the value of a setting as a slice of strings
+func GetStringSlice(key string) []string {
+	settingsLock.RLock()
+	defer settingsLock.RUnlock()
+	return viper.GetStringSlice(key)
+}
+
+// UnmarshalKey decodes the value of a setting into rawVal
+func UnmarshalKey(key string, rawVal interface{}) error {
+	settingsLock.RLock()
+	defer settingsLock.RUnlock()
+	return viper.UnmarshalKey(key, rawVal)
+}
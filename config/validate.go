@@ -0,0 +1,399 @@
+package config
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/mail"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	validate "github.com/asaskevich/govalidator"
+	logging "github.com/op/go-logging"
+	"github.com/spf13/cast"
+	"github.com/spf13/viper"
+)
+
+// the groups of routes which can be referenced in the config.
+// must match the route groups of the server package
+var routeGroups = []string{"public", "authorize", "admin", "debug"}
+
+// ValidationError holds every problem found in a configuration
+type ValidationError struct {
+	Problems []string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("configuration has %d problem(s):\n  - %s", len(e.Problems), strings.Join(e.Problems, "\n  - "))
+}
+
+// a setting and the checks its value must pass
+type setting struct {
+	key string
+	// the setting is only checked when this (boolean) setting is true
+	when   string
+	checks []check
+}
+
+// a check returns an error describing why a value is invalid
+type check func(v *viper.Viper, key string) error
+
+// settings with a value which can be checked on its own.
+// checks involving more than one setting are in conflicts
+var schema = []setting{
+	{key: "log_level", checks: []check{isLogLevel}},
+	{key: "config.watch", checks: []check{isBool}},
+	{key: "db.provider", checks: []check{oneOf("bolt", "memory")}},
+	{key: "ddns.update_interval", checks: []check{isPositiveDuration}},
+	{key: "admin.allow_unauthenticated", checks: []check{isBool}},
+	{key: "access_windows.timezone", checks: []check{isTimezone}},
+	{key: "sliding_ttl.renew_interval", checks: []check{isPositiveDuration}},
+	{key: "authorize_cache.enabled", checks: []check{isBool}},
+	{key: "authorize_cache.ttl", when: "authorize_cache.enabled", checks: []check{isPositiveDuration}},
+	{key: "authorize_cache.max_entries", when: "authorize_cache.enabled", checks: []check{isInt(1, -1)}},
+	{key: "geoip.enabled", checks: []check{isBool}},
+	{key: "geoip.database", when: "geoip.enabled", checks: []check{isRequired, isReadableFile}},
+	{key: "geoip.challenge_policy", checks: []check{oneOf("reject", "flag")}},
+	{key: "audit.enabled", checks: []check{isBool}},
+	{key: "audit.log_authorize_allow", checks: []check{isBool}},
+	{key: "audit.file", when: "audit.enabled", checks: []check{isRequired, parentDirExists}},
+	{key: "audit.history.enabled", checks: []check{isBool}},
+	{key: "audit.history.include_authorize", checks: []check{isBool}},
+	{key: "audit.history.max_age", when: "audit.history.enabled", checks: []check{isDuration}},
+	{key: "audit.history.max_events", when: "audit.history.enabled", checks: []check{isInt(0, -1)}},
+	{key: "audit.failure_burst.threshold", checks: []check{isInt(0, -1)}},
+	{key: "audit.failure_burst.window", checks: []check{isPositiveDuration}},
+	{key: "webhooks.enabled", checks: []check{isBool}},
+	{key: "webhooks.timeout", when: "webhooks.enabled", checks: []check{isPositiveDuration}},
+	{key: "webhooks.max_retries", when: "webhooks.enabled", checks: []check{isInt(0, -1)}},
+	{key: "webhooks.retry_backoff", when: "webhooks.enabled", checks: []check{isPositiveDuration}},
+	{key: "webhooks.endpoints", when: "webhooks.enabled", checks: []check{areWebhookEndpoints}},
+	{key: "email.enabled", checks: []check{isBool}},
+	{key: "email.from", when: "email.enabled", checks: []check{isRequired, isMailAddress}},
+	{key: "email.subject", when: "email.enabled", checks: []check{isRequired, isTemplate}},
+	{key: "email.template", when: "email.enabled", checks: []check{isTemplateFile}},
+	{key: "email.smtp.host", when: "email.enabled", checks: []check{isRequired, isHost}},
+	{key: "email.smtp.port", when: "email.enabled", checks: []check{isPort}},
+	{key: "email.smtp.security", when: "email.enabled", checks: []check{oneOf("starttls", "tls", "none")}},
+	{key: "server.bind_address", checks: []check{isHost}},
+	{key: "server.bind_port", checks: []check{isPort}},
+	{key: "server.access_log", checks: []check{isBool}},
+	{key: "server.compression", checks: []check{isBool}},
+	{key: "server.enable_profiler", checks: []check{isBool}},
+	{key: "server.enable_metrics", checks: []check{isBool}},
+	{key: "server.shutdown_timeout", checks: []check{isPositiveDuration}},
+	{key: "server.admin_listener.enabled", checks: []check{isBool}},
+	{key: "server.admin_listener.bind_address", when: "server.admin_listener.enabled", checks: []check{isHost}},
+	{key: "server.admin_listener.bind_port", when: "server.admin_listener.enabled", checks: []check{isPort}},
+	{key: "server.admin_listener.route_groups", when: "server.admin_listener.enabled", checks: []check{isRequired, areRouteGroups}},
+	{key: "server.unix_socket.enabled", checks: []check{isBool}},
+	{key: "server.unix_socket.path", when: "server.unix_socket.enabled", checks: []check{isRequired, parentDirExists}},
+	{key: "server.unix_socket.mode", when: "server.unix_socket.enabled", checks: []check{isFileMode}},
+	{key: "server.unix_socket.route_groups", when: "server.unix_socket.enabled", checks: []check{isRequired, areRouteGroups}},
+	{key: "server.tls.enabled", checks: []check{isBool}},
+	{key: "server.tls.cert_chain", when: "server.tls.enabled", checks: []check{isRequired, isReadableFile}},
+	{key: "server.tls.private_key", when: "server.tls.enabled", checks: []check{isRequired, isReadableFile}},
+	{key: "server.tls.client_auth_enabled", checks: []check{isBool}},
+	{key: "server.tls.client_auth_ca", when: "server.tls.client_auth_enabled", checks: []check{isRequired, isCertPool}},
+	{key: "server.tls.client_auth_route_groups", when: "server.tls.client_auth_enabled", checks: []check{areRouteGroups}},
+	{key: "server.tls.client_auth_admin_identity", checks: []check{isBool}},
+}
+
+// checks involving more than one setting
+var conflicts = []func(v *viper.Viper) error{
+	checkAdminSecret,
+	checkTLSKeyPair,
+	checkClientAuth,
+	checkListenerAddresses,
+	checkListenerGroups,
+}
+
+// Validate returns a *ValidationError describing every problem found in v, or nil
+func Validate(v *viper.Viper) error {
+	var problems []string
+	for _, s := range schema {
+		// a condition which is itself invalid is reported on its own
+		if s.when != "" {
+			if enabled, err := cast.ToBoolE(v.Get(s.when)); err != nil || !enabled {
+				continue
+			}
+		}
+		for _, c := range s.checks {
+			if err := c(v, s.key); err != nil {
+				problems = append(problems, fmt.Sprintf("%s: %v", s.key, err))
+				break
+			}
+		}
+	}
+	// conflicts are only meaningful when every setting has a valid value
+	if len(problems) == 0 {
+		for _, c := range conflicts {
+			if err := c(v); err != nil {
+				problems = append(problems, err.Error())
+			}
+		}
+	}
+	if len(problems) > 0 {
+		return &ValidationError{Problems: problems}
+	}
+	return nil
+}
+
+func isBool(v *viper.Viper, key string) error {
+	if _, err := cast.ToBoolE(v.Get(key)); err != nil {
+		return fmt.Errorf("must be true or false, got %q", v.GetString(key))
+	}
+	return nil
+}
+
+func isRequired(v *viper.Viper, key string) error {
+	if v.GetString(key) == "" && len(v.GetStringSlice(key)) == 0 {
+		return fmt.Errorf("must be set")
+	}
+	return nil
+}
+
+func isDuration(v *viper.Viper, key string) error {
+	if _, err := cast.ToDurationE(v.Get(key)); err != nil {
+		return fmt.Errorf("must be a duration (like 30s, 15m or 2h), got %q", v.GetString(key))
+	}
+	return nil
+}
+
+func isPositiveDuration(v *viper.Viper, key string) error {
+	if err := isDuration(v, key); err != nil {
+		return err
+	}
+	if v.GetDuration(key) <= 0 {
+		return fmt.Errorf("must be greater than zero, got %q", v.GetString(key))
+	}
+	return nil
+}
+
+// isInt returns a check for an integer within [min, max]. a negative max means no maximum
+func isInt(min, max int) check {
+	return func(v *viper.Viper, key string) error {
+		i, err := cast.ToIntE(v.Get(key))
+		if err != nil {
+			return fmt.Errorf("must be an integer, got %q", v.GetString(key))
+		}
+		if i < min || (max >= 0 && i > max) {
+			if max < 0 {
+				return fmt.Errorf("must be at least %d, got %d", min, i)
+			}
+			return fmt.Errorf("must be between %d and %d, got %d", min, max, i)
+		}
+		return nil
+	}
+}
+
+func isPort(v *viper.Viper, key string) error {
+	return isInt(1, 65535)(v, key)
+}
+
+func isHost(v *viper.Viper, key string) error {
+	if host := v.GetString(key); net.ParseIP(host) == nil && !validate.IsDNSName(host) {
+		return fmt.Errorf("must be an IP address or hostname, got %q", host)
+	}
+	return nil
+}
+
+func isLogLevel(v *viper.Viper, key string) error {
+	if _, err := logging.LogLevel(v.GetString(key)); err != nil {
+		return fmt.Errorf("must be one of CRITICAL, ERROR, WARNING, NOTICE, INFO or DEBUG, got %q", v.GetString(key))
+	}
+	return nil
+}
+
+func isTimezone(v *viper.Viper, key string) error {
+	if _, err := time.LoadLocation(v.GetString(key)); err != nil {
+		return fmt.Errorf("must be an IANA timezone (like UTC or Europe/Paris), got %q", v.GetString(key))
+	}
+	return nil
+}
+
+func isFileMode(v *viper.Viper, key string) error {
+	if _, err := strconv.ParseUint(v.GetString(key), 8, 32); err != nil {
+		return fmt.Errorf("must be an octal file mode (like 0660), got %q", v.GetString(key))
+	}
+	return nil
+}
+
+// oneOf returns a check for a value (case insensitive) in values
+func oneOf(values ...string) check {
+	return func(v *viper.Viper, key string) error {
+		value := strings.ToLower(v.GetString(key))
+		for _, allowed := range values {
+			if value == allowed {
+				return nil
+			}
+		}
+		return fmt.Errorf("must be one of %s, got %q", strings.Join(values, ", "), v.GetString(key))
+	}
+}
+
+func areRouteGroups(v *viper.Viper, key string) error {
+groups:
+	for _, group := range v.GetStringSlice(key) {
+		for _, known := range routeGroups {
+			if strings.EqualFold(group, known) {
+				continue groups
+			}
+		}
+		return fmt.Errorf("unknown route group %q, groups are %s", group, strings.Join(routeGroups, ", "))
+	}
+	return nil
+}
+
+// areWebhookEndpoints checks a list of webhooks, which each have an http(s) url
+func areWebhookEndpoints(v *viper.Viper, key string) error {
+	endpoints, err := cast.ToSliceE(v.Get(key))
+	if err != nil {
+		return fmt.Errorf("must be a list of webhooks")
+	}
+	if len(endpoints) == 0 {
+		return fmt.Errorf("must have at least one webhook")
+	}
+	for i, endpoint := range endpoints {
+		fields, err := cast.ToStringMapE(endpoint)
+		if err != nil {
+			return fmt.Errorf("webhook %d must have a url, and optionally a secret and events", i+1)
+		}
+		u, err := url.Parse(cast.ToString(fields["url"]))
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("webhook %d must have an http or https url, got %q", i+1, cast.ToString(fields["url"]))
+		}
+		if _, err = cast.ToStringSliceE(fields["events"]); fields["events"] != nil && err != nil {
+			return fmt.Errorf("events of webhook %d must be a list of event types", i+1)
+		}
+	}
+	return nil
+}
+
+func isMailAddress(v *viper.Viper, key string) error {
+	if _, err := mail.ParseAddress(v.GetString(key)); err != nil {
+		return fmt.Errorf("must be an email address (like protego@example.com or Protego <protego@example.com>), got %q", v.GetString(key))
+	}
+	return nil
+}
+
+func isTemplate(v *viper.Viper, key string) error {
+	if _, err := template.New(key).Parse(v.GetString(key)); err != nil {
+		return fmt.Errorf("must be a valid template: %v", err)
+	}
+	return nil
+}
+
+// isTemplateFile checks the template in the file at the value, which is optional
+func isTemplateFile(v *viper.Viper, key string) error {
+	if v.GetString(key) == "" {
+		return nil
+	}
+	if _, err := template.ParseFiles(v.GetString(key)); err != nil {
+		return fmt.Errorf("must be a readable and valid template: %v", err)
+	}
+	return nil
+}
+
+func isReadableFile(v *viper.Viper, key string) error {
+	f, err := os.Open(v.GetString(key))
+	if err != nil {
+		return err
+	}
+	return f.Close()
+}
+
+func parentDirExists(v *viper.Viper, key string) error {
+	path := v.GetString(key)
+	if path == "-" {
+		return nil
+	}
+	dir := filepath.Dir(path)
+	if info, err := os.Stat(dir); err != nil || !info.IsDir() {
+		return fmt.Errorf("directory %s does not exist", dir)
+	}
+	return nil
+}
+
+func isCertPool(v *viper.Viper, key string) error {
+	pem, err := ioutil.ReadFile(v.GetString(key))
+	if err != nil {
+		return err
+	}
+	if !x509.NewCertPool().AppendCertsFromPEM(pem) {
+		return fmt.Errorf("no PEM encoded certificate found in %s", v.GetString(key))
+	}
+	return nil
+}
+
+// the admin API is only left unauthenticated when admin.secret is empty, which must be explicit.
+// allowing it while admin.secret is set would silently open the admin API once the secret is removed
+// (for example in favor of admin API tokens)
+func checkAdminSecret(v *viper.Viper) error {
+	if v.GetBool("admin.allow_unauthenticated") && v.GetString("admin.secret") != "" {
+		return fmt.Errorf("admin.allow_unauthenticated: must be false while admin.secret is set, otherwise removing the secret leaves the admin API unauthenticated")
+	}
+	return nil
+}
+
+func checkTLSKeyPair(v *viper.Viper) error {
+	if !v.GetBool("server.tls.enabled") {
+		return nil
+	}
+	if _, err := tls.LoadX509KeyPair(v.GetString("server.tls.cert_chain"), v.GetString("server.tls.private_key")); err != nil {
+		return fmt.Errorf("server.tls: unable to load cert_chain and private_key: %v", err)
+	}
+	return nil
+}
+
+func checkClientAuth(v *viper.Viper) error {
+	if v.GetBool("server.tls.client_auth_enabled") && !v.GetBool("server.tls.enabled") {
+		return fmt.Errorf("server.tls.client_auth_enabled: requires server.tls.enabled")
+	}
+	if v.GetBool("server.tls.client_auth_admin_identity") && !v.GetBool("server.tls.client_auth_enabled") {
+		return fmt.Errorf("server.tls.client_auth_admin_identity: requires server.tls.client_auth_enabled")
+	}
+	return nil
+}
+
+func checkListenerAddresses(v *viper.Viper) error {
+	if !v.GetBool("server.admin_listener.enabled") {
+		return nil
+	}
+	if v.GetInt("server.bind_port") != v.GetInt("server.admin_listener.bind_port") {
+		return nil
+	}
+	main, admin := v.GetString("server.bind_address"), v.GetString("server.admin_listener.bind_address")
+	if main == admin || isUnspecified(main) || isUnspecified(admin) {
+		return fmt.Errorf("server.admin_listener: bind_address and bind_port overlap with the main listener")
+	}
+	return nil
+}
+
+// a route group can only be moved away from the main listener once
+func checkListenerGroups(v *viper.Viper) error {
+	if !v.GetBool("server.admin_listener.enabled") || !v.GetBool("server.unix_socket.enabled") {
+		return nil
+	}
+	for _, group := range v.GetStringSlice("server.unix_socket.route_groups") {
+		for _, adminGroup := range v.GetStringSlice("server.admin_listener.route_groups") {
+			if strings.EqualFold(group, adminGroup) {
+				return fmt.Errorf("server.unix_socket.route_groups: group %q is already served by server.admin_listener", group)
+			}
+		}
+	}
+	return nil
+}
+
+// isUnspecified returns true for an address which binds to every interface
+func isUnspecified(address string) bool {
+	ip := net.ParseIP(address)
+	return ip != nil && ip.IsUnspecified()
+}
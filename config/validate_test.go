@@ -0,0 +1,80 @@
+package config
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+)
+
+// testConfig returns the default config, with settings overridden
+func testConfig(settings map[string]interface{}) *viper.Viper {
+	v := viper.New()
+	setDefaults(v)
+	for key, value := range settings {
+		v.Set(key, value)
+	}
+	return v
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings map[string]interface{}
+		// a part of every expected problem, none when empty
+		problems []string
+	}{
+		{"defaults", nil, nil},
+		{"unknown provider", map[string]interface{}{"db.provider": "mysql"}, []string{`db.provider: must be one of bolt, memory, got "mysql"`}},
+		{"provider is case insensitive", map[string]interface{}{"db.provider": "Memory"}, nil},
+		{"invalid log level", map[string]interface{}{"log_level": "LOUD"}, []string{"log_level: must be one of"}},
+		{"invalid bool", map[string]interface{}{"server.access_log": "sometimes"}, []string{`server.access_log: must be true or false, got "sometimes"`}},
+		{"invalid duration", map[string]interface{}{"ddns.update_interval": "often"}, []string{"ddns.update_interval: must be a duration"}},
+		{"zero duration", map[string]interface{}{"ddns.update_interval": "0s"}, []string{"ddns.update_interval: must be greater than zero"}},
+		{"port out of range", map[string]interface{}{"server.bind_port": 70000}, []string{"server.bind_port: must be between 1 and 65535, got 70000"}},
+		{"invalid host", map[string]interface{}{"server.bind_address": "not a host"}, []string{"server.bind_address: must be an IP address or hostname"}},
+		{"every problem is reported", map[string]interface{}{"db.provider": "mysql", "server.bind_port": 0}, []string{"db.provider", "server.bind_port"}},
+		// settings which depend on a disabled setting are not checked
+		{"disabled audit log", map[string]interface{}{"audit.file": "/does/not/exist/audit.log"}, nil},
+		{"enabled audit log", map[string]interface{}{"audit.enabled": true, "audit.file": "/does/not/exist/audit.log"}, []string{"audit.file: directory /does/not/exist does not exist"}},
+		{"missing TLS files", map[string]interface{}{"server.tls.enabled": true}, []string{"server.tls.cert_chain: must be set", "server.tls.private_key: must be set"}},
+		{"unknown route group", map[string]interface{}{"server.admin_listener.enabled": true, "server.admin_listener.route_groups": []string{"admin", "metrics"}}, []string{`unknown route group "metrics"`}},
+		// conflicts
+		{"client auth without TLS", map[string]interface{}{"server.tls.client_auth_enabled": true, "server.tls.client_auth_ca": "../testdata/sampleconfig/protego.yaml"}, []string{"server.tls.client_auth_ca: no PEM encoded certificate"}},
+		{"admin identity without client auth", map[string]interface{}{"server.tls.client_auth_admin_identity": true}, []string{"server.tls.client_auth_admin_identity: requires server.tls.client_auth_enabled"}},
+		{"overlapping listeners", map[string]interface{}{"server.admin_listener.enabled": true, "server.admin_listener.bind_port": 8080}, []string{"server.admin_listener: bind_address and bind_port overlap"}},
+		{"separate listeners", map[string]interface{}{"server.admin_listener.enabled": true, "server.admin_listener.bind_address": "127.0.0.2", "server.admin_listener.bind_port": 8080}, nil},
+		{"unspecified address overlaps", map[string]interface{}{"server.bind_address": "0.0.0.0", "server.admin_listener.enabled": true, "server.admin_listener.bind_address": "127.0.0.2", "server.admin_listener.bind_port": 8080}, []string{"overlap with the main listener"}},
+		{"group served twice", map[string]interface{}{"server.admin_listener.enabled": true, "server.unix_socket.enabled": true, "server.unix_socket.path": filepath.Join("..", "protego.sock"), "server.unix_socket.route_groups": []string{"authorize", "admin"}}, []string{`group "admin" is already served by server.admin_listener`}},
+		// the admin API is only unauthenticated without admin.secret, when explicitly allowed
+		{"admin secret", map[string]interface{}{"admin.secret": "supersecret"}, nil},
+		{"unauthenticated admin API", map[string]interface{}{"admin.allow_unauthenticated": true}, nil},
+		{"unauthenticated admin API with a secret", map[string]interface{}{"admin.secret": "supersecret", "admin.allow_unauthenticated": true}, []string{"admin.allow_unauthenticated: must be false while admin.secret is set"}},
+		// conflicts are only checked once every setting is valid
+		{"conflict and invalid setting", map[string]interface{}{"server.tls.client_auth_admin_identity": true, "db.provider": "mysql"}, []string{"db.provider"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := Validate(testConfig(test.settings))
+			if len(test.problems) == 0 {
+				if err != nil {
+					t.Fatalf("expected no problem, got %v", err)
+				}
+				return
+			}
+			validationErr, ok := err.(*ValidationError)
+			if !ok {
+				t.Fatalf("expected a *ValidationError, got %v", err)
+			}
+			if len(validationErr.Problems) != len(test.problems) {
+				t.Fatalf("expected %d problem(s), got %v", len(test.problems), validationErr.Problems)
+			}
+			for i, problem := range test.problems {
+				if !strings.Contains(validationErr.Problems[i], problem) {
+					t.Errorf("expected problem %d to contain %q, got %q", i, problem, validationErr.Problems[i])
+				}
+			}
+		})
+	}
+}
@@ -9,6 +9,13 @@ import (
 	validate "github.com/asaskevich/govalidator"
 )
 
+const (
+	// ACLSourceChallenge marks an ACL created by a successful challenge
+	ACLSourceChallenge = "challenge"
+	// ACLSourceDdns marks an ACL created from a user's dynamic DNS name
+	ACLSourceDdns = "ddns"
+)
+
 // ACL represents what an IP address is able to access
 type ACL struct {
 	// when true, client is allowed to access everything
@@ -17,6 +24,25 @@ type ACL struct {
 	AllowedHosts []string `json:"allowed_hosts"`
 	// after this date, the ACL is no longer valid
 	TTL *time.Time `json:"ttl"`
+	// what created this ACL (challenge or ddns)
+	Source string `json:"source,omitempty"`
+	// the ID of the user this ACL was created for. When set, access is evaluated
+	// against the user's current permissions, AllowAll and AllowedHosts are only a snapshot
+	UserID string `json:"user_id,omitempty"`
+	// the IDs of every user this ACL was created for, when there is more than one (ddns only):
+	// the DNS names of these users resolve to the same IP. Access is then evaluated against
+	// the permissions of each of them
+	UserIDs []string `json:"user_ids,omitempty"`
+	// the DNS name which resolved to this IP (ddns only)
+	DNSName string `json:"dns_name,omitempty"`
+	// when the challenge which created this ACL occurred (challenge only)
+	ChallengedAt *time.Time `json:"challenged_at,omitempty"`
+	// when set, the TTL is extended to this many minutes after each authorized request
+	SlidingMinutes int `json:"sliding_minutes,omitempty"`
+	// a sliding TTL is never extended past this date
+	MaxTTL *time.Time `json:"max_ttl,omitempty"`
+	// true when the challenge which created this ACL included a valid TOTP code
+	TOTPVerified bool `json:"totp_verified,omitempty"`
 }
 
 // encodes this struct for storage to db
@@ -70,4 +96,27 @@ func (a *ACL) IsExpired() (expired bool) {
 		}
 	}
 	return
-}
\ No newline at end of file
+}
+
+// isChallengeOf returns true if this ACL was created by a challenge of the user and has not expired
+func (a *ACL) isChallengeOf(userID string) bool {
+	return a.UserID == userID && a.Source == ACLSourceChallenge && !a.IsExpired()
+}
+
+// Renew extends a sliding TTL to SlidingMinutes after now, but never past MaxTTL.
+// the ACL is left unchanged (and false is returned) when the TTL would be extended
+// by less than minExtension, so that callers can coalesce writes
+func (a *ACL) Renew(now time.Time, minExtension time.Duration) bool {
+	if a.SlidingMinutes <= 0 || a.TTL == nil {
+		return false
+	}
+	ttl := now.Add(time.Duration(a.SlidingMinutes) * time.Minute)
+	if a.MaxTTL != nil && ttl.After(*a.MaxTTL) {
+		ttl = *a.MaxTTL
+	}
+	if ttl.Sub(*a.TTL) < minExtension {
+		return false
+	}
+	a.TTL = &ttl
+	return true
+}
@@ -0,0 +1,47 @@
+package dataprovider
+
+import (
+	"testing"
+	"time"
+)
+
+func TestACLRenew(t *testing.T) {
+	now := time.Date(2024, 3, 4, 12, 0, 0, 0, time.UTC)
+	at := func(d time.Duration) *time.Time {
+		t := now.Add(d)
+		return &t
+	}
+	tests := []struct {
+		name         string
+		acl          ACL
+		minExtension time.Duration
+		renewed      bool
+		// the TTL after renewal, relative to now
+		ttl time.Duration
+	}{
+		{"not sliding", ACL{TTL: at(time.Minute)}, 0, false, time.Minute},
+		{"without TTL", ACL{SlidingMinutes: 60}, 0, false, 0},
+		{"extended", ACL{SlidingMinutes: 60, TTL: at(time.Minute)}, 0, true, time.Hour},
+		// MaxTTL is also the valid_until of the user, when it comes first
+		{"capped at MaxTTL", ACL{SlidingMinutes: 60, TTL: at(time.Minute), MaxTTL: at(30 * time.Minute)}, 0, true, 30 * time.Minute},
+		{"MaxTTL already reached", ACL{SlidingMinutes: 60, TTL: at(30 * time.Minute), MaxTTL: at(30 * time.Minute)}, time.Second, false, 30 * time.Minute},
+		// renewals are throttled to one every minExtension
+		{"extension below minExtension", ACL{SlidingMinutes: 60, TTL: at(55*time.Minute + time.Second)}, 5 * time.Minute, false, 55*time.Minute + time.Second},
+		{"extension of minExtension", ACL{SlidingMinutes: 60, TTL: at(55 * time.Minute)}, 5 * time.Minute, true, time.Hour},
+		{"extension capped below minExtension", ACL{SlidingMinutes: 60, TTL: at(time.Minute), MaxTTL: at(3 * time.Minute)}, 5 * time.Minute, false, time.Minute},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			acl := test.acl
+			if renewed := acl.Renew(now, test.minExtension); renewed != test.renewed {
+				t.Fatalf("expected renewed to be %v", test.renewed)
+			}
+			switch {
+			case acl.TTL == nil && test.ttl != 0:
+				t.Fatalf("expected a TTL of %v", test.ttl)
+			case acl.TTL != nil && !acl.TTL.Equal(now.Add(test.ttl)):
+				t.Fatalf("expected a TTL of %v, got %v", test.ttl, acl.TTL.Sub(now))
+			}
+		})
+	}
+}
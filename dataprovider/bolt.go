@@ -1,23 +1,44 @@
 package dataprovider
 
 import (
+	"bytes"
+	"encoding/binary"
 	"encoding/json"
 	"fmt"
+	"net/netip"
+	"strings"
+	"sync"
 	"time"
 
-	validate "github.com/asaskevich/govalidator"
 	"github.com/boltdb/bolt"
-	"github.com/spf13/viper"
+	"github.com/gbolo/protego/audit"
+	"github.com/gbolo/protego/config"
 )
 
 var (
-	userBucket = []byte("user")
-	aclBucket  = []byte("acl")
+	userBucket    = []byte("user")
+	aclBucket     = []byte("acl")
+	auditBucket   = []byte("audit")
+	tokenBucket   = []byte("token")
+	groupBucket   = []byte("group")
+	hostSetBucket = []byte("hostset")
+	policyBucket  = []byte("hostpolicy")
+	denyBucket    = []byte("deny")
+	// names of the tokens, by token hash
+	tokenHashBucket = []byte("tokenhash")
 )
 
 // BoltProvider implements Provider for bolt key/value store
 type BoltProvider struct {
 	dbHandle *bolt.DB
+	// read cache of the acl bucket, updated once a change is committed
+	acls *IPIndex[ACL]
+	// serializes changes to the acl bucket and its read cache
+	aclLock *sync.Mutex
+	// read cache of the deny bucket, updated once a change is committed
+	denied *IPIndex[DenyEntry]
+	// serializes changes to the deny bucket and its read cache
+	denyLock *sync.Mutex
 }
 
 func NewBoltProvider() (p BoltProvider, err error) {
@@ -26,7 +47,7 @@ func NewBoltProvider() (p BoltProvider, err error) {
 }
 
 func (p *BoltProvider) InitializeDatabase() (err error) {
-	boltDbFile := viper.GetString("db.bolt.file")
+	boltDbFile := config.GetString("db.bolt.file")
 	p.dbHandle, err = bolt.Open(boltDbFile, 0600, &bolt.Options{
 		NoGrowSync: false,
 		//FreelistType: bolt.FreelistArrayType,
@@ -49,6 +70,46 @@ func (p *BoltProvider) InitializeDatabase() (err error) {
 			log.Errorf("error creating acl bucket: %v", err)
 			return err
 		}
+		err = p.dbHandle.Update(func(tx *bolt.Tx) error {
+			_, e := tx.CreateBucketIfNotExists(auditBucket)
+			return e
+		})
+		if err != nil {
+			log.Errorf("error creating audit bucket: %v", err)
+			return err
+		}
+		err = p.dbHandle.Update(func(tx *bolt.Tx) error {
+			for _, bucket := range [][]byte{tokenBucket, tokenHashBucket} {
+				if _, e := tx.CreateBucketIfNotExists(bucket); e != nil {
+					return e
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			log.Errorf("error creating token bucket: %v", err)
+			return err
+		}
+		err = p.dbHandle.Update(func(tx *bolt.Tx) error {
+			for _, bucket := range [][]byte{groupBucket, hostSetBucket, policyBucket, denyBucket} {
+				if _, e := tx.CreateBucketIfNotExists(bucket); e != nil {
+					return e
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			log.Errorf("error creating group buckets: %v", err)
+			return err
+		}
+		if err = p.loadACLs(); err != nil {
+			log.Errorf("error loading acl bucket: %v", err)
+			return err
+		}
+		if err = p.loadDenyEntries(); err != nil {
+			log.Errorf("error loading deny bucket: %v", err)
+			return err
+		}
 	} else {
 		log.Errorf("error creating bolt key/value store handle: %v", err)
 	}
@@ -59,41 +120,183 @@ func (p *BoltProvider) CheckAvailability() error {
 	return nil
 }
 
+func (p *BoltProvider) Close() error {
+	log.Infof("closing bolt key/value store handle")
+	return p.dbHandle.Close()
+}
+
+// loadACLs loads the acl bucket into the read cache. IPs which were stored
+// before they were normalized are stored again under their normalized form
+func (p *BoltProvider) loadACLs() error {
+	p.acls = NewIPIndex[ACL]()
+	p.aclLock = new(sync.Mutex)
+	return p.dbHandle.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(aclBucket)
+		// ACLs stored under an IP which is not normalized, keyed by that IP
+		stale := make(map[string][]byte)
+		c := b.Cursor()
+		for ip, aclBytes := c.First(); ip != nil; ip, aclBytes = c.Next() {
+			if NormalizeIP(string(ip)) != string(ip) {
+				stale[string(ip)] = append([]byte(nil), aclBytes...)
+				continue
+			}
+			var acl ACL
+			if json.Unmarshal(aclBytes, &acl) == nil {
+				p.acls.Set(string(ip), acl)
+			}
+		}
+		for ip, aclBytes := range stale {
+			// an ACL already stored under the normalized IP is kept
+			if normalized := NormalizeIP(ip); b.Get([]byte(normalized)) == nil {
+				var acl ACL
+				if json.Unmarshal(aclBytes, &acl) == nil {
+					log.Infof("normalizing stored IP %s to %s", ip, normalized)
+					if e := b.Put([]byte(normalized), aclBytes); e != nil {
+						return e
+					}
+					p.acls.Set(normalized, acl)
+				}
+			}
+			if e := b.Delete([]byte(ip)); e != nil {
+				return e
+			}
+		}
+		return nil
+	})
+}
+
 func (p *BoltProvider) AddIp(ip string, acl *ACL) error {
-	if !validate.IsIP(ip) {
-		return fmt.Errorf("validation error for IP: %s", ip)
+	addr, err := ParseIP(ip)
+	if err != nil {
+		return err
 	}
-	return p.dbHandle.Update(func(tx *bolt.Tx) error {
+	ip = addr.String()
+	p.aclLock.Lock()
+	defer p.aclLock.Unlock()
+	err = p.dbHandle.Update(func(tx *bolt.Tx) error {
 		e := tx.Bucket(aclBucket).Put([]byte(ip), acl.Encode())
 		return e
 	})
+	if err == nil {
+		p.acls.Set(ip, *acl)
+	}
+	return err
 }
 
 func (p *BoltProvider) RemoveIp(ip string) error {
-	if !validate.IsIP(ip) {
-		return fmt.Errorf("validation error for IP: %s", ip)
+	addr, err := ParseIP(ip)
+	if err != nil {
+		return err
 	}
+	ip = addr.String()
+	p.aclLock.Lock()
+	defer p.aclLock.Unlock()
 	// remove the acl
-	return p.dbHandle.Update(func(tx *bolt.Tx) error {
+	err = p.dbHandle.Update(func(tx *bolt.Tx) error {
+		if e := untrackIp(tx, ip); e != nil {
+			return e
+		}
 		e := tx.Bucket(aclBucket).Delete([]byte(ip))
 		return e
 	})
+	if err == nil {
+		p.acls.Delete(ip)
+	}
+	return err
 }
 
-func (p *BoltProvider) GetACL(ip string) (acl *ACL, err error) {
-	if !validate.IsIP(ip) {
-		return nil, fmt.Errorf("validation error for IP: %s", ip)
+func (p *BoltProvider) AddUserIp(ip string, acl *ACL) (newIP bool, evicted []string, err error) {
+	addr, err := ParseIP(ip)
+	if err != nil {
+		return false, nil, err
 	}
-	// retrieve the acl
-	err = p.dbHandle.View(func(tx *bolt.Tx) error {
-		var aclBytes []byte
-		aclBytes = tx.Bucket(aclBucket).Get([]byte(ip))
-		if len(aclBytes) > 1 {
-			// serialize aclBytes into acl
-			return json.Unmarshal(aclBytes, &acl)
+	ip = addr.String()
+	p.aclLock.Lock()
+	defer p.aclLock.Unlock()
+	err = p.dbHandle.Update(func(tx *bolt.Tx) error {
+		users, acls := tx.Bucket(userBucket), tx.Bucket(aclBucket)
+		u, e := getBoltUser(tx, acl.UserID)
+		if e != nil {
+			return e
 		}
-		return nil
+		if u == nil {
+			return ErrUserNotFound
+		}
+		previous := getBoltACL(tx, ip)
+		newIP = previous == nil || previous.UserID != u.ID || previous.IsExpired()
+		// forget IPs which are no longer whitelisted for this user
+		u.pruneIps(func(ip string) *ACL {
+			return getBoltACL(tx, ip)
+		})
+		if evicted, e = u.admitIp(ip); e != nil {
+			return e
+		}
+		for _, old := range evicted {
+			if e = acls.Delete([]byte(old)); e != nil {
+				return e
+			}
+		}
+		// this IP may have been whitelisted for another user
+		if previous != nil && previous.UserID != u.ID {
+			if e = untrackIp(tx, ip); e != nil {
+				return e
+			}
+		}
+		if e = acls.Put([]byte(ip), acl.Encode()); e != nil {
+			return e
+		}
+		return users.Put([]byte(u.ID), u.Encode())
 	})
+	if err != nil {
+		return false, nil, err
+	}
+	for _, old := range evicted {
+		p.acls.Delete(old)
+	}
+	p.acls.Set(ip, *acl)
+	return
+}
+
+// untrackIp removes ip from the IPs of the user its ACL was whitelisted for
+func untrackIp(tx *bolt.Tx, ip string) error {
+	acl := getBoltACL(tx, ip)
+	if acl == nil || acl.UserID == "" {
+		return nil
+	}
+	u, err := getBoltUser(tx, acl.UserID)
+	if err != nil || u == nil || !u.CheckIp(ip) {
+		return err
+	}
+	u.RemoveIp(ip)
+	return tx.Bucket(userBucket).Put([]byte(u.ID), u.Encode())
+}
+
+// getBoltACL returns the ACL of ip, even when it has expired, or nil
+func getBoltACL(tx *bolt.Tx, ip string) (acl *ACL) {
+	if aclBytes := tx.Bucket(aclBucket).Get([]byte(ip)); len(aclBytes) > 1 {
+		if json.Unmarshal(aclBytes, &acl) != nil {
+			return nil
+		}
+	}
+	return
+}
+
+// getBoltUser returns the user identified by id, or nil
+func getBoltUser(tx *bolt.Tx, id string) (u *User, err error) {
+	if userBytes := tx.Bucket(userBucket).Get([]byte(id)); len(userBytes) > 1 {
+		err = json.Unmarshal(userBytes, &u)
+	}
+	return
+}
+
+func (p *BoltProvider) GetACL(ip string) (acl *ACL, err error) {
+	if _, err = ParseIP(ip); err != nil {
+		return nil, err
+	}
+	// retrieve the acl from the read cache
+	if aclFound, ok := p.acls.Get(ip); ok {
+		acl = &aclFound
+	}
 	// check expiration
 	if acl != nil && acl.IsExpired() {
 		log.Infof("user IP (%s) TTL has expired. Removing from database", ip)
@@ -104,8 +307,8 @@ func (p *BoltProvider) GetACL(ip string) (acl *ACL, err error) {
 }
 
 func (p *BoltProvider) UpdateACL(ip string, acl *ACL) error {
-	if !validate.IsIP(ip) {
-		return fmt.Errorf("validation error for IP: %s", ip)
+	if _, err := ParseIP(ip); err != nil {
+		return err
 	}
 	// get existing acl
 	ea, _ := p.GetACL(ip)
@@ -116,6 +319,17 @@ func (p *BoltProvider) UpdateACL(ip string, acl *ACL) error {
 	return p.AddIp(ip, acl)
 }
 
+func (p *BoltProvider) GetAllACLs() (acls map[string]ACL, err error) {
+	acls = make(map[string]ACL)
+	p.acls.Walk(func(prefix netip.Prefix, acl ACL) bool {
+		if !acl.IsExpired() {
+			acls[prefix.Addr().String()] = acl
+		}
+		return true
+	})
+	return
+}
+
 func (p *BoltProvider) AddUser(u *User) error {
 	// validate the user object
 	if u == nil || len(u.ID) < 6 {
@@ -168,19 +382,40 @@ func (p *BoltProvider) UpdateUser(u *User) error {
 	if u == nil || len(u.ID) < 6 {
 		return fmt.Errorf("validation error for User: %v", u)
 	}
-	// check if user already exists
-	eu, _ := p.GetUser(u.ID)
-	if eu == nil {
-		return ErrUserNotFound
-	}
-	// overwrite the existing user, but keep associated IPs
-	u.IPs = eu.IPs
+	// IPs are read within the same transaction, so that none whitelisted in the meantime are lost
 	return p.dbHandle.Update(func(tx *bolt.Tx) error {
-		e := tx.Bucket(userBucket).Put([]byte(u.ID), u.Encode())
+		// check if user already exists
+		eu, e := getBoltUser(tx, u.ID)
+		if e != nil {
+			return e
+		}
+		if eu == nil {
+			return ErrUserNotFound
+		}
+		// overwrite the existing user, but keep associated IPs
+		u.IPs = eu.IPs
+		u.keepTOTPCounter(eu)
+		e = tx.Bucket(userBucket).Put([]byte(u.ID), u.Encode())
 		return e
 	})
 }
 
+func (p *BoltProvider) UseTOTPCounter(id string, counter int64) error {
+	return p.dbHandle.Update(func(tx *bolt.Tx) error {
+		u, e := getBoltUser(tx, id)
+		if e != nil {
+			return e
+		}
+		if u == nil {
+			return ErrUserNotFound
+		}
+		if e = u.useTOTPCounter(counter); e != nil {
+			return e
+		}
+		return tx.Bucket(userBucket).Put([]byte(u.ID), u.Encode())
+	})
+}
+
 func (p *BoltProvider) GetAllUsers() (users []User, err error) {
 	err = p.dbHandle.View(func(tx *bolt.Tx) error {
 		b := tx.Bucket(userBucket)
@@ -195,4 +430,382 @@ func (p *BoltProvider) GetAllUsers() (users []User, err error) {
 		return nil
 	})
 	return
-}
\ No newline at end of file
+}
+
+// putNamed stores value under name. When exists is true, name must already be
+// in the bucket (errNotFound otherwise), else it must not be (errExists otherwise)
+func (p *BoltProvider) putNamed(bucket []byte, name string, value []byte, exists bool, errExists, errNotFound error) error {
+	return p.dbHandle.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(bucket)
+		found := b.Get([]byte(name)) != nil
+		switch {
+		case exists && !found:
+			return errNotFound
+		case !exists && found:
+			return errExists
+		}
+		return b.Put([]byte(name), value)
+	})
+}
+
+// removeNamed deletes name from the bucket, or returns errNotFound
+func (p *BoltProvider) removeNamed(bucket []byte, name string, errNotFound error) error {
+	return p.dbHandle.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(bucket)
+		if b.Get([]byte(name)) == nil {
+			return errNotFound
+		}
+		return b.Delete([]byte(name))
+	})
+}
+
+// getNamed unmarshals the value of name into v, found is false when name is not in the bucket
+func (p *BoltProvider) getNamed(bucket []byte, name string, v interface{}) (found bool, err error) {
+	err = p.dbHandle.View(func(tx *bolt.Tx) error {
+		if valueBytes := tx.Bucket(bucket).Get([]byte(name)); len(valueBytes) > 1 {
+			found = true
+			return json.Unmarshal(valueBytes, v)
+		}
+		return nil
+	})
+	return
+}
+
+// forEachNamed calls fn with every value of the bucket
+func (p *BoltProvider) forEachNamed(bucket []byte, fn func(valueBytes []byte)) error {
+	return p.dbHandle.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(bucket).ForEach(func(_, valueBytes []byte) error {
+			fn(valueBytes)
+			return nil
+		})
+	})
+}
+
+func (p *BoltProvider) AddGroup(g *Group) error {
+	if g == nil || g.Name == "" {
+		return fmt.Errorf("validation error for Group: %v", g)
+	}
+	return p.putNamed(groupBucket, g.Name, g.Encode(), false, ErrGroupExists, ErrGroupNotFound)
+}
+
+func (p *BoltProvider) UpdateGroup(g *Group) error {
+	if g == nil || g.Name == "" {
+		return fmt.Errorf("validation error for Group: %v", g)
+	}
+	return p.putNamed(groupBucket, g.Name, g.Encode(), true, ErrGroupExists, ErrGroupNotFound)
+}
+
+func (p *BoltProvider) RemoveGroup(name string) error {
+	return p.removeNamed(groupBucket, name, ErrGroupNotFound)
+}
+
+func (p *BoltProvider) GetGroup(name string) (*Group, error) {
+	var group Group
+	found, err := p.getNamed(groupBucket, name, &group)
+	if !found || err != nil {
+		return nil, err
+	}
+	return &group, nil
+}
+
+func (p *BoltProvider) GetAllGroups() (groups []Group, err error) {
+	err = p.forEachNamed(groupBucket, func(groupBytes []byte) {
+		var group Group
+		if json.Unmarshal(groupBytes, &group) == nil {
+			groups = append(groups, group)
+		}
+	})
+	return
+}
+
+func (p *BoltProvider) AddHostSet(h *HostSet) error {
+	if h == nil || h.Name == "" {
+		return fmt.Errorf("validation error for HostSet: %v", h)
+	}
+	return p.putNamed(hostSetBucket, h.Name, h.Encode(), false, ErrHostSetExists, ErrHostSetNotFound)
+}
+
+func (p *BoltProvider) UpdateHostSet(h *HostSet) error {
+	if h == nil || h.Name == "" {
+		return fmt.Errorf("validation error for HostSet: %v", h)
+	}
+	return p.putNamed(hostSetBucket, h.Name, h.Encode(), true, ErrHostSetExists, ErrHostSetNotFound)
+}
+
+func (p *BoltProvider) RemoveHostSet(name string) error {
+	return p.removeNamed(hostSetBucket, name, ErrHostSetNotFound)
+}
+
+func (p *BoltProvider) GetHostSet(name string) (*HostSet, error) {
+	var hostSet HostSet
+	found, err := p.getNamed(hostSetBucket, name, &hostSet)
+	if !found || err != nil {
+		return nil, err
+	}
+	return &hostSet, nil
+}
+
+func (p *BoltProvider) GetAllHostSets() (hostSets []HostSet, err error) {
+	err = p.forEachNamed(hostSetBucket, func(hostSetBytes []byte) {
+		var hostSet HostSet
+		if json.Unmarshal(hostSetBytes, &hostSet) == nil {
+			hostSets = append(hostSets, hostSet)
+		}
+	})
+	return
+}
+
+func (p *BoltProvider) AddHostPolicy(pol *HostPolicy) error {
+	if pol == nil || pol.Host == "" {
+		return fmt.Errorf("validation error for HostPolicy: %v", pol)
+	}
+	return p.putNamed(policyBucket, pol.Host, pol.Encode(), false, ErrHostPolicyExists, ErrHostPolicyNotFound)
+}
+
+func (p *BoltProvider) UpdateHostPolicy(pol *HostPolicy) error {
+	if pol == nil || pol.Host == "" {
+		return fmt.Errorf("validation error for HostPolicy: %v", pol)
+	}
+	return p.putNamed(policyBucket, pol.Host, pol.Encode(), true, ErrHostPolicyExists, ErrHostPolicyNotFound)
+}
+
+func (p *BoltProvider) RemoveHostPolicy(host string) error {
+	return p.removeNamed(policyBucket, strings.ToLower(host), ErrHostPolicyNotFound)
+}
+
+func (p *BoltProvider) GetHostPolicy(host string) (*HostPolicy, error) {
+	var pol HostPolicy
+	found, err := p.getNamed(policyBucket, strings.ToLower(host), &pol)
+	if !found || err != nil {
+		return nil, err
+	}
+	return &pol, nil
+}
+
+func (p *BoltProvider) GetAllHostPolicies() (policies []HostPolicy, err error) {
+	err = p.forEachNamed(policyBucket, func(policyBytes []byte) {
+		var pol HostPolicy
+		if json.Unmarshal(policyBytes, &pol) == nil {
+			policies = append(policies, pol)
+		}
+	})
+	return
+}
+
+func (p *BoltProvider) AddToken(t *Token) error {
+	if t == nil || t.Name == "" {
+		return fmt.Errorf("validation error for Token: %v", t)
+	}
+	return p.dbHandle.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(tokenBucket)
+		if b.Get([]byte(t.Name)) != nil {
+			return ErrTokenExists
+		}
+		if e := tx.Bucket(tokenHashBucket).Put([]byte(t.Hash), []byte(t.Name)); e != nil {
+			return e
+		}
+		return b.Put([]byte(t.Name), t.Encode())
+	})
+}
+
+func (p *BoltProvider) RemoveToken(name string) error {
+	return p.dbHandle.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(tokenBucket)
+		tokenBytes := b.Get([]byte(name))
+		if tokenBytes == nil {
+			return ErrTokenNotFound
+		}
+		var token Token
+		if json.Unmarshal(tokenBytes, &token) == nil {
+			if e := tx.Bucket(tokenHashBucket).Delete([]byte(token.Hash)); e != nil {
+				return e
+			}
+		}
+		return b.Delete([]byte(name))
+	})
+}
+
+func (p *BoltProvider) GetToken(name string) (token *Token, err error) {
+	err = p.dbHandle.View(func(tx *bolt.Tx) error {
+		if tokenBytes := tx.Bucket(tokenBucket).Get([]byte(name)); len(tokenBytes) > 1 {
+			return json.Unmarshal(tokenBytes, &token)
+		}
+		return nil
+	})
+	return
+}
+
+func (p *BoltProvider) GetAllTokens() (tokens []Token, err error) {
+	err = p.dbHandle.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket(tokenBucket).Cursor()
+		for name, tokenBytes := c.First(); name != nil; name, tokenBytes = c.Next() {
+			var token Token
+			if json.Unmarshal(tokenBytes, &token) == nil {
+				tokens = append(tokens, token)
+			}
+		}
+		return nil
+	})
+	return
+}
+
+func (p *BoltProvider) FindToken(secret string) (token *Token, err error) {
+	hash := hashToken(secret)
+	err = p.dbHandle.View(func(tx *bolt.Tx) error {
+		name := tx.Bucket(tokenHashBucket).Get([]byte(hash))
+		if name == nil {
+			return nil
+		}
+		if tokenBytes := tx.Bucket(tokenBucket).Get(name); len(tokenBytes) > 1 {
+			return json.Unmarshal(tokenBytes, &token)
+		}
+		return nil
+	})
+	if token != nil && !token.CheckSecret(secret) {
+		token = nil
+	}
+	return
+}
+
+// auditKey returns a key which sorts audit events by time.
+// the sequence guarantees uniqueness for events with the same timestamp.
+func auditKey(t time.Time, seq uint64) []byte {
+	key := make([]byte, 16)
+	binary.BigEndian.PutUint64(key[0:8], uint64(t.UnixNano()))
+	binary.BigEndian.PutUint64(key[8:16], seq)
+	return key
+}
+
+// loadDenyEntries loads the deny bucket into the read cache
+func (p *BoltProvider) loadDenyEntries() error {
+	p.denied = NewIPIndex[DenyEntry]()
+	p.denyLock = new(sync.Mutex)
+	return p.forEachNamed(denyBucket, func(entryBytes []byte) {
+		var entry DenyEntry
+		if json.Unmarshal(entryBytes, &entry) != nil {
+			return
+		}
+		if prefix, err := parseCIDR(entry.CIDR); err == nil {
+			p.denied.SetPrefix(prefix, entry)
+		}
+	})
+}
+
+func (p *BoltProvider) AddDenyEntry(d *DenyEntry) error {
+	if d == nil || d.CIDR == "" {
+		return fmt.Errorf("validation error for DenyEntry: %v", d)
+	}
+	prefix, err := parseCIDR(d.CIDR)
+	if err != nil {
+		return err
+	}
+	p.denyLock.Lock()
+	defer p.denyLock.Unlock()
+	err = p.dbHandle.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(denyBucket)
+		// an expired entry is replaced
+		if entryBytes := b.Get([]byte(d.CIDR)); entryBytes != nil {
+			var existing DenyEntry
+			if json.Unmarshal(entryBytes, &existing) == nil && !existing.IsExpired() {
+				return ErrDenyEntryExists
+			}
+		}
+		return b.Put([]byte(d.CIDR), d.Encode())
+	})
+	if err == nil {
+		p.denied.SetPrefix(prefix, *d)
+	}
+	return err
+}
+
+func (p *BoltProvider) RemoveDenyEntry(cidr string) (*DenyEntry, error) {
+	prefix, err := parseCIDR(cidr)
+	if err != nil {
+		return nil, ErrDenyEntryNotFound
+	}
+	p.denyLock.Lock()
+	defer p.denyLock.Unlock()
+	// the read cache holds every entry of the deny bucket, since changes are serialized by denyLock
+	entry, ok := p.denied.GetPrefix(prefix)
+	if !ok {
+		return nil, ErrDenyEntryNotFound
+	}
+	if err = p.removeNamed(denyBucket, cidr, ErrDenyEntryNotFound); err != nil {
+		return nil, err
+	}
+	p.denied.DeletePrefix(prefix)
+	return &entry, nil
+}
+
+func (p *BoltProvider) GetAllDenyEntries() (entries []DenyEntry, err error) {
+	err = p.forEachNamed(denyBucket, func(entryBytes []byte) {
+		var entry DenyEntry
+		if json.Unmarshal(entryBytes, &entry) == nil && !entry.IsExpired() {
+			entries = append(entries, entry)
+		}
+	})
+	return
+}
+
+func (p *BoltProvider) FindDenyEntry(ip string) (*DenyEntry, error) {
+	return findDenyEntry(p.denied, ip), nil
+}
+
+func (p *BoltProvider) AddAuditEvent(e *audit.Event) error {
+	if e == nil {
+		return fmt.Errorf("audit event is nil")
+	}
+	encoded, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	return p.dbHandle.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(auditBucket)
+		seq, e2 := b.NextSequence()
+		if e2 != nil {
+			return e2
+		}
+		return b.Put(auditKey(e.Timestamp, seq), encoded)
+	})
+}
+
+func (p *BoltProvider) GetAuditEvents(f *audit.Filter) (events []audit.Event, err error) {
+	err = p.dbHandle.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket(auditBucket).Cursor()
+		// iterate from newest to oldest
+		for k, v := c.Last(); k != nil; k, v = c.Prev() {
+			if f.Limit > 0 && len(events) >= f.Limit {
+				break
+			}
+			var event audit.Event
+			if json.Unmarshal(v, &event) == nil && f.Match(&event) {
+				events = append(events, event)
+			}
+		}
+		return nil
+	})
+	return
+}
+
+func (p *BoltProvider) PruneAuditEvents(before time.Time, maxEvents int) error {
+	return p.dbHandle.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(auditBucket)
+		remaining := b.Stats().KeyN
+		// a zero before means no age limit. its UnixNano would overflow, and be after every event
+		var cutoff []byte
+		if !before.IsZero() {
+			cutoff = auditKey(before, 0)
+		}
+		c := b.Cursor()
+		for k, _ := c.First(); k != nil; k, _ = c.First() {
+			if (cutoff == nil || bytes.Compare(k, cutoff) >= 0) && (maxEvents <= 0 || remaining <= maxEvents) {
+				break
+			}
+			if e := c.Delete(); e != nil {
+				return e
+			}
+			remaining--
+		}
+		return nil
+	})
+}
@@ -0,0 +1,45 @@
+package dataprovider
+
+import (
+	"fmt"
+	"strings"
+)
+
+// error generated when a client is located in a country which is not allowed
+var ErrCountryNotAllowed = fmt.Errorf("country is not allowed")
+
+// normalizeCountries uppercases ISO 3166-1 alpha-2 country codes, and returns an error when one is invalid
+func normalizeCountries(countries []string) error {
+	for i, country := range countries {
+		country = strings.ToUpper(country)
+		if len(country) != 2 || country[0] < 'A' || country[0] > 'Z' || country[1] < 'A' || country[1] > 'Z' {
+			return fmt.Errorf("country must be an ISO 3166-1 alpha-2 code (like CA or FR): %q", countries[i])
+		}
+		countries[i] = country
+	}
+	return nil
+}
+
+// checkCountry returns ErrCountryNotAllowed when country is not in allowed.
+// every country is allowed when allowed is empty, and an unknown country ("") is never in it
+func checkCountry(allowed []string, country string) error {
+	if len(allowed) == 0 {
+		return nil
+	}
+	for _, c := range allowed {
+		if country != "" && strings.EqualFold(c, country) {
+			return nil
+		}
+	}
+	return ErrCountryNotAllowed
+}
+
+// CheckCountry returns ErrCountryNotAllowed when this User is restricted to other countries
+func (u *User) CheckCountry(country string) error {
+	return checkCountry(u.AllowedCountries, country)
+}
+
+// CheckCountry returns ErrCountryNotAllowed when this HostPolicy restricts its host to other countries
+func (pol *HostPolicy) CheckCountry(country string) error {
+	return checkCountry(pol.AllowedCountries, country)
+}
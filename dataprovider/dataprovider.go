@@ -1,6 +1,13 @@
 package dataprovider
 
-import "github.com/gbolo/protego/config"
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gbolo/protego/audit"
+	"github.com/gbolo/protego/config"
+)
 
 var log = config.GetLogger()
 
@@ -8,21 +15,97 @@ var log = config.GetLogger()
 type Provider interface {
 	InitializeDatabase() error
 	CheckAvailability() error
+	// releases all resources, the provider cannot be used afterwards
+	Close() error
 
 	// used for IP authorization
 	AddIp(ip string, acl *ACL) error
+	// also removes ip from the IPs of the user it was whitelisted for
 	RemoveIp(ip string) error
 	GetACL(ip string) (*ACL, error)
 	UpdateACL(ip string, acl *ACL) error
+	GetAllACLs() (map[string]ACL, error)
+	// adds the ACL of a challenge passed by the user acl.UserID, and records ip in the user's IPs.
+	// newIP is true when ip was not whitelisted for this user (or its ACL had expired).
+	// when the user has reached its MaxIPs, the ACLs of its oldest IPs are removed and returned,
+	// or ErrMaxIPsReached is returned (depending on its MaxIPsPolicy)
+	AddUserIp(ip string, acl *ACL) (newIP bool, evicted []string, err error)
 
 	// user management
 	AddUser(u *User) error
 	RemoveUser(u *User) error
 	GetUser(id string) (*User, error)
+	// the TOTP counter of a user is kept, unless its TOTP secret changes
 	UpdateUser(u *User) error
 	GetAllUsers() ([]User, error)
+	// records that the TOTP code of period counter was accepted from the user id,
+	// or returns ErrTOTPReplayed when a code of this period or a later one already was
+	UseTOTPCounter(id string, counter int64) error
+
+	// groups of users and the host sets granted to them, identified by name
+	AddGroup(g *Group) error
+	UpdateGroup(g *Group) error
+	RemoveGroup(name string) error
+	GetGroup(name string) (*Group, error)
+	GetAllGroups() ([]Group, error)
+	AddHostSet(h *HostSet) error
+	UpdateHostSet(h *HostSet) error
+	RemoveHostSet(name string) error
+	GetHostSet(name string) (*HostSet, error)
+	GetAllHostSets() ([]HostSet, error)
+
+	// policies which restrict access to hosts, identified by host
+	AddHostPolicy(pol *HostPolicy) error
+	UpdateHostPolicy(pol *HostPolicy) error
+	RemoveHostPolicy(host string) error
+	GetHostPolicy(host string) (*HostPolicy, error)
+	GetAllHostPolicies() ([]HostPolicy, error)
+
+	// admin API tokens, identified by name
+	AddToken(t *Token) error
+	RemoveToken(name string) error
+	GetToken(name string) (*Token, error)
+	GetAllTokens() ([]Token, error)
+	// returns the token whose secret is secret, or nil
+	FindToken(secret string) (*Token, error)
+
+	// blocked IP addresses and ranges, identified by CIDR. expired entries are not returned
+	AddDenyEntry(d *DenyEntry) error
+	// returns the removed entry, or ErrDenyEntryNotFound
+	RemoveDenyEntry(cidr string) (*DenyEntry, error)
+	GetAllDenyEntries() ([]DenyEntry, error)
+	// returns the most specific entry which blocks ip, or nil
+	FindDenyEntry(ip string) (*DenyEntry, error)
+
+	// audit history
+	AddAuditEvent(e *audit.Event) error
+	// returns matching events, newest first
+	GetAuditEvents(f *audit.Filter) ([]audit.Event, error)
+	// removes events older than before, then the oldest events until at most maxEvents remain
+	PruneAuditEvents(before time.Time, maxEvents int) error
 
 	// only needs a real implementation if provider does not
 	// natively support TTL
 	//MaintananceTTL() error
 }
+
+// NewProviderFromConfig returns the data provider defined by db.provider.
+// the returned provider records metrics for every operation.
+func NewProviderFromConfig() (p Provider, err error) {
+	switch provider := strings.ToLower(config.GetString("db.provider")); provider {
+	case "bolt":
+		bolt, e := NewBoltProvider()
+		p = &bolt
+		err = e
+	case "memory":
+		memory, e := NewMemoryProvider()
+		p = &memory
+		err = e
+	default:
+		return nil, fmt.Errorf("the value set for db.provider is unrecognized: %s", provider)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to init data provider: %v", err)
+	}
+	return NewInstrumentedProvider(strings.ToLower(config.GetString("db.provider")), p), nil
+}
@@ -1,119 +1,411 @@
 package dataprovider
 
 import (
+	"context"
+	"errors"
 	"net"
+	"reflect"
+	"sort"
 	"sync"
 	"time"
 
 	validate "github.com/asaskevich/govalidator"
+	"github.com/gbolo/protego/audit"
+	"github.com/gbolo/protego/metrics"
 )
 
+// the interval used when an invalid one is provided
+const defaultDdnsInterval = 120 * time.Minute
+
+// Resolver performs DNS lookups for the DdnsProvider.
+// net.DefaultResolver satisfies this interface.
+type Resolver interface {
+	LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error)
+}
+
+// ddnsUser is the state the DdnsProvider keeps for a single user
+type ddnsUser struct {
+	// the ACL granted to every IP this user's DNS names resolve to
+	acl ACL
+	// the DNS names of this user
+	dnsNames []string
+	// the last resolved IP for each DNS name
+	resolved map[string]string
+}
+
+// DdnsProvider whitelists the IPs that users' DNS names resolve to.
+// Its state is derived entirely from users: ProcessUser and DeleteUser
+// change the desired state, and a reconcile loop (see Run) resolves the
+// DNS names and rebuilds the ACLs.
+// The resulting ACLs are persisted in the data provider (with source ddns),
+// and the last known IP of a DNS name is kept when its lookup fails for any
+// reason other than the name not existing, so that an outage of the DNS
+// resolver (or a restart) does not lock out users.
 type DdnsProvider struct {
-	fqdns      map[string]ACL
-	acls       map[string]ACL
-	lock       *sync.Mutex // TODO: use RWMutex
-	stopSignal chan bool
+	store    Provider
+	resolver Resolver
+	interval time.Duration
+	// keyed by user ID
+	users map[string]*ddnsUser
+	// indexed by IP, always derived from users
+	acls *IPIndex[ACL]
+	// last known IPs restored from the store, keyed by user ID then DNS name
+	restored map[string]map[string]string
+	lock     *sync.RWMutex
+	// serializes writes to the store
+	persistLock *sync.Mutex
+	trigger     chan struct{}
 }
 
-func NewDdnsProvider() (p DdnsProvider) {
-	p = DdnsProvider{
-		fqdns:      make(map[string]ACL),
-		acls:       make(map[string]ACL),
-		lock:       new(sync.Mutex),
-		stopSignal: make(chan bool),
+// NewDdnsProvider returns a DdnsProvider which uses the specified resolver.
+// ACLs are persisted to store, unless it is nil.
+// The reconcile loop is not started until Run is called.
+func NewDdnsProvider(store Provider, resolver Resolver, interval time.Duration) (p *DdnsProvider) {
+	if resolver == nil {
+		resolver = net.DefaultResolver
+	}
+	if interval <= 0 {
+		interval = defaultDdnsInterval
+	}
+	p = &DdnsProvider{
+		store:       store,
+		resolver:    resolver,
+		interval:    interval,
+		users:       make(map[string]*ddnsUser),
+		acls:        NewIPIndex[ACL](),
+		restored:    make(map[string]map[string]string),
+		lock:        new(sync.RWMutex),
+		persistLock: new(sync.Mutex),
+		trigger:     make(chan struct{}, 1),
 	}
-	go p.daemonize()
+	p.restore()
 	log.Debug("ddns provider has been initialized")
 	return
 }
 
+// restore loads the last known IPs of DNS names from the store
+func (p *DdnsProvider) restore() {
+	if p.store == nil {
+		return
+	}
+	acls, err := p.store.GetAllACLs()
+	if err != nil {
+		log.Errorf("unable to restore DNS based ACLs: %v", err)
+		return
+	}
+	count := 0
+	for ip, acl := range acls {
+		if acl.Source != ACLSourceDdns || acl.UserID == "" || acl.DNSName == "" {
+			continue
+		}
+		if _, ok := p.restored[acl.UserID]; !ok {
+			p.restored[acl.UserID] = make(map[string]string)
+		}
+		p.restored[acl.UserID][acl.DNSName] = ip
+		count++
+	}
+	log.Debugf("restored %d DNS based ACLs", count)
+}
+
+// ProcessUsers replaces the state of the DdnsProvider with the provided users
 func (p *DdnsProvider) ProcessUsers(users []User) {
 	log.Debugf("processing %d user(s)", len(users))
-	for _, user := range users {
-		p.ProcessUser(&user)
+	p.lock.Lock()
+	for id := range p.users {
+		delete(p.users, id)
 	}
+	for i := range users {
+		p.setUser(&users[i])
+	}
+	acls := p.rebuildACLs()
+	p.lock.Unlock()
+	p.persist(acls)
+	p.Trigger()
 }
 
+// ProcessUser adds or updates a single user. DNS names which the user no
+// longer has are removed immediately, new ones are resolved asynchronously.
 func (p *DdnsProvider) ProcessUser(user *User) {
-	if len(user.DNSNames) == 0 {
-		return
-	}
-	log.Debugf("user %s has %d DNS Names", user.ID, len(user.DNSNames))
 	p.lock.Lock()
-	for _, fqdn := range user.DNSNames {
-		if validate.IsDNSName(fqdn) {
-			p.fqdns[fqdn] = ACL{
-				AllowAll:     user.ACLAllowAll,
-				AllowedHosts: user.ACLAllowedHosts,
-			}
-		}
-	}
+	p.setUser(user)
+	acls := p.rebuildACLs()
 	p.lock.Unlock()
-	p.updateACLs()
+	p.persist(acls)
+	p.Trigger()
 }
 
+// DeleteUser removes a user and all the ACLs derived from its DNS names
 func (p *DdnsProvider) DeleteUser(user *User) {
-	if len(user.DNSNames) == 0 {
+	p.lock.Lock()
+	_, ok := p.users[user.ID]
+	delete(p.users, user.ID)
+	delete(p.restored, user.ID)
+	acls := p.rebuildACLs()
+	p.lock.Unlock()
+	if ok {
+		p.persist(acls)
+	}
+}
+
+// GetACL returns the ACL of an IP, or nil if no DNS name resolves to it
+func (p *DdnsProvider) GetACL(ip string) (acl *ACL) {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	if aclFound, ok := p.acls.Get(ip); ok {
+		acl = &aclFound
+	}
+	return
+}
+
+// Trigger requests a reconcile without waiting for the next interval.
+// It never blocks.
+func (p *DdnsProvider) Trigger() {
+	select {
+	case p.trigger <- struct{}{}:
+	default:
+	}
+}
+
+// Run is a blocking loop which reconciles the ACLs periodically
+// and whenever it is triggered. It returns when ctx is cancelled.
+func (p *DdnsProvider) Run(ctx context.Context) {
+	t := time.NewTicker(p.interval)
+	defer t.Stop()
+	log.Infof("interval of periodic updates for DNS based ACLs is set to %v", p.interval)
+
+	for {
+		select {
+		case <-t.C:
+			p.Reconcile(ctx)
+			log.Debugf("periodic update for DNS based ACLs completed")
+		case <-p.trigger:
+			p.Reconcile(ctx)
+		case <-ctx.Done():
+			log.Warning("stop signal received, DNS based ACLs will stop being updated.")
+			return
+		}
+	}
+}
+
+// Reconcile resolves the DNS names of every user and rebuilds the ACLs.
+// DNS lookups are done without holding the lock.
+func (p *DdnsProvider) Reconcile(ctx context.Context) {
+	// take a snapshot of what needs to be resolved
+	p.lock.RLock()
+	fqdns := make(map[string]bool)
+	for _, u := range p.users {
+		for _, fqdn := range u.dnsNames {
+			fqdns[fqdn] = true
+		}
+	}
+	p.lock.RUnlock()
+	if len(fqdns) == 0 {
 		return
 	}
+
+	// a DNS name missing from both maps is kept at its last known IP
+	resolved := make(map[string]string, len(fqdns))
+	gone := make(map[string]bool)
+	for fqdn := range fqdns {
+		ip, err := p.lookup(ctx, fqdn)
+		switch {
+		case err == nil && ip != "":
+			resolved[fqdn] = ip
+		case err == nil || isNotFound(err):
+			gone[fqdn] = true
+		default:
+			log.Warningf("keeping last known IP for %s until it can be resolved", fqdn)
+		}
+	}
+
+	// users may have changed during the lookups, only apply results
+	// for DNS names which are still wanted
+	var changes []audit.Event
 	p.lock.Lock()
-	for _, fqdn := range user.DNSNames {
-		if _, ok := p.fqdns[fqdn]; ok {
-			delete(p.fqdns, fqdn)
+	for id, u := range p.users {
+		for _, fqdn := range u.dnsNames {
+			if ip, ok := resolved[fqdn]; ok {
+				if NormalizeIP(u.resolved[fqdn]) != NormalizeIP(ip) {
+					changes = append(changes, audit.Event{Type: audit.EventDDNSUpdate, ClientIP: NormalizeIP(ip), UserID: id, Target: fqdn})
+				}
+				u.resolved[fqdn] = ip
+			} else if gone[fqdn] {
+				delete(u.resolved, fqdn)
+			}
 		}
 	}
+	acls := p.rebuildACLs()
 	p.lock.Unlock()
-	p.updateACLs()
+	p.persist(acls)
+	for _, e := range changes {
+		log.Infof("DNS name %s of user %s now resolves to %s", e.Target, e.UserID, e.ClientIP)
+		audit.Record(e)
+	}
 }
 
-func (p *DdnsProvider) GetACL(ip string) (acl *ACL) {
-	p.lock.Lock()
-	defer p.lock.Unlock()
-	if aclFound, ok := p.acls[ip]; ok {
-		acl = &aclFound
+// lookup returns the first IP a DNS name resolves to
+func (p *DdnsProvider) lookup(ctx context.Context, fqdn string) (string, error) {
+	start := time.Now()
+	addrs, err := p.resolver.LookupIPAddr(ctx, fqdn)
+	metrics.DdnsLookupDuration.Observe(time.Since(start).Seconds())
+	if err != nil {
+		log.Errorf("unable to perform a DNS lookup for %s: %v", fqdn, err)
+		if isNotFound(err) {
+			metrics.DdnsLookups.WithLabelValues("not_found").Inc()
+		} else {
+			metrics.DdnsLookups.WithLabelValues("error").Inc()
+		}
+		return "", err
 	}
-	return
+	if len(addrs) == 0 {
+		metrics.DdnsLookups.WithLabelValues("not_found").Inc()
+		return "", nil
+	}
+	metrics.DdnsLookups.WithLabelValues("success").Inc()
+	// we ONLY use the first IP address, and ignore everything else
+	if len(addrs) > 1 {
+		log.Warningf("the following dns lookup (%s) resulted in more than one (%d) IPs. Only using the first one %s", fqdn, len(addrs), addrs[0].IP)
+	}
+	return addrs[0].IP.String(), nil
+}
+
+// isNotFound returns true when the error means the DNS name does not exist,
+// as opposed to the resolver being unavailable
+func isNotFound(err error) bool {
+	var dnsErr *net.DNSError
+	return errors.As(err, &dnsErr) && dnsErr.IsNotFound
 }
 
-func (p *DdnsProvider) updateACLs() {
-	if len(p.fqdns) == 0 {
+// setUser updates the desired state of a user. Disabled users and users
+// without valid DNS names are removed. Must be called with the lock held.
+func (p *DdnsProvider) setUser(user *User) {
+	var dnsNames []string
+	for _, fqdn := range user.DNSNames {
+		if validate.IsDNSName(fqdn) {
+			dnsNames = append(dnsNames, fqdn)
+		}
+	}
+	if !user.Enabled || len(dnsNames) == 0 {
+		delete(p.users, user.ID)
 		return
 	}
+	log.Debugf("user %s has %d DNS Names", user.ID, len(dnsNames))
+
+	// keep previous results for DNS names the user still has
+	previous := p.restored[user.ID]
+	delete(p.restored, user.ID)
+	if existing, ok := p.users[user.ID]; ok {
+		previous = existing.resolved
+	}
+	resolved := make(map[string]string)
+	for _, fqdn := range dnsNames {
+		if ip, ok := previous[fqdn]; ok {
+			resolved[fqdn] = ip
+		}
+	}
+	p.users[user.ID] = &ddnsUser{
+		acl: ACL{
+			AllowAll:     user.ACLAllowAll,
+			AllowedHosts: user.ACLAllowedHosts,
+			Source:       ACLSourceDdns,
+			UserID:       user.ID,
+		},
+		dnsNames: dnsNames,
+		resolved: resolved,
+	}
+}
+
+// rebuildACLs derives the ACLs from the resolved IPs of all users.
+// when more than one user resolves to the same (normalized) IP, their ACLs are merged.
+// Must be called with the lock held. The returned map must not be modified.
+func (p *DdnsProvider) rebuildACLs() map[string]ACL {
 	acls := make(map[string]ACL)
-	for fqdn, acl := range p.fqdns {
-		ips, err := net.LookupIP(fqdn)
-		if err != nil {
-			log.Errorf("unable to perform a DNS lookup for %s: %v", fqdn, err)
-		}
-		if len(ips) > 0 {
-			// we ONLY use the first IP address, and ignore everything else
-			if len(ips) > 1 {
-				log.Warningf("the following dns lookup (%s) resulted in more than one (%d) IPs. Only using the first one %s", fqdn, len(ips), ips[0])
+	index := NewIPIndex[ACL]()
+	// in a stable order, so that a merged ACL does not change from one rebuild to the next
+	userIDs := make([]string, 0, len(p.users))
+	for id := range p.users {
+		userIDs = append(userIDs, id)
+	}
+	sort.Strings(userIDs)
+	for _, id := range userIDs {
+		u := p.users[id]
+		fqdns := make([]string, 0, len(u.resolved))
+		for fqdn := range u.resolved {
+			fqdns = append(fqdns, fqdn)
+		}
+		sort.Strings(fqdns)
+		for _, fqdn := range fqdns {
+			ip := NormalizeIP(u.resolved[fqdn])
+			acl := u.acl
+			acl.DNSName = fqdn
+			if existing, ok := acls[ip]; ok {
+				acl = mergeACL(existing, acl)
 			}
-			acls[ips[0].String()] = acl
+			acls[ip] = acl
+			index.Set(ip, acl)
 		}
 	}
-	log.Debugf("adding %d ACLs", len(acls))
-	p.lock.Lock()
-	p.acls = acls
-	p.lock.Unlock()
+	log.Debugf("ddns provider now has %d ACLs", len(acls))
+	p.acls = index
+	return acls
 }
 
-// daemonize is a blocking loop which periodically updates ACLs. Only exits if shutdown signal is received
-func (p *DdnsProvider) daemonize() {
-	// for now, hard code this interval
-	updateInterval := 120
-	t := time.Tick(time.Duration(updateInterval) * time.Minute)
-	log.Infof("interval of periodic updates for DNS based ACLs is set to %d minute(s)", updateInterval)
+// persist synchronizes the DNS based ACLs of the store with acls.
+// ACLs from other sources (like a challenge) are never overwritten.
+func (p *DdnsProvider) persist(acls map[string]ACL) {
+	if p.store == nil {
+		return
+	}
+	p.persistLock.Lock()
+	defer p.persistLock.Unlock()
 
-	for {
-		select {
-		case <-t:
-			p.updateACLs()
-			log.Debugf("periodic update for DNS based ACLs completed")
-		case <-p.stopSignal:
-			log.Warning("stop signal received, DNS based ACLs will stop being updated.")
-			return
+	stored, err := p.store.GetAllACLs()
+	if err != nil {
+		log.Errorf("unable to persist DNS based ACLs: %v", err)
+		return
+	}
+	// remove stale ACLs
+	for ip, acl := range stored {
+		if _, ok := acls[ip]; !ok && acl.Source == ACLSourceDdns {
+			if err = p.store.RemoveIp(ip); err != nil {
+				log.Errorf("unable to remove DNS based ACL for %s: %v", ip, err)
+			}
 		}
 	}
+	// add new and changed ACLs
+	for ip, acl := range acls {
+		existing, ok := stored[ip]
+		if ok && (existing.Source != ACLSourceDdns || reflect.DeepEqual(existing, acl)) {
+			continue
+		}
+		acl := acl
+		if err = p.store.AddIp(ip, &acl); err != nil {
+			log.Errorf("unable to persist DNS based ACL for %s: %v", ip, err)
+		}
+	}
+}
+
+// mergeACL returns an ACL which allows everything either a or b allows.
+// The origin (user and DNS name) of a is kept, and the users of both are listed in UserIDs.
+func mergeACL(a, b ACL) (merged ACL) {
+	merged = a
+	merged.AllowedHosts = nil
+	merged.AllowAll = a.AllowAll || b.AllowAll
+	merged.AllowedHosts = append(merged.AllowedHosts, a.AllowedHosts...)
+	for _, host := range b.AllowedHosts {
+		if !merged.CheckHost(host) {
+			merged.AllowedHosts = append(merged.AllowedHosts, host)
+		}
+	}
+	merged.UserIDs = nil
+	for _, acl := range []ACL{a, b} {
+		userIDs := acl.UserIDs
+		if len(userIDs) == 0 && acl.UserID != "" {
+			userIDs = []string{acl.UserID}
+		}
+		for _, id := range userIDs {
+			merged.UserIDs = appendUnique(merged.UserIDs, id)
+		}
+	}
+	return
 }
@@ -0,0 +1,211 @@
+package dataprovider
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeResolver resolves DNS names from a map. Names which are not in it do not exist
+type fakeResolver struct {
+	ips  map[string]string
+	errs map[string]error
+	lock sync.Mutex
+}
+
+func newFakeResolver() *fakeResolver {
+	return &fakeResolver{ips: make(map[string]string), errs: make(map[string]error)}
+}
+
+func (r *fakeResolver) set(fqdn, ip string, err error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.ips[fqdn], r.errs[fqdn] = ip, err
+}
+
+func (r *fakeResolver) LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if err := r.errs[host]; err != nil {
+		return nil, err
+	}
+	ip, ok := r.ips[host]
+	if !ok {
+		return nil, &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+	}
+	return []net.IPAddr{{IP: net.ParseIP(ip)}}, nil
+}
+
+func ddnsTestUser(id string, hosts []string, dnsNames ...string) *User {
+	return &User{ID: id, Enabled: true, ACLAllowedHosts: hosts, DNSNames: dnsNames}
+}
+
+// expectACL fails the test when ip does not have an ACL of userID, or has one when userID is empty
+func expectACL(t *testing.T, p *DdnsProvider, ip, userID string) {
+	t.Helper()
+	acl := p.GetACL(ip)
+	switch {
+	case userID == "" && acl != nil:
+		t.Fatalf("expected no ACL for %s, got one of user %s", ip, acl.UserID)
+	case userID != "" && acl == nil:
+		t.Fatalf("expected an ACL of user %s for %s, got none", userID, ip)
+	case userID != "" && acl.UserID != userID:
+		t.Fatalf("expected an ACL of user %s for %s, got one of user %s", userID, ip, acl.UserID)
+	}
+}
+
+func TestDdnsProcessUserDropsRemovedNames(t *testing.T) {
+	resolver := newFakeResolver()
+	resolver.set("home.example.com", "10.0.0.1", nil)
+	resolver.set("work.example.com", "10.0.0.2", nil)
+	p := NewDdnsProvider(nil, resolver, time.Hour)
+
+	p.ProcessUser(ddnsTestUser("u1", nil, "home.example.com", "work.example.com"))
+	p.Reconcile(context.Background())
+	expectACL(t, p, "10.0.0.1", "u1")
+	expectACL(t, p, "10.0.0.2", "u1")
+
+	// removed DNS names are dropped immediately, without a reconcile
+	p.ProcessUser(ddnsTestUser("u1", nil, "home.example.com"))
+	expectACL(t, p, "10.0.0.1", "u1")
+	expectACL(t, p, "10.0.0.2", "")
+
+	// disabling the user drops all of its DNS names
+	disabled := ddnsTestUser("u1", nil, "home.example.com")
+	disabled.Enabled = false
+	p.ProcessUser(disabled)
+	expectACL(t, p, "10.0.0.1", "")
+}
+
+func TestDdnsDeleteUserRemovesACLs(t *testing.T) {
+	store, err := NewMemoryProvider()
+	if err != nil {
+		t.Fatal(err)
+	}
+	resolver := newFakeResolver()
+	resolver.set("u1.example.com", "10.0.0.1", nil)
+	resolver.set("u2.example.com", "10.0.0.2", nil)
+	p := NewDdnsProvider(&store, resolver, time.Hour)
+
+	u1 := ddnsTestUser("u1", nil, "u1.example.com")
+	p.ProcessUsers([]User{*u1, *ddnsTestUser("u2", nil, "u2.example.com")})
+	p.Reconcile(context.Background())
+	if acl, _ := store.GetACL("10.0.0.1"); acl == nil || acl.Source != ACLSourceDdns {
+		t.Fatalf("expected a persisted ddns ACL for 10.0.0.1, got %v", acl)
+	}
+
+	p.DeleteUser(u1)
+	expectACL(t, p, "10.0.0.1", "")
+	expectACL(t, p, "10.0.0.2", "u2")
+	if acl, _ := store.GetACL("10.0.0.1"); acl != nil {
+		t.Fatalf("expected the persisted ACL of a deleted user to be removed, got %v", acl)
+	}
+	if acl, _ := store.GetACL("10.0.0.2"); acl == nil {
+		t.Fatal("expected the persisted ACL of another user to be kept")
+	}
+}
+
+func TestDdnsLastKnownGood(t *testing.T) {
+	resolver := newFakeResolver()
+	resolver.set("home.example.com", "10.0.0.1", nil)
+	p := NewDdnsProvider(nil, resolver, time.Hour)
+	p.ProcessUser(ddnsTestUser("u1", nil, "home.example.com"))
+	p.Reconcile(context.Background())
+	expectACL(t, p, "10.0.0.1", "u1")
+
+	// the resolver is unavailable: the last known IP is kept
+	resolver.set("home.example.com", "", &net.DNSError{Err: "i/o timeout", Name: "home.example.com", IsTimeout: true})
+	p.Reconcile(context.Background())
+	expectACL(t, p, "10.0.0.1", "u1")
+	resolver.set("home.example.com", "", errors.New("connection refused"))
+	p.Reconcile(context.Background())
+	expectACL(t, p, "10.0.0.1", "u1")
+
+	// the name resolves to a new IP: the old one is dropped
+	resolver.set("home.example.com", "10.0.0.9", nil)
+	p.Reconcile(context.Background())
+	expectACL(t, p, "10.0.0.1", "")
+	expectACL(t, p, "10.0.0.9", "u1")
+
+	// the name no longer exists (NXDOMAIN): its IP is dropped
+	resolver.lock.Lock()
+	delete(resolver.ips, "home.example.com")
+	delete(resolver.errs, "home.example.com")
+	resolver.lock.Unlock()
+	p.Reconcile(context.Background())
+	expectACL(t, p, "10.0.0.9", "")
+}
+
+// the ACLs persisted before a restart are used until the DNS names can be resolved again
+func TestDdnsRestoreAfterRestart(t *testing.T) {
+	store, err := NewMemoryProvider()
+	if err != nil {
+		t.Fatal(err)
+	}
+	resolver := newFakeResolver()
+	resolver.set("home.example.com", "10.0.0.1", nil)
+	users := []User{*ddnsTestUser("u1", []string{"a.example.com"}, "home.example.com")}
+	p := NewDdnsProvider(&store, resolver, time.Hour)
+	p.ProcessUsers(users)
+	p.Reconcile(context.Background())
+	expectACL(t, p, "10.0.0.1", "u1")
+
+	// restarted while the resolver is unavailable
+	resolver.set("home.example.com", "", errors.New("connection refused"))
+	p = NewDdnsProvider(&store, resolver, time.Hour)
+	p.ProcessUsers(users)
+	expectACL(t, p, "10.0.0.1", "u1")
+	p.Reconcile(context.Background())
+	expectACL(t, p, "10.0.0.1", "u1")
+	if acl := p.GetACL("10.0.0.1"); !acl.CheckHost("a.example.com") {
+		t.Fatalf("expected the restored ACL to allow the hosts of the user, got %v", acl.AllowedHosts)
+	}
+
+	// a restored IP is not kept for a DNS name the user no longer has
+	p = NewDdnsProvider(&store, resolver, time.Hour)
+	p.ProcessUsers([]User{*ddnsTestUser("u1", []string{"a.example.com"}, "other.example.com")})
+	expectACL(t, p, "10.0.0.1", "")
+}
+
+func TestDdnsMergedACLListsEveryUser(t *testing.T) {
+	resolver := newFakeResolver()
+	resolver.set("a.example.com", "10.0.0.1", nil)
+	resolver.set("b.example.com", "::ffff:10.0.0.1", nil)
+	p := NewDdnsProvider(nil, resolver, time.Hour)
+	p.ProcessUsers([]User{
+		*ddnsTestUser("u2", []string{"b.example.com"}, "b.example.com"),
+		*ddnsTestUser("u1", []string{"a.example.com"}, "a.example.com"),
+	})
+	p.Reconcile(context.Background())
+
+	acl := p.GetACL("10.0.0.1")
+	if acl == nil {
+		t.Fatal("expected a merged ACL for 10.0.0.1")
+	}
+	if acl.UserID != "u1" || len(acl.UserIDs) != 2 || acl.UserIDs[0] != "u1" || acl.UserIDs[1] != "u2" {
+		t.Fatalf("expected the ACL of u1 listing users u1 and u2, got user %s and users %v", acl.UserID, acl.UserIDs)
+	}
+	if !acl.CheckHost("a.example.com") || !acl.CheckHost("b.example.com") {
+		t.Fatalf("expected the hosts of both users, got %v", acl.AllowedHosts)
+	}
+}
+
+func TestDdnsRunStopsOnCancel(t *testing.T) {
+	p := NewDdnsProvider(nil, newFakeResolver(), time.Hour)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		p.Run(ctx)
+		close(done)
+	}()
+	p.Trigger()
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run did not return after its context was cancelled")
+	}
+}
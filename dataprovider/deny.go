@@ -0,0 +1,104 @@
+package dataprovider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/netip"
+	"strings"
+	"time"
+)
+
+var (
+	// error generated when attempting to add a deny entry that already exists
+	ErrDenyEntryExists = fmt.Errorf("deny entry already exists")
+	// error generated when attempting to remove a deny entry that does not exist
+	ErrDenyEntryNotFound = fmt.Errorf("deny entry was not found")
+)
+
+// DenyEntry blocks an IP address or range. It takes precedence over every ACL,
+// and clients within it can not pass a challenge.
+type DenyEntry struct {
+	// The blocked range in CIDR notation. A single IP address is stored as a /32 (or /128)
+	CIDR string `json:"cidr" example:"203.0.113.0/24"`
+	// Why this range is blocked
+	Reason string `json:"reason,omitempty" example:"credential stuffing"`
+	// When this DenyEntry was created
+	CreatedAt time.Time `json:"created_at" example:"2020-03-22T14:28:00Z"`
+	// After this date, the range is no longer blocked. Never expires when not set
+	ExpiresAt *time.Time `json:"expires_at,omitempty" example:"2020-03-23T14:28:00Z"`
+}
+
+// NewDenyEntry returns a DenyEntry for an IP address or a CIDR
+func NewDenyEntry(ipOrCIDR, reason string, expiresAt *time.Time) (d *DenyEntry, err error) {
+	cidr, err := NormalizeCIDR(ipOrCIDR)
+	if err != nil {
+		return nil, err
+	}
+	if expiresAt != nil && expiresAt.Before(time.Now()) {
+		return nil, fmt.Errorf("deny entry expiry must be in the future")
+	}
+	return &DenyEntry{
+		CIDR:      cidr,
+		Reason:    reason,
+		CreatedAt: time.Now().UTC(),
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+// NormalizeCIDR returns the CIDR notation of an IP address (as a single address range) or of a CIDR,
+// so that every representation of a range is identical
+func NormalizeCIDR(ipOrCIDR string) (string, error) {
+	p, err := parseCIDR(ipOrCIDR)
+	if err != nil {
+		return "", err
+	}
+	return p.String(), nil
+}
+
+// parseCIDR parses an IP address (as a single address range) or a CIDR into its normalized prefix
+func parseCIDR(ipOrCIDR string) (netip.Prefix, error) {
+	if !strings.Contains(ipOrCIDR, "/") {
+		addr, err := ParseIP(ipOrCIDR)
+		if err != nil {
+			return netip.Prefix{}, fmt.Errorf("validation error for IP or CIDR: %s", ipOrCIDR)
+		}
+		return hostPrefix(addr), nil
+	}
+	p, err := netip.ParsePrefix(ipOrCIDR)
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("validation error for IP or CIDR: %s", ipOrCIDR)
+	}
+	return normalizePrefix(p), nil
+}
+
+// Contains returns true if ip is within this range
+func (d *DenyEntry) Contains(ip string) bool {
+	p, err := parseCIDR(d.CIDR)
+	if err != nil {
+		return false
+	}
+	addr, err := ParseIP(ip)
+	return err == nil && p.Contains(addr)
+}
+
+// IsExpired returns true if the entry is past its expiry
+func (d *DenyEntry) IsExpired() bool {
+	return d.ExpiresAt != nil && d.ExpiresAt.Before(time.Now())
+}
+
+// Encode this object for storage to db
+func (d *DenyEntry) Encode() (encoded []byte) {
+	encoded, _ = json.Marshal(d)
+	return
+}
+
+// findDenyEntry returns the most specific entry of idx which blocks ip and has not expired, or nil
+func findDenyEntry(idx *IPIndex[DenyEntry], ip string) *DenyEntry {
+	_, entry, ok := idx.LookupFunc(ip, func(d DenyEntry) bool {
+		return !d.IsExpired()
+	})
+	if !ok {
+		return nil
+	}
+	return &entry
+}
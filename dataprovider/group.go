@@ -0,0 +1,172 @@
+package dataprovider
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	validate "github.com/asaskevich/govalidator"
+)
+
+var (
+	// error generated when attempting to add a group that already exists
+	ErrGroupExists = fmt.Errorf("group already exists")
+	// error generated when attempting to modify a group that does not exist
+	ErrGroupNotFound = fmt.Errorf("group was not found")
+	// error generated when attempting to add a host set that already exists
+	ErrHostSetExists = fmt.Errorf("host set already exists")
+	// error generated when attempting to modify a host set that does not exist
+	ErrHostSetNotFound = fmt.Errorf("host set was not found")
+)
+
+// Group is a named group of users. Every member of a group is granted access
+// to the hosts of the group's host sets (or to everything with AllowAll).
+// users reference the groups they are a member of.
+type Group struct {
+	// A unique name for this Group
+	Name string `json:"name" example:"family"`
+	// A brief description of this Group
+	Description string `json:"description" example:"everyone at home"`
+	// Determines if members of this Group are allowed to access ALL resources
+	AllowAll bool `json:"allow_all" example:"false"`
+	// The names of the host sets members of this Group are allowed to access
+	HostSets []string `json:"host_sets" example:"media,wiki"`
+}
+
+// HostSet is a named list of hosts (FQDN), which can be granted to groups
+type HostSet struct {
+	// A unique name for this HostSet
+	Name string `json:"name" example:"media"`
+	// A brief description of this HostSet
+	Description string `json:"description" example:"streaming services"`
+	// A list of hosts (FQDN)
+	Hosts []string `json:"hosts" example:"plex.example.com,jellyfin.example.com"`
+}
+
+// Validate returns an error when the group is invalid
+func (g *Group) Validate() error {
+	if !isValidName(g.Name) {
+		return fmt.Errorf("group name must be 1 to 64 letters, digits, '_', '.' or '-': %q", g.Name)
+	}
+	for _, name := range g.HostSets {
+		if !isValidName(name) {
+			return fmt.Errorf("host set name is invalid: %q", name)
+		}
+	}
+	return nil
+}
+
+// Encode this object for storage to db
+func (g *Group) Encode() (encoded []byte) {
+	encoded, _ = json.Marshal(g)
+	return
+}
+
+// Validate returns an error when the host set is invalid. Hosts are lowercased
+func (h *HostSet) Validate() error {
+	if !isValidName(h.Name) {
+		return fmt.Errorf("host set name must be 1 to 64 letters, digits, '_', '.' or '-': %q", h.Name)
+	}
+	for i, host := range h.Hosts {
+		if !validate.IsDNSName(host) {
+			return fmt.Errorf("validation error for DNS name: %s", host)
+		}
+		h.Hosts[i] = strings.ToLower(host)
+	}
+	return nil
+}
+
+// Encode this object for storage to db
+func (h *HostSet) Encode() (encoded []byte) {
+	encoded, _ = json.Marshal(h)
+	return
+}
+
+// Permissions is what a user is allowed to access, once its groups are resolved
+type Permissions struct {
+	AllowAll bool
+	Hosts    []string
+	// the groups which exist and the user is a member of
+	Groups []string
+}
+
+// CheckHost checks if the permissions allow access to a host (case insensitive)
+func (perm *Permissions) CheckHost(host string) bool {
+	if perm.AllowAll {
+		return true
+	}
+	for _, allowedHost := range perm.Hosts {
+		if strings.EqualFold(allowedHost, host) {
+			return true
+		}
+	}
+	return false
+}
+
+// InAnyGroup returns true if the user is a member of one of groups
+func (perm *Permissions) InAnyGroup(groups []string) bool {
+	for _, group := range groups {
+		for _, member := range perm.Groups {
+			if group == member {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Grant adds access to everything (when allowAll is true) and to hosts
+func (perm *Permissions) Grant(allowAll bool, hosts []string) {
+	perm.AllowAll = perm.AllowAll || allowAll
+	for _, host := range hosts {
+		perm.Hosts = appendUnique(perm.Hosts, strings.ToLower(host))
+	}
+}
+
+// ResolveGroups returns the permissions granted by groups.
+// groups and host sets which do not exist (anymore) grant nothing
+func ResolveGroups(p Provider, groups []string) (perm Permissions, err error) {
+	for _, name := range groups {
+		group, err := p.GetGroup(name)
+		if err != nil {
+			return perm, err
+		}
+		if group == nil {
+			log.Warningf("group %s does not exist", name)
+			continue
+		}
+		perm.Groups = append(perm.Groups, name)
+		perm.Grant(group.AllowAll, nil)
+		for _, hostSetName := range group.HostSets {
+			hostSet, err := p.GetHostSet(hostSetName)
+			if err != nil {
+				return perm, err
+			}
+			if hostSet == nil {
+				log.Warningf("host set %s of group %s does not exist", hostSetName, name)
+				continue
+			}
+			perm.Grant(false, hostSet.Hosts)
+		}
+	}
+	return
+}
+
+// ResolvePermissions returns the permissions of a user: its own ACL and the grants of its groups
+func ResolvePermissions(p Provider, u *User) (perm Permissions, err error) {
+	if perm, err = ResolveGroups(p, u.Groups); err != nil {
+		return
+	}
+	perm.Grant(u.ACLAllowAll, u.ACLAllowedHosts)
+	return
+}
+
+// appendUnique appends value to values if it is not already in it (case insensitive)
+func appendUnique(values []string, value string) []string {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return values
+		}
+	}
+	return append(values, value)
+}
@@ -0,0 +1,112 @@
+package dataprovider
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestResolvePermissions(t *testing.T) {
+	tests := []struct {
+		name     string
+		user     User
+		allowAll bool
+		hosts    []string
+		groups   []string
+	}{
+		{"no groups", User{ACLAllowedHosts: []string{"own.example.com"}}, false, []string{"own.example.com"}, nil},
+		{"host sets of a group", User{Groups: []string{"family"}}, false, []string{"plex.example.com", "wiki.example.com"}, []string{"family"}},
+		{"own hosts and groups", User{Groups: []string{"family", "guests"}, ACLAllowedHosts: []string{"Own.example.com", "plex.example.com"}}, false, []string{"plex.example.com", "wiki.example.com", "guest.example.com", "own.example.com"}, []string{"family", "guests"}},
+		{"allow all group", User{Groups: []string{"admins"}, ACLAllowedHosts: []string{"own.example.com"}}, true, []string{"own.example.com"}, []string{"admins"}},
+		{"allow all user", User{ACLAllowAll: true, Groups: []string{"guests"}}, true, []string{"guest.example.com"}, []string{"guests"}},
+		// groups and host sets which do not exist grant nothing
+		{"missing group", User{Groups: []string{"gone", "guests"}}, false, []string{"guest.example.com"}, []string{"guests"}},
+		{"missing host set", User{Groups: []string{"broken"}}, false, nil, []string{"broken"}},
+	}
+	for name, p := range testProviders(t) {
+		for _, h := range []HostSet{
+			{Name: "media", Hosts: []string{"plex.example.com"}},
+			{Name: "wiki", Hosts: []string{"wiki.example.com"}},
+			{Name: "guest", Hosts: []string{"guest.example.com"}},
+		} {
+			h := h
+			if err := p.AddHostSet(&h); err != nil {
+				t.Fatal(err)
+			}
+		}
+		for _, g := range []Group{
+			{Name: "family", HostSets: []string{"media", "wiki"}},
+			{Name: "guests", HostSets: []string{"guest"}},
+			{Name: "admins", AllowAll: true},
+			{Name: "broken", HostSets: []string{"gone"}},
+		} {
+			g := g
+			if err := p.AddGroup(&g); err != nil {
+				t.Fatal(err)
+			}
+		}
+		for _, test := range tests {
+			t.Run(name+"/"+test.name, func(t *testing.T) {
+				perm, err := ResolvePermissions(p, &test.user)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if perm.AllowAll != test.allowAll || !reflect.DeepEqual(perm.Hosts, test.hosts) || !reflect.DeepEqual(perm.Groups, test.groups) {
+					t.Fatalf("expected allow all %v, hosts %v and groups %v, got %+v", test.allowAll, test.hosts, test.groups, perm)
+				}
+			})
+		}
+	}
+}
+
+// permissions are resolved at every authorization, so that changes to groups
+// apply to the IPs whitelisted by a challenge before
+func TestResolvePermissionsGroupChanges(t *testing.T) {
+	for name, p := range testProviders(t) {
+		t.Run(name, func(t *testing.T) {
+			if err := p.AddHostSet(&HostSet{Name: "media", Hosts: []string{"plex.example.com"}}); err != nil {
+				t.Fatal(err)
+			}
+			if err := p.AddHostSet(&HostSet{Name: "wiki", Hosts: []string{"wiki.example.com"}}); err != nil {
+				t.Fatal(err)
+			}
+			if err := p.AddGroup(&Group{Name: "family", HostSets: []string{"media"}}); err != nil {
+				t.Fatal(err)
+			}
+			user := &User{Groups: []string{"family"}}
+			expect := func(host string, allowed bool) {
+				t.Helper()
+				perm, err := ResolvePermissions(p, user)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if perm.CheckHost(host) != allowed {
+					t.Fatalf("expected access to %s to be %v, got %+v", host, allowed, perm)
+				}
+			}
+
+			// at challenge time
+			expect("plex.example.com", true)
+			expect("wiki.example.com", false)
+
+			// the group is edited
+			if err := p.UpdateGroup(&Group{Name: "family", HostSets: []string{"wiki"}}); err != nil {
+				t.Fatal(err)
+			}
+			expect("plex.example.com", false)
+			expect("wiki.example.com", true)
+
+			// a host set of the group is edited
+			if err := p.UpdateHostSet(&HostSet{Name: "wiki", Hosts: []string{"docs.example.com"}}); err != nil {
+				t.Fatal(err)
+			}
+			expect("wiki.example.com", false)
+			expect("docs.example.com", true)
+
+			// the group is deleted
+			if err := p.RemoveGroup("family"); err != nil {
+				t.Fatal(err)
+			}
+			expect("docs.example.com", false)
+		})
+	}
+}
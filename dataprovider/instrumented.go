@@ -0,0 +1,234 @@
+package dataprovider
+
+import (
+	"time"
+
+	"github.com/gbolo/protego/audit"
+	"github.com/gbolo/protego/metrics"
+)
+
+// InstrumentedProvider wraps a Provider and observes the latency of every operation
+type InstrumentedProvider struct {
+	name     string
+	provider Provider
+}
+
+// NewInstrumentedProvider returns a Provider which records metrics under the specified provider name
+func NewInstrumentedProvider(name string, p Provider) *InstrumentedProvider {
+	return &InstrumentedProvider{name: name, provider: p}
+}
+
+// observe records the duration of an operation which started at start
+func (p *InstrumentedProvider) observe(operation string, start time.Time) {
+	metrics.ProviderOperationDuration.WithLabelValues(p.name, operation).Observe(time.Since(start).Seconds())
+}
+
+func (p *InstrumentedProvider) InitializeDatabase() error {
+	defer p.observe("initialize_database", time.Now())
+	return p.provider.InitializeDatabase()
+}
+
+func (p *InstrumentedProvider) CheckAvailability() error {
+	defer p.observe("check_availability", time.Now())
+	return p.provider.CheckAvailability()
+}
+
+func (p *InstrumentedProvider) Close() error {
+	defer p.observe("close", time.Now())
+	return p.provider.Close()
+}
+
+func (p *InstrumentedProvider) AddIp(ip string, acl *ACL) error {
+	defer p.observe("add_ip", time.Now())
+	return p.provider.AddIp(ip, acl)
+}
+
+func (p *InstrumentedProvider) AddUserIp(ip string, acl *ACL) (bool, []string, error) {
+	defer p.observe("add_user_ip", time.Now())
+	return p.provider.AddUserIp(ip, acl)
+}
+
+func (p *InstrumentedProvider) RemoveIp(ip string) error {
+	defer p.observe("remove_ip", time.Now())
+	return p.provider.RemoveIp(ip)
+}
+
+func (p *InstrumentedProvider) GetACL(ip string) (*ACL, error) {
+	defer p.observe("get_acl", time.Now())
+	return p.provider.GetACL(ip)
+}
+
+func (p *InstrumentedProvider) UpdateACL(ip string, acl *ACL) error {
+	defer p.observe("update_acl", time.Now())
+	return p.provider.UpdateACL(ip, acl)
+}
+
+func (p *InstrumentedProvider) GetAllACLs() (map[string]ACL, error) {
+	defer p.observe("get_all_acls", time.Now())
+	return p.provider.GetAllACLs()
+}
+
+func (p *InstrumentedProvider) AddUser(u *User) error {
+	defer p.observe("add_user", time.Now())
+	return p.provider.AddUser(u)
+}
+
+func (p *InstrumentedProvider) RemoveUser(u *User) error {
+	defer p.observe("remove_user", time.Now())
+	return p.provider.RemoveUser(u)
+}
+
+func (p *InstrumentedProvider) GetUser(id string) (*User, error) {
+	defer p.observe("get_user", time.Now())
+	return p.provider.GetUser(id)
+}
+
+func (p *InstrumentedProvider) UpdateUser(u *User) error {
+	defer p.observe("update_user", time.Now())
+	return p.provider.UpdateUser(u)
+}
+
+func (p *InstrumentedProvider) UseTOTPCounter(id string, counter int64) error {
+	defer p.observe("use_totp_counter", time.Now())
+	return p.provider.UseTOTPCounter(id, counter)
+}
+
+func (p *InstrumentedProvider) GetAllUsers() ([]User, error) {
+	defer p.observe("get_all_users", time.Now())
+	return p.provider.GetAllUsers()
+}
+
+func (p *InstrumentedProvider) AddGroup(g *Group) error {
+	defer p.observe("add_group", time.Now())
+	return p.provider.AddGroup(g)
+}
+
+func (p *InstrumentedProvider) UpdateGroup(g *Group) error {
+	defer p.observe("update_group", time.Now())
+	return p.provider.UpdateGroup(g)
+}
+
+func (p *InstrumentedProvider) RemoveGroup(name string) error {
+	defer p.observe("remove_group", time.Now())
+	return p.provider.RemoveGroup(name)
+}
+
+func (p *InstrumentedProvider) GetGroup(name string) (*Group, error) {
+	defer p.observe("get_group", time.Now())
+	return p.provider.GetGroup(name)
+}
+
+func (p *InstrumentedProvider) GetAllGroups() ([]Group, error) {
+	defer p.observe("get_all_groups", time.Now())
+	return p.provider.GetAllGroups()
+}
+
+func (p *InstrumentedProvider) AddHostSet(h *HostSet) error {
+	defer p.observe("add_host_set", time.Now())
+	return p.provider.AddHostSet(h)
+}
+
+func (p *InstrumentedProvider) UpdateHostSet(h *HostSet) error {
+	defer p.observe("update_host_set", time.Now())
+	return p.provider.UpdateHostSet(h)
+}
+
+func (p *InstrumentedProvider) RemoveHostSet(name string) error {
+	defer p.observe("remove_host_set", time.Now())
+	return p.provider.RemoveHostSet(name)
+}
+
+func (p *InstrumentedProvider) GetHostSet(name string) (*HostSet, error) {
+	defer p.observe("get_host_set", time.Now())
+	return p.provider.GetHostSet(name)
+}
+
+func (p *InstrumentedProvider) GetAllHostSets() ([]HostSet, error) {
+	defer p.observe("get_all_host_sets", time.Now())
+	return p.provider.GetAllHostSets()
+}
+
+func (p *InstrumentedProvider) AddHostPolicy(pol *HostPolicy) error {
+	defer p.observe("add_host_policy", time.Now())
+	return p.provider.AddHostPolicy(pol)
+}
+
+func (p *InstrumentedProvider) UpdateHostPolicy(pol *HostPolicy) error {
+	defer p.observe("update_host_policy", time.Now())
+	return p.provider.UpdateHostPolicy(pol)
+}
+
+func (p *InstrumentedProvider) RemoveHostPolicy(host string) error {
+	defer p.observe("remove_host_policy", time.Now())
+	return p.provider.RemoveHostPolicy(host)
+}
+
+func (p *InstrumentedProvider) GetHostPolicy(host string) (*HostPolicy, error) {
+	defer p.observe("get_host_policy", time.Now())
+	return p.provider.GetHostPolicy(host)
+}
+
+func (p *InstrumentedProvider) GetAllHostPolicies() ([]HostPolicy, error) {
+	defer p.observe("get_all_host_policies", time.Now())
+	return p.provider.GetAllHostPolicies()
+}
+
+func (p *InstrumentedProvider) AddToken(t *Token) error {
+	defer p.observe("add_token", time.Now())
+	return p.provider.AddToken(t)
+}
+
+func (p *InstrumentedProvider) RemoveToken(name string) error {
+	defer p.observe("remove_token", time.Now())
+	return p.provider.RemoveToken(name)
+}
+
+func (p *InstrumentedProvider) GetToken(name string) (*Token, error) {
+	defer p.observe("get_token", time.Now())
+	return p.provider.GetToken(name)
+}
+
+func (p *InstrumentedProvider) GetAllTokens() ([]Token, error) {
+	defer p.observe("get_all_tokens", time.Now())
+	return p.provider.GetAllTokens()
+}
+
+func (p *InstrumentedProvider) FindToken(secret string) (*Token, error) {
+	defer p.observe("find_token", time.Now())
+	return p.provider.FindToken(secret)
+}
+
+func (p *InstrumentedProvider) AddDenyEntry(d *DenyEntry) error {
+	defer p.observe("add_deny_entry", time.Now())
+	return p.provider.AddDenyEntry(d)
+}
+
+func (p *InstrumentedProvider) RemoveDenyEntry(cidr string) (*DenyEntry, error) {
+	defer p.observe("remove_deny_entry", time.Now())
+	return p.provider.RemoveDenyEntry(cidr)
+}
+
+func (p *InstrumentedProvider) GetAllDenyEntries() ([]DenyEntry, error) {
+	defer p.observe("get_all_deny_entries", time.Now())
+	return p.provider.GetAllDenyEntries()
+}
+
+func (p *InstrumentedProvider) FindDenyEntry(ip string) (*DenyEntry, error) {
+	defer p.observe("find_deny_entry", time.Now())
+	return p.provider.FindDenyEntry(ip)
+}
+
+func (p *InstrumentedProvider) AddAuditEvent(e *audit.Event) error {
+	defer p.observe("add_audit_event", time.Now())
+	return p.provider.AddAuditEvent(e)
+}
+
+func (p *InstrumentedProvider) GetAuditEvents(f *audit.Filter) ([]audit.Event, error) {
+	defer p.observe("get_audit_events", time.Now())
+	return p.provider.GetAuditEvents(f)
+}
+
+func (p *InstrumentedProvider) PruneAuditEvents(before time.Time, maxEvents int) error {
+	defer p.observe("prune_audit_events", time.Now())
+	return p.provider.PruneAuditEvents(before, maxEvents)
+}
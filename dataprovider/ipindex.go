@@ -0,0 +1,280 @@
+package dataprovider
+
+import (
+	"fmt"
+	"net/netip"
+	"sync"
+)
+
+// ParseIP parses an IP address into its normalized form: IPv4-mapped IPv6
+// addresses are unmapped to IPv4, and zones are removed. This way 0:0:0:0:0:0:0:1
+// and ::1, or ::ffff:1.2.3.4 and 1.2.3.4, are the same address
+func ParseIP(ip string) (netip.Addr, error) {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return addr, fmt.Errorf("validation error for IP: %s", ip)
+	}
+	return addr.Unmap().WithZone(""), nil
+}
+
+// NormalizeIP returns the normalized string representation of an IP address, or ip itself when it is invalid
+func NormalizeIP(ip string) string {
+	if addr, err := ParseIP(ip); err == nil {
+		return addr.String()
+	}
+	return ip
+}
+
+// IPIndex is a radix tree of values indexed by IP address or prefix, which is safe for concurrent use.
+// IPv4 and IPv6 addresses are kept in separate trees, and addresses are normalized (see ParseIP).
+type IPIndex[V any] struct {
+	v4   *ipNode[V]
+	v6   *ipNode[V]
+	size int
+	lock *sync.RWMutex
+}
+
+// a node of IPIndex. nodes without a value only join their two children
+type ipNode[V any] struct {
+	prefix   netip.Prefix
+	value    V
+	hasValue bool
+	children [2]*ipNode[V]
+}
+
+// NewIPIndex returns an empty IPIndex
+func NewIPIndex[V any]() *IPIndex[V] {
+	return &IPIndex[V]{lock: new(sync.RWMutex)}
+}
+
+// hostPrefix returns the prefix which only contains addr
+func hostPrefix(addr netip.Addr) netip.Prefix {
+	return netip.PrefixFrom(addr, addr.BitLen())
+}
+
+// normalizePrefix returns the normalized and masked form of p
+func normalizePrefix(p netip.Prefix) netip.Prefix {
+	addr := p.Addr().WithZone("")
+	bits := p.Bits()
+	if addr.Is4In6() && bits >= 96 {
+		addr, bits = addr.Unmap(), bits-96
+	}
+	return netip.PrefixFrom(addr, bits).Masked()
+}
+
+// root returns the tree of the family of p
+func (idx *IPIndex[V]) root(p netip.Prefix) **ipNode[V] {
+	if p.Addr().Is4() {
+		return &idx.v4
+	}
+	return &idx.v6
+}
+
+// Set sets the value of ip
+func (idx *IPIndex[V]) Set(ip string, value V) error {
+	addr, err := ParseIP(ip)
+	if err != nil {
+		return err
+	}
+	idx.SetPrefix(hostPrefix(addr), value)
+	return nil
+}
+
+// SetPrefix sets the value of prefix p
+func (idx *IPIndex[V]) SetPrefix(p netip.Prefix, value V) {
+	p = normalizePrefix(p)
+	idx.lock.Lock()
+	defer idx.lock.Unlock()
+	if insertNode(idx.root(p), p, value) {
+		idx.size++
+	}
+}
+
+// Get returns the value of ip. Prefixes containing ip are not considered
+func (idx *IPIndex[V]) Get(ip string) (value V, ok bool) {
+	addr, err := ParseIP(ip)
+	if err != nil {
+		return
+	}
+	return idx.GetPrefix(hostPrefix(addr))
+}
+
+// GetPrefix returns the value of prefix p
+func (idx *IPIndex[V]) GetPrefix(p netip.Prefix) (value V, ok bool) {
+	p = normalizePrefix(p)
+	idx.lock.RLock()
+	defer idx.lock.RUnlock()
+	for n := *idx.root(p); n != nil && n.prefix.Bits() <= p.Bits() && n.prefix.Contains(p.Addr()); {
+		if n.prefix == p {
+			return n.value, n.hasValue
+		}
+		n = n.children[bitAt(p.Addr(), n.prefix.Bits())]
+	}
+	return
+}
+
+// Lookup returns the value of the longest prefix containing ip (which may be ip itself), and that prefix
+func (idx *IPIndex[V]) Lookup(ip string) (prefix netip.Prefix, value V, ok bool) {
+	return idx.LookupFunc(ip, nil)
+}
+
+// LookupFunc returns the value of the longest prefix containing ip for which match
+// returns true, and that prefix. Every value matches when match is nil
+func (idx *IPIndex[V]) LookupFunc(ip string, match func(value V) bool) (prefix netip.Prefix, value V, ok bool) {
+	addr, err := ParseIP(ip)
+	if err != nil {
+		return
+	}
+	idx.lock.RLock()
+	defer idx.lock.RUnlock()
+	// the nodes containing ip, from the shortest to the longest prefix
+	var path []*ipNode[V]
+	for n := *idx.root(hostPrefix(addr)); n != nil && n.prefix.Contains(addr); {
+		if n.hasValue {
+			path = append(path, n)
+		}
+		if n.prefix.Bits() == addr.BitLen() {
+			break
+		}
+		n = n.children[bitAt(addr, n.prefix.Bits())]
+	}
+	for i := len(path) - 1; i >= 0; i-- {
+		if match == nil || match(path[i].value) {
+			return path[i].prefix, path[i].value, true
+		}
+	}
+	return
+}
+
+// Delete removes the value of ip, and returns true if it had one
+func (idx *IPIndex[V]) Delete(ip string) bool {
+	addr, err := ParseIP(ip)
+	if err != nil {
+		return false
+	}
+	return idx.DeletePrefix(hostPrefix(addr))
+}
+
+// DeletePrefix removes the value of prefix p, and returns true if it had one
+func (idx *IPIndex[V]) DeletePrefix(p netip.Prefix) bool {
+	p = normalizePrefix(p)
+	idx.lock.Lock()
+	defer idx.lock.Unlock()
+	if deleteNode(idx.root(p), p) {
+		idx.size--
+		return true
+	}
+	return false
+}
+
+// Len returns the number of values
+func (idx *IPIndex[V]) Len() int {
+	idx.lock.RLock()
+	defer idx.lock.RUnlock()
+	return idx.size
+}
+
+// Walk calls fn for every value, IPv4 before IPv6 and in address order, until fn returns false.
+// the index must not be modified by fn
+func (idx *IPIndex[V]) Walk(fn func(prefix netip.Prefix, value V) bool) {
+	idx.lock.RLock()
+	defer idx.lock.RUnlock()
+	_ = walkNode(idx.v4, fn) && walkNode(idx.v6, fn)
+}
+
+// Clear removes every value
+func (idx *IPIndex[V]) Clear() {
+	idx.lock.Lock()
+	defer idx.lock.Unlock()
+	idx.v4, idx.v6, idx.size = nil, nil, 0
+}
+
+// insertNode sets the value of p in the tree n, and returns true if p had no value
+func insertNode[V any](n **ipNode[V], p netip.Prefix, value V) bool {
+	node := *n
+	if node == nil {
+		*n = &ipNode[V]{prefix: p, value: value, hasValue: true}
+		return true
+	}
+	common := commonBits(node.prefix, p)
+	switch {
+	case common == node.prefix.Bits() && common == p.Bits():
+		added := !node.hasValue
+		node.value, node.hasValue = value, true
+		return added
+	case common == node.prefix.Bits():
+		// p is within node
+		return insertNode(&node.children[bitAt(p.Addr(), common)], p, value)
+	case common == p.Bits():
+		// node is within p
+		parent := &ipNode[V]{prefix: p, value: value, hasValue: true}
+		parent.children[bitAt(node.prefix.Addr(), common)] = node
+		*n = parent
+		return true
+	default:
+		// node and p only share their first common bits, they are joined by a node without value
+		join := &ipNode[V]{prefix: netip.PrefixFrom(p.Addr(), common).Masked()}
+		join.children[bitAt(node.prefix.Addr(), common)] = node
+		join.children[bitAt(p.Addr(), common)] = &ipNode[V]{prefix: p, value: value, hasValue: true}
+		*n = join
+		return true
+	}
+}
+
+// deleteNode removes the value of p from the tree n, and returns true if p had a value.
+// nodes left without a value and with less than two children are removed
+func deleteNode[V any](n **ipNode[V], p netip.Prefix) bool {
+	node := *n
+	if node == nil || node.prefix.Bits() > p.Bits() || !node.prefix.Contains(p.Addr()) {
+		return false
+	}
+	deleted := false
+	if node.prefix == p {
+		deleted = node.hasValue
+		var zero V
+		node.value, node.hasValue = zero, false
+	} else {
+		deleted = deleteNode(&node.children[bitAt(p.Addr(), node.prefix.Bits())], p)
+	}
+	if !node.hasValue {
+		switch {
+		case node.children[0] == nil:
+			*n = node.children[1]
+		case node.children[1] == nil:
+			*n = node.children[0]
+		}
+	}
+	return deleted
+}
+
+// walkNode calls fn for every value of the tree n in order, and returns false once fn does
+func walkNode[V any](n *ipNode[V], fn func(prefix netip.Prefix, value V) bool) bool {
+	if n == nil {
+		return true
+	}
+	if n.hasValue && !fn(n.prefix, n.value) {
+		return false
+	}
+	return walkNode(n.children[0], fn) && walkNode(n.children[1], fn)
+}
+
+// bitAt returns the bit of addr at position i, starting from the most significant bit
+func bitAt(addr netip.Addr, i int) int {
+	b := addr.AsSlice()
+	return int(b[i/8]>>(7-uint(i%8))) & 1
+}
+
+// commonBits returns the number of leading bits a and b have in common, within the shortest of them
+func commonBits(a, b netip.Prefix) int {
+	max := a.Bits()
+	if b.Bits() < max {
+		max = b.Bits()
+	}
+	x, y := a.Addr().AsSlice(), b.Addr().AsSlice()
+	for i := 0; i < max; i++ {
+		if (x[i/8]>>(7-uint(i%8)))&1 != (y[i/8]>>(7-uint(i%8)))&1 {
+			return i
+		}
+	}
+	return max
+}
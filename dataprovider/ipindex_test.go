@@ -0,0 +1,223 @@
+package dataprovider
+
+import (
+	"math/rand"
+	"net/netip"
+	"testing"
+)
+
+// walkPrefixes returns the prefixes of idx in walk order
+func walkPrefixes(idx *IPIndex[string]) (prefixes []string) {
+	idx.Walk(func(prefix netip.Prefix, _ string) bool {
+		prefixes = append(prefixes, prefix.String())
+		return true
+	})
+	return
+}
+
+func TestParseIP(t *testing.T) {
+	for ip, expected := range map[string]string{
+		"1.2.3.4":         "1.2.3.4",
+		"::ffff:1.2.3.4":  "1.2.3.4",
+		"0:0:0:0:0:0:0:1": "::1",
+		"fe80::1%eth0":    "fe80::1",
+		"2001:DB8::1":     "2001:db8::1",
+	} {
+		if normalized := NormalizeIP(ip); normalized != expected {
+			t.Errorf("expected %s to be normalized to %s, got %s", ip, expected, normalized)
+		}
+	}
+	if _, err := ParseIP("1.2.3"); err == nil {
+		t.Error("expected an error for an invalid IP")
+	}
+	if normalized := NormalizeIP("invalid"); normalized != "invalid" {
+		t.Errorf("expected an invalid IP to be returned as is, got %s", normalized)
+	}
+}
+
+func TestIPIndexSetGetDelete(t *testing.T) {
+	idx := NewIPIndex[string]()
+	for _, ip := range []string{"10.0.0.1", "10.0.0.2", "::ffff:10.0.0.3", "2001:db8::1", "0:0:0:0:0:0:0:1"} {
+		if err := idx.Set(ip, ip); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := idx.Set("invalid", "invalid"); err == nil {
+		t.Fatal("expected an error for an invalid IP")
+	}
+	// setting an existing IP replaces its value
+	idx.Set("10.0.0.1", "replaced")
+	if idx.Len() != 5 {
+		t.Fatalf("expected 5 values, got %d", idx.Len())
+	}
+
+	for ip, expected := range map[string]string{
+		"10.0.0.1":        "replaced",
+		"10.0.0.3":        "::ffff:10.0.0.3",
+		"::ffff:10.0.0.2": "10.0.0.2",
+		"::1":             "0:0:0:0:0:0:0:1",
+		"2001:db8::1":     "2001:db8::1",
+	} {
+		if value, ok := idx.Get(ip); !ok || value != expected {
+			t.Errorf("expected %s for %s, got %q (found: %v)", expected, ip, value, ok)
+		}
+	}
+	for _, ip := range []string{"10.0.0.4", "2001:db8::2", "invalid"} {
+		if value, ok := idx.Get(ip); ok {
+			t.Errorf("expected no value for %s, got %s", ip, value)
+		}
+	}
+
+	if !idx.Delete("::ffff:10.0.0.1") {
+		t.Fatal("expected 10.0.0.1 to be deleted")
+	}
+	if idx.Delete("10.0.0.1") || idx.Delete("10.0.0.4") || idx.Delete("invalid") {
+		t.Fatal("expected deleting a missing IP to return false")
+	}
+	if _, ok := idx.Get("10.0.0.1"); ok || idx.Len() != 4 {
+		t.Fatalf("expected 10.0.0.1 to be gone and 4 values, got %d", idx.Len())
+	}
+
+	idx.Clear()
+	if idx.Len() != 0 || len(walkPrefixes(idx)) != 0 {
+		t.Fatal("expected no value after Clear")
+	}
+}
+
+func TestIPIndexPrefixes(t *testing.T) {
+	idx := NewIPIndex[string]()
+	// 10.1.0.0/16 is inserted after its children: the tree is split and joined
+	for _, cidr := range []string{"10.1.2.0/24", "10.1.3.0/24", "10.0.0.0/8", "10.1.0.0/16", "10.1.2.7/32", "10.200.0.0/16"} {
+		idx.SetPrefix(netip.MustParsePrefix(cidr), cidr)
+	}
+	// prefixes are normalized and masked
+	idx.SetPrefix(netip.MustParsePrefix("::ffff:192.168.1.77/120"), "192.168.1.0/24")
+
+	expected := []string{"10.0.0.0/8", "10.1.0.0/16", "10.1.2.0/24", "10.1.2.7/32", "10.1.3.0/24", "10.200.0.0/16", "192.168.1.0/24"}
+	prefixes := walkPrefixes(idx)
+	if len(prefixes) != len(expected) || idx.Len() != len(expected) {
+		t.Fatalf("expected prefixes %v, got %v (len %d)", expected, prefixes, idx.Len())
+	}
+	for i := range expected {
+		if prefixes[i] != expected[i] {
+			t.Fatalf("expected prefixes %v in order, got %v", expected, prefixes)
+		}
+	}
+	if value, ok := idx.GetPrefix(netip.MustParsePrefix("192.168.1.0/24")); !ok || value != "192.168.1.0/24" {
+		t.Fatalf("expected the value of 192.168.1.0/24, got %q", value)
+	}
+	// the prefix joining 10.1.2.0/24 and 10.1.3.0/24 has no value
+	if _, ok := idx.GetPrefix(netip.MustParsePrefix("10.1.2.0/23")); ok {
+		t.Fatal("expected no value for a joining prefix")
+	}
+
+	lookups := map[string]string{
+		"10.1.2.7":        "10.1.2.7/32",
+		"10.1.2.8":        "10.1.2.0/24",
+		"::ffff:10.1.3.1": "10.1.3.0/24",
+		"10.1.4.1":        "10.1.0.0/16",
+		"10.2.0.1":        "10.0.0.0/8",
+		"10.200.9.9":      "10.200.0.0/16",
+		"192.168.1.200":   "192.168.1.0/24",
+		"11.0.0.1":        "",
+		"2001:db8::1":     "",
+		"invalid":         "",
+	}
+	for ip, expected := range lookups {
+		prefix, value, ok := idx.Lookup(ip)
+		if ok != (expected != "") || value != expected || (ok && prefix.String() != expected) {
+			t.Errorf("expected lookup of %s to return %q, got %q (prefix %v, found: %v)", ip, expected, value, prefix, ok)
+		}
+	}
+
+	// LookupFunc skips the prefixes which do not match
+	_, value, _ := idx.LookupFunc("10.1.2.7", func(v string) bool { return v != "10.1.2.7/32" && v != "10.1.2.0/24" })
+	if value != "10.1.0.0/16" {
+		t.Fatalf("expected the longest matching prefix 10.1.0.0/16, got %q", value)
+	}
+	if _, _, ok := idx.LookupFunc("10.1.2.7", func(string) bool { return false }); ok {
+		t.Fatal("expected no result when nothing matches")
+	}
+
+	// deleting a prefix keeps the prefixes within it
+	if !idx.DeletePrefix(netip.MustParsePrefix("10.1.0.0/16")) || idx.DeletePrefix(netip.MustParsePrefix("10.1.0.0/16")) {
+		t.Fatal("expected 10.1.0.0/16 to be deleted once")
+	}
+	if _, value, _ := idx.Lookup("10.1.4.1"); value != "10.0.0.0/8" {
+		t.Fatalf("expected 10.0.0.0/8 once 10.1.0.0/16 is deleted, got %q", value)
+	}
+	if _, value, _ := idx.Lookup("10.1.3.1"); value != "10.1.3.0/24" {
+		t.Fatalf("expected 10.1.3.0/24 to be kept, got %q", value)
+	}
+	// a joining prefix has no value to delete
+	if idx.DeletePrefix(netip.MustParsePrefix("10.1.2.0/23")) {
+		t.Fatal("expected deleting a joining prefix to return false")
+	}
+	if idx.Len() != len(expected)-1 {
+		t.Fatalf("expected %d values, got %d", len(expected)-1, idx.Len())
+	}
+}
+
+func TestIPIndexWalkStops(t *testing.T) {
+	idx := NewIPIndex[string]()
+	for _, ip := range []string{"10.0.0.1", "10.0.0.2", "::1"} {
+		idx.Set(ip, ip)
+	}
+	var visited []string
+	idx.Walk(func(prefix netip.Prefix, value string) bool {
+		visited = append(visited, value)
+		return false
+	})
+	if len(visited) != 1 || visited[0] != "10.0.0.1" {
+		t.Fatalf("expected Walk to stop after 10.0.0.1, visited %v", visited)
+	}
+}
+
+// TestIPIndexRandom compares the index with a map of prefixes after random changes
+func TestIPIndexRandom(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	randomPrefix := func() netip.Prefix {
+		// few distinct addresses and lengths, so that prefixes overlap
+		addr := netip.AddrFrom4([4]byte{10, byte(rng.Intn(4)), byte(rng.Intn(4) * 64), byte(rng.Intn(4))})
+		return netip.PrefixFrom(addr, 8+rng.Intn(25)).Masked()
+	}
+	idx := NewIPIndex[string]()
+	expected := make(map[netip.Prefix]string)
+	for i := 0; i < 5000; i++ {
+		p := randomPrefix()
+		if rng.Intn(3) == 0 {
+			_, had := expected[p]
+			if deleted := idx.DeletePrefix(p); deleted != had {
+				t.Fatalf("step %d: expected deleting %v to return %v", i, p, had)
+			}
+			delete(expected, p)
+		} else {
+			idx.SetPrefix(p, p.String())
+			expected[p] = p.String()
+		}
+		if idx.Len() != len(expected) {
+			t.Fatalf("step %d: expected %d values, got %d", i, len(expected), idx.Len())
+		}
+
+		// the longest prefix containing a random address, by brute force
+		addr := randomPrefix().Addr()
+		longest := ""
+		bits := -1
+		for prefix, value := range expected {
+			if prefix.Contains(addr) && prefix.Bits() > bits {
+				longest, bits = value, prefix.Bits()
+			}
+		}
+		if _, value, _ := idx.Lookup(addr.String()); value != longest {
+			t.Fatalf("step %d: expected lookup of %v to return %q, got %q", i, addr, longest, value)
+		}
+	}
+	for prefix, value := range expected {
+		if got, ok := idx.GetPrefix(prefix); !ok || got != value {
+			t.Fatalf("expected %s for %v, got %q", value, prefix, got)
+		}
+	}
+	if walked := walkPrefixes(idx); len(walked) != len(expected) {
+		t.Fatalf("expected Walk to visit %d prefixes, got %d", len(expected), len(walked))
+	}
+}
@@ -2,17 +2,29 @@ package dataprovider
 
 import (
 	"fmt"
+	"net/netip"
+	"strings"
 	"sync"
+	"time"
 
-	validate "github.com/asaskevich/govalidator"
+	"github.com/gbolo/protego/audit"
 )
 
 // MemoryProvider implements Provider in memory
 // NOT SAFE TO USE OUTSIDE OF TESTING
 type MemoryProvider struct {
-	users map[string]User
-	acls  map[string]ACL
-	lock  *sync.Mutex // TODO: use RWMutex
+	users    map[string]User
+	acls     *IPIndex[ACL]
+	tokens   map[string]Token
+	groups   map[string]Group
+	hostSets map[string]HostSet
+	policies map[string]HostPolicy
+	denied   *IPIndex[DenyEntry]
+	// names of the tokens, by token hash
+	tokenHashes map[string]string
+	// ordered from oldest to newest
+	auditEvents []audit.Event
+	lock        *sync.RWMutex
 }
 
 func NewMemoryProvider() (p MemoryProvider, err error) {
@@ -22,8 +34,15 @@ func NewMemoryProvider() (p MemoryProvider, err error) {
 
 func (p *MemoryProvider) InitializeDatabase() (err error) {
 	p.users = make(map[string]User)
-	p.acls = make(map[string]ACL)
-	p.lock = new(sync.Mutex)
+	p.acls = NewIPIndex[ACL]()
+	p.tokens = make(map[string]Token)
+	p.tokenHashes = make(map[string]string)
+	p.groups = make(map[string]Group)
+	p.hostSets = make(map[string]HostSet)
+	p.policies = make(map[string]HostPolicy)
+	p.denied = NewIPIndex[DenyEntry]()
+	p.auditEvents = nil
+	p.lock = new(sync.RWMutex)
 	log.Warningf("in-memory data provider has been initialized. This setting should only be used for testing.")
 	return nil
 }
@@ -32,45 +51,93 @@ func (p *MemoryProvider) CheckAvailability() error {
 	return nil
 }
 
+func (p *MemoryProvider) Close() error {
+	return nil
+}
+
 func (p *MemoryProvider) AddIp(ip string, acl *ACL) error {
-	if !validate.IsIP(ip) {
-		return fmt.Errorf("validation error for IP: %s", ip)
-	}
 	p.lock.Lock()
 	defer p.lock.Unlock()
-	p.acls[ip] = *acl
-	return nil
+	return p.acls.Set(ip, *acl)
 }
 
 func (p *MemoryProvider) RemoveIp(ip string) error {
-	if !validate.IsIP(ip) {
-		return fmt.Errorf("validation error for IP: %s", ip)
+	addr, err := ParseIP(ip)
+	if err != nil {
+		return err
 	}
+	ip = addr.String()
 	// remove the acl
 	p.lock.Lock()
 	defer p.lock.Unlock()
-	if _, ok := p.acls[ip]; ok {
-		delete(p.acls, ip)
+	if _, ok := p.acls.Get(ip); ok {
+		p.untrackIp(ip)
+		p.acls.Delete(ip)
 	}
 	return nil
 }
 
-func (p *MemoryProvider) GetACL(ip string) (acl *ACL, err error) {
-	if !validate.IsIP(ip) {
-		return nil, fmt.Errorf("validation error for IP: %s", ip)
+func (p *MemoryProvider) AddUserIp(ip string, acl *ACL) (newIP bool, evicted []string, err error) {
+	addr, err := ParseIP(ip)
+	if err != nil {
+		return false, nil, err
 	}
-	// retrieve the acl
+	ip = addr.String()
 	p.lock.Lock()
 	defer p.lock.Unlock()
-	if aclFound, ok := p.acls[ip]; ok {
+	u, ok := p.users[acl.UserID]
+	if !ok {
+		return false, nil, ErrUserNotFound
+	}
+	previous, whitelisted := p.acls.Get(ip)
+	newIP = !whitelisted || previous.UserID != u.ID || previous.IsExpired()
+	// forget IPs which are no longer whitelisted for this user
+	u.pruneIps(func(ip string) *ACL {
+		if a, ok := p.acls.Get(ip); ok {
+			return &a
+		}
+		return nil
+	})
+	if evicted, err = u.admitIp(ip); err != nil {
+		return false, nil, err
+	}
+	for _, old := range evicted {
+		p.acls.Delete(old)
+	}
+	// this IP may have been whitelisted for another user
+	if whitelisted && previous.UserID != u.ID {
+		p.untrackIp(ip)
+	}
+	p.acls.Set(ip, *acl)
+	p.users[u.ID] = u
+	return
+}
+
+// untrackIp removes ip from the IPs of the user its ACL was whitelisted for. lock must be held
+func (p *MemoryProvider) untrackIp(ip string) {
+	acl, _ := p.acls.Get(ip)
+	if u, ok := p.users[acl.UserID]; ok {
+		u.RemoveIp(ip)
+		p.users[u.ID] = u
+	}
+}
+
+func (p *MemoryProvider) GetACL(ip string) (acl *ACL, err error) {
+	if _, err = ParseIP(ip); err != nil {
+		return nil, err
+	}
+	// retrieve the acl
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	if aclFound, ok := p.acls.Get(ip); ok {
 		acl = &aclFound
 	}
 	return
 }
 
 func (p *MemoryProvider) UpdateACL(ip string, acl *ACL) error {
-	if !validate.IsIP(ip) {
-		return fmt.Errorf("validation error for IP: %s", ip)
+	if _, err := ParseIP(ip); err != nil {
+		return err
 	}
 	// get existing acl
 	ea, _ := p.GetACL(ip)
@@ -81,6 +148,19 @@ func (p *MemoryProvider) UpdateACL(ip string, acl *ACL) error {
 	return p.AddIp(ip, acl)
 }
 
+func (p *MemoryProvider) GetAllACLs() (acls map[string]ACL, err error) {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	acls = make(map[string]ACL)
+	p.acls.Walk(func(prefix netip.Prefix, acl ACL) bool {
+		if !acl.IsExpired() {
+			acls[prefix.Addr().String()] = acl
+		}
+		return true
+	})
+	return
+}
+
 func (p *MemoryProvider) AddUser(u *User) error {
 	// validate the user object
 	if u == nil || len(u.ID) < 6 {
@@ -118,8 +198,8 @@ func (p *MemoryProvider) GetUser(id string) (user *User, err error) {
 		return nil, fmt.Errorf("user id is invalid: %s", id)
 	}
 	// retrieve the user
-	p.lock.Lock()
-	defer p.lock.Unlock()
+	p.lock.RLock()
+	defer p.lock.RUnlock()
 	if userFound, ok := p.users[id]; ok {
 		user = &userFound
 	}
@@ -127,8 +207,8 @@ func (p *MemoryProvider) GetUser(id string) (user *User, err error) {
 }
 
 func (p *MemoryProvider) GetAllUsers() (users []User, err error) {
-	p.lock.Lock()
-	defer p.lock.Unlock()
+	p.lock.RLock()
+	defer p.lock.RUnlock()
 	for _, user := range p.users {
 		users = append(users, user)
 	}
@@ -140,15 +220,330 @@ func (p *MemoryProvider) UpdateUser(u *User) error {
 	if u == nil || len(u.ID) < 6 {
 		return fmt.Errorf("validation error for User: %v", u)
 	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
 	// check if user already exists
-	eu, _ := p.GetUser(u.ID)
-	if eu == nil {
+	eu, ok := p.users[u.ID]
+	if !ok {
 		return ErrUserNotFound
 	}
 	// overwrite the existing user, but keep associated IPs
 	u.IPs = eu.IPs
+	u.keepTOTPCounter(&eu)
+	p.users[u.ID] = *u
+	return nil
+}
+
+func (p *MemoryProvider) UseTOTPCounter(id string, counter int64) error {
 	p.lock.Lock()
 	defer p.lock.Unlock()
-	p.users[u.ID] = *u
+	u, ok := p.users[id]
+	if !ok {
+		return ErrUserNotFound
+	}
+	if err := u.useTOTPCounter(counter); err != nil {
+		return err
+	}
+	p.users[id] = u
+	return nil
+}
+
+func (p *MemoryProvider) AddGroup(g *Group) error {
+	if g == nil || g.Name == "" {
+		return fmt.Errorf("validation error for Group: %v", g)
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if _, ok := p.groups[g.Name]; ok {
+		return ErrGroupExists
+	}
+	p.groups[g.Name] = *g
+	return nil
+}
+
+func (p *MemoryProvider) UpdateGroup(g *Group) error {
+	if g == nil || g.Name == "" {
+		return fmt.Errorf("validation error for Group: %v", g)
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if _, ok := p.groups[g.Name]; !ok {
+		return ErrGroupNotFound
+	}
+	p.groups[g.Name] = *g
+	return nil
+}
+
+func (p *MemoryProvider) RemoveGroup(name string) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if _, ok := p.groups[name]; !ok {
+		return ErrGroupNotFound
+	}
+	delete(p.groups, name)
+	return nil
+}
+
+func (p *MemoryProvider) GetGroup(name string) (group *Group, err error) {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	if groupFound, ok := p.groups[name]; ok {
+		group = &groupFound
+	}
+	return
+}
+
+func (p *MemoryProvider) GetAllGroups() (groups []Group, err error) {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	for _, group := range p.groups {
+		groups = append(groups, group)
+	}
+	return
+}
+
+func (p *MemoryProvider) AddHostSet(h *HostSet) error {
+	if h == nil || h.Name == "" {
+		return fmt.Errorf("validation error for HostSet: %v", h)
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if _, ok := p.hostSets[h.Name]; ok {
+		return ErrHostSetExists
+	}
+	p.hostSets[h.Name] = *h
+	return nil
+}
+
+func (p *MemoryProvider) UpdateHostSet(h *HostSet) error {
+	if h == nil || h.Name == "" {
+		return fmt.Errorf("validation error for HostSet: %v", h)
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if _, ok := p.hostSets[h.Name]; !ok {
+		return ErrHostSetNotFound
+	}
+	p.hostSets[h.Name] = *h
+	return nil
+}
+
+func (p *MemoryProvider) RemoveHostSet(name string) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if _, ok := p.hostSets[name]; !ok {
+		return ErrHostSetNotFound
+	}
+	delete(p.hostSets, name)
+	return nil
+}
+
+func (p *MemoryProvider) GetHostSet(name string) (hostSet *HostSet, err error) {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	if hostSetFound, ok := p.hostSets[name]; ok {
+		hostSet = &hostSetFound
+	}
+	return
+}
+
+func (p *MemoryProvider) GetAllHostSets() (hostSets []HostSet, err error) {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	for _, hostSet := range p.hostSets {
+		hostSets = append(hostSets, hostSet)
+	}
+	return
+}
+
+func (p *MemoryProvider) AddHostPolicy(pol *HostPolicy) error {
+	if pol == nil || pol.Host == "" {
+		return fmt.Errorf("validation error for HostPolicy: %v", pol)
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if _, ok := p.policies[pol.Host]; ok {
+		return ErrHostPolicyExists
+	}
+	p.policies[pol.Host] = *pol
+	return nil
+}
+
+func (p *MemoryProvider) UpdateHostPolicy(pol *HostPolicy) error {
+	if pol == nil || pol.Host == "" {
+		return fmt.Errorf("validation error for HostPolicy: %v", pol)
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if _, ok := p.policies[pol.Host]; !ok {
+		return ErrHostPolicyNotFound
+	}
+	p.policies[pol.Host] = *pol
+	return nil
+}
+
+func (p *MemoryProvider) RemoveHostPolicy(host string) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if _, ok := p.policies[strings.ToLower(host)]; !ok {
+		return ErrHostPolicyNotFound
+	}
+	delete(p.policies, strings.ToLower(host))
+	return nil
+}
+
+func (p *MemoryProvider) GetHostPolicy(host string) (pol *HostPolicy, err error) {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	if policyFound, ok := p.policies[strings.ToLower(host)]; ok {
+		pol = &policyFound
+	}
+	return
+}
+
+func (p *MemoryProvider) GetAllHostPolicies() (policies []HostPolicy, err error) {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	for _, pol := range p.policies {
+		policies = append(policies, pol)
+	}
+	return
+}
+
+func (p *MemoryProvider) AddToken(t *Token) error {
+	if t == nil || t.Name == "" {
+		return fmt.Errorf("validation error for Token: %v", t)
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if _, ok := p.tokens[t.Name]; ok {
+		return ErrTokenExists
+	}
+	p.tokens[t.Name] = *t
+	p.tokenHashes[t.Hash] = t.Name
+	return nil
+}
+
+func (p *MemoryProvider) RemoveToken(name string) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	token, ok := p.tokens[name]
+	if !ok {
+		return ErrTokenNotFound
+	}
+	delete(p.tokenHashes, token.Hash)
+	delete(p.tokens, name)
+	return nil
+}
+
+func (p *MemoryProvider) GetToken(name string) (token *Token, err error) {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	if tokenFound, ok := p.tokens[name]; ok {
+		token = &tokenFound
+	}
+	return
+}
+
+func (p *MemoryProvider) GetAllTokens() (tokens []Token, err error) {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	for _, token := range p.tokens {
+		tokens = append(tokens, token)
+	}
+	return
+}
+
+func (p *MemoryProvider) FindToken(secret string) (token *Token, err error) {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	if tokenFound, ok := p.tokens[p.tokenHashes[hashToken(secret)]]; ok && tokenFound.CheckSecret(secret) {
+		token = &tokenFound
+	}
+	return
+}
+
+func (p *MemoryProvider) AddDenyEntry(d *DenyEntry) error {
+	if d == nil || d.CIDR == "" {
+		return fmt.Errorf("validation error for DenyEntry: %v", d)
+	}
+	prefix, err := parseCIDR(d.CIDR)
+	if err != nil {
+		return err
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	// an expired entry is replaced
+	if existing, ok := p.denied.GetPrefix(prefix); ok && !existing.IsExpired() {
+		return ErrDenyEntryExists
+	}
+	p.denied.SetPrefix(prefix, *d)
+	return nil
+}
+
+func (p *MemoryProvider) RemoveDenyEntry(cidr string) (*DenyEntry, error) {
+	prefix, err := parseCIDR(cidr)
+	if err != nil {
+		return nil, ErrDenyEntryNotFound
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	entry, ok := p.denied.GetPrefix(prefix)
+	if !ok {
+		return nil, ErrDenyEntryNotFound
+	}
+	p.denied.DeletePrefix(prefix)
+	return &entry, nil
+}
+
+func (p *MemoryProvider) GetAllDenyEntries() (entries []DenyEntry, err error) {
+	p.denied.Walk(func(_ netip.Prefix, entry DenyEntry) bool {
+		if !entry.IsExpired() {
+			entries = append(entries, entry)
+		}
+		return true
+	})
+	return
+}
+
+func (p *MemoryProvider) FindDenyEntry(ip string) (*DenyEntry, error) {
+	return findDenyEntry(p.denied, ip), nil
+}
+
+func (p *MemoryProvider) AddAuditEvent(e *audit.Event) error {
+	if e == nil {
+		return fmt.Errorf("audit event is nil")
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.auditEvents = append(p.auditEvents, *e)
+	return nil
+}
+
+func (p *MemoryProvider) GetAuditEvents(f *audit.Filter) (events []audit.Event, err error) {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	for i := len(p.auditEvents) - 1; i >= 0; i-- {
+		if f.Limit > 0 && len(events) >= f.Limit {
+			break
+		}
+		if f.Match(&p.auditEvents[i]) {
+			events = append(events, p.auditEvents[i])
+		}
+	}
+	return
+}
+
+func (p *MemoryProvider) PruneAuditEvents(before time.Time, maxEvents int) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	start := 0
+	for start < len(p.auditEvents) && p.auditEvents[start].Timestamp.Before(before) {
+		start++
+	}
+	if maxEvents > 0 && len(p.auditEvents)-start > maxEvents {
+		start = len(p.auditEvents) - maxEvents
+	}
+	p.auditEvents = append([]audit.Event(nil), p.auditEvents[start:]...)
 	return nil
 }
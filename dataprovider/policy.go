@@ -0,0 +1,104 @@
+package dataprovider
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	validate "github.com/asaskevich/govalidator"
+)
+
+var (
+	// error generated when attempting to add a host policy that already exists
+	ErrHostPolicyExists = fmt.Errorf("host policy already exists")
+	// error generated when attempting to modify a host policy that does not exist
+	ErrHostPolicyNotFound = fmt.Errorf("host policy was not found")
+	// error generated when the challenge of an IP is older than the max TTL of a host
+	ErrHostTTLExpired = fmt.Errorf("challenge is older than the max TTL of the host")
+	// error generated when a host requires TOTP, and the challenge did not include a TOTP code
+	ErrTOTPRequired = fmt.Errorf("host requires a challenge with TOTP")
+	// error generated when a user is not a member of any group allowed to access a host
+	ErrGroupNotAllowed = fmt.Errorf("host is restricted to other groups")
+)
+
+// HostPolicy restricts access to a host (FQDN) further than the permissions of users.
+// it applies on top of them, and never grants access by itself.
+type HostPolicy struct {
+	// The host (FQDN) this HostPolicy applies to
+	Host string `json:"host" example:"nas.example.com"`
+	// A brief description of this HostPolicy
+	Description string `json:"description" example:"NAS admin panel"`
+	// Access is denied once the challenge of an IP is older than this many minutes (0 means no limit)
+	MaxTTLMinutes int `json:"max_ttl_minutes" example:"15"`
+	// Access requires a challenge which included a valid TOTP code
+	RequireTOTP bool `json:"require_totp" example:"true"`
+	// When set, only members of these groups can access this host
+	AllowedGroups []string `json:"allowed_groups,omitempty" example:"admins"`
+	// When set, this host can only be accessed from these countries (ISO 3166-1 alpha-2), located with the GeoIP database
+	AllowedCountries []string `json:"allowed_countries,omitempty" example:"CA,FR"`
+}
+
+// Validate returns an error when the host policy is invalid. The host is lowercased, and countries uppercased
+func (pol *HostPolicy) Validate() error {
+	if !validate.IsDNSName(pol.Host) {
+		return fmt.Errorf("validation error for DNS name: %s", pol.Host)
+	}
+	// stored in the form policies are looked up with (see policyHost in the server package)
+	pol.Host = strings.ToLower(strings.TrimSuffix(pol.Host, "."))
+	if pol.MaxTTLMinutes < 0 {
+		return fmt.Errorf("max_ttl_minutes cannot be negative")
+	}
+	for _, name := range pol.AllowedGroups {
+		if !isValidName(name) {
+			return fmt.Errorf("group name is invalid: %q", name)
+		}
+	}
+	return normalizeCountries(pol.AllowedCountries)
+}
+
+// Encode this object for storage to db
+func (pol *HostPolicy) Encode() (encoded []byte) {
+	encoded, _ = json.Marshal(pol)
+	return
+}
+
+// Check returns an error when this policy denies an IP with acl and perm access to its host at now
+func (pol *HostPolicy) Check(acl *ACL, perm *Permissions, now time.Time) error {
+	if pol.MaxTTLMinutes > 0 {
+		// IPs which were not whitelisted by a challenge never pass a challenge again
+		if acl.ChallengedAt == nil || now.After(pol.expiry(*acl.ChallengedAt)) {
+			return ErrHostTTLExpired
+		}
+	}
+	if pol.RequireTOTP && !acl.TOTPVerified {
+		return ErrTOTPRequired
+	}
+	if len(pol.AllowedGroups) > 0 && !perm.InAnyGroup(pol.AllowedGroups) {
+		return ErrGroupNotAllowed
+	}
+	return nil
+}
+
+// expiry returns when access through a challenge at challengedAt ends
+func (pol *HostPolicy) expiry(challengedAt time.Time) time.Time {
+	return challengedAt.Add(time.Duration(pol.MaxTTLMinutes) * time.Minute)
+}
+
+// PolicyTTL returns when every host of perm is denied by the max TTL of its policy, for a
+// challenge at challengedAt. ok is false when some host is not limited by a max TTL
+func PolicyTTL(p Provider, perm *Permissions, challengedAt time.Time) (ttl time.Time, ok bool, err error) {
+	if perm.AllowAll || len(perm.Hosts) == 0 {
+		return
+	}
+	for _, host := range perm.Hosts {
+		pol, err := p.GetHostPolicy(host)
+		if err != nil || pol == nil || pol.MaxTTLMinutes == 0 {
+			return ttl, false, err
+		}
+		if expiry := pol.expiry(challengedAt); expiry.After(ttl) {
+			ttl = expiry
+		}
+	}
+	return ttl, true, nil
+}
@@ -0,0 +1,272 @@
+package dataprovider
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gbolo/protego/audit"
+	"github.com/spf13/viper"
+)
+
+// testProviders returns an empty provider of every kind, keyed by name
+func testProviders(t *testing.T) map[string]Provider {
+	t.Helper()
+	viper.Set("db.bolt.file", filepath.Join(t.TempDir(), "protego.db"))
+	bolt, err := NewBoltProvider()
+	if err != nil {
+		t.Fatalf("unable to create bolt provider: %v", err)
+	}
+	t.Cleanup(func() { bolt.Close() })
+	memory, err := NewMemoryProvider()
+	if err != nil {
+		t.Fatalf("unable to create memory provider: %v", err)
+	}
+	return map[string]Provider{"bolt": &bolt, "memory": &memory}
+}
+
+// offline commands open the data provider defined by db.provider, like the server
+func TestNewProviderFromConfig(t *testing.T) {
+	t.Cleanup(func() { viper.Set("db.provider", "") })
+	viper.Set("db.provider", "nosql")
+	if _, err := NewProviderFromConfig(); err == nil {
+		t.Fatal("expected an unknown db.provider to be refused")
+	}
+
+	viper.Set("db.provider", "Bolt")
+	viper.Set("db.bolt.file", filepath.Join(t.TempDir(), "protego.db"))
+	p, err := NewProviderFromConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := p.(*InstrumentedProvider); !ok {
+		t.Fatalf("expected an instrumented provider, got %T", p)
+	}
+	u, err := NewUser("secret123", "offline")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = p.AddUser(u); err != nil {
+		t.Fatal(err)
+	}
+	if err = p.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// the changes are found once the database is opened again (by the server)
+	if p, err = NewProviderFromConfig(); err != nil {
+		t.Fatal(err)
+	}
+	defer p.Close()
+	if found, err := p.GetUser(u.ID); err != nil || found == nil {
+		t.Fatalf("expected the user to be stored in the bolt database, got %v (%v)", found, err)
+	}
+}
+
+func TestPruneAuditEvents(t *testing.T) {
+	now := time.Now().UTC()
+	for name, p := range testProviders(t) {
+		t.Run(name, func(t *testing.T) {
+			for i := 5; i > 0; i-- {
+				e := audit.Event{Type: audit.EventChallenge, Timestamp: now.Add(-time.Duration(i) * time.Hour)}
+				if err := p.AddAuditEvent(&e); err != nil {
+					t.Fatal(err)
+				}
+			}
+			count := func() int {
+				events, err := p.GetAuditEvents(&audit.Filter{})
+				if err != nil {
+					t.Fatal(err)
+				}
+				return len(events)
+			}
+
+			// no age limit and no max events keeps everything
+			if err := p.PruneAuditEvents(time.Time{}, 0); err != nil {
+				t.Fatal(err)
+			}
+			if n := count(); n != 5 {
+				t.Fatalf("expected 5 events without limits, got %d", n)
+			}
+			// no age limit, only max events
+			if err := p.PruneAuditEvents(time.Time{}, 4); err != nil {
+				t.Fatal(err)
+			}
+			if n := count(); n != 4 {
+				t.Fatalf("expected 4 events with max_events 4, got %d", n)
+			}
+			// events older than 150 minutes are removed
+			if err := p.PruneAuditEvents(now.Add(-150*time.Minute), 0); err != nil {
+				t.Fatal(err)
+			}
+			if n := count(); n != 2 {
+				t.Fatalf("expected 2 events newer than the cutoff, got %d", n)
+			}
+		})
+	}
+}
+
+func TestFindDenyEntry(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
+	for name, p := range testProviders(t) {
+		t.Run(name, func(t *testing.T) {
+			for _, entry := range []DenyEntry{
+				{CIDR: "10.0.0.0/8", Reason: "wide"},
+				{CIDR: "10.1.0.0/16", Reason: "narrow"},
+				{CIDR: "10.1.2.0/24", Reason: "expired", ExpiresAt: &past},
+				{CIDR: "2001:db8::/32", Reason: "v6"},
+			} {
+				entry := entry
+				if err := p.AddDenyEntry(&entry); err != nil {
+					t.Fatal(err)
+				}
+			}
+			expect := func(ip, reason string) {
+				t.Helper()
+				entry, err := p.FindDenyEntry(ip)
+				switch {
+				case err != nil:
+					t.Fatal(err)
+				case reason == "" && entry != nil:
+					t.Fatalf("expected %s not to be blocked, got %s", ip, entry.CIDR)
+				case reason != "" && (entry == nil || entry.Reason != reason):
+					t.Fatalf("expected %s to be blocked by the %s entry, got %v", ip, reason, entry)
+				}
+			}
+
+			expect("10.9.0.1", "wide")
+			expect("10.1.9.1", "narrow")
+			// an expired entry does not hide the ranges containing it
+			expect("10.1.2.3", "narrow")
+			expect("::ffff:10.1.9.1", "narrow")
+			expect("2001:db8::1", "v6")
+			expect("192.0.2.1", "")
+			expect("invalid", "")
+
+			// the expired entry is replaced, and the new entry is found
+			if err := p.AddDenyEntry(&DenyEntry{CIDR: "10.1.2.0/24", Reason: "replaced"}); err != nil {
+				t.Fatal(err)
+			}
+			expect("10.1.2.3", "replaced")
+			if err := p.AddDenyEntry(&DenyEntry{CIDR: "10.1.2.0/24"}); err != ErrDenyEntryExists {
+				t.Fatalf("expected %v, got %v", ErrDenyEntryExists, err)
+			}
+			if removed, err := p.RemoveDenyEntry("10.1.0.0/16"); err != nil || removed.Reason != "narrow" {
+				t.Fatalf("expected the narrow entry to be removed, got %v (%v)", removed, err)
+			}
+			expect("10.1.9.1", "wide")
+			if _, err := p.RemoveDenyEntry("10.1.0.0/16"); err != ErrDenyEntryNotFound {
+				t.Fatalf("expected %v, got %v", ErrDenyEntryNotFound, err)
+			}
+
+			// the read cache of the bolt provider is loaded from the database
+			if b, ok := p.(*BoltProvider); ok {
+				if err := b.Close(); err != nil {
+					t.Fatal(err)
+				}
+				if err := b.InitializeDatabase(); err != nil {
+					t.Fatal(err)
+				}
+				expect("10.1.2.3", "replaced")
+				expect("10.1.9.1", "wide")
+			}
+		})
+	}
+}
+
+func TestFindToken(t *testing.T) {
+	for name, p := range testProviders(t) {
+		t.Run(name, func(t *testing.T) {
+			token, secret, err := NewToken("ci", []string{ScopeUsersRead}, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			other, _, err := NewToken("other", []string{ScopeAuditRead}, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, tk := range []*Token{token, other} {
+				if err = p.AddToken(tk); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			found, err := p.FindToken(secret)
+			if err != nil || found == nil || found.Name != "ci" {
+				t.Fatalf("expected to find token ci, got %v (%v)", found, err)
+			}
+			if found, _ = p.FindToken(secret + "0"); found != nil {
+				t.Fatalf("expected no token for a wrong secret, got %s", found.Name)
+			}
+			if found, _ = p.FindToken(""); found != nil {
+				t.Fatalf("expected no token for an empty secret, got %s", found.Name)
+			}
+
+			// a removed token is no longer found
+			if err = p.RemoveToken("ci"); err != nil {
+				t.Fatal(err)
+			}
+			if found, _ = p.FindToken(secret); found != nil {
+				t.Fatalf("expected a removed token not to be found, got %s", found.Name)
+			}
+		})
+	}
+}
+
+func TestAddUserIp(t *testing.T) {
+	for name, p := range testProviders(t) {
+		t.Run(name, func(t *testing.T) {
+			users := make([]*User, 2)
+			for i, secret := range []string{"secret123", "secret456"} {
+				u, err := NewUser(secret, "ips")
+				if err != nil {
+					t.Fatal(err)
+				}
+				if err = p.AddUser(u); err != nil {
+					t.Fatal(err)
+				}
+				users[i] = u
+			}
+			u1, u2 := users[0], users[1]
+			u1.MaxIPs = 2
+			if err := p.UpdateUser(u1); err != nil {
+				t.Fatal(err)
+			}
+			add := func(ip, userID string, expectNew bool, expectEvicted ...string) {
+				t.Helper()
+				newIP, evicted, err := p.AddUserIp(ip, &ACL{UserID: userID, Source: ACLSourceChallenge})
+				if err != nil {
+					t.Fatal(err)
+				}
+				if newIP != expectNew {
+					t.Fatalf("expected %s to be new for %s: %v", ip, userID, expectNew)
+				}
+				if len(evicted) != len(expectEvicted) || (len(evicted) > 0 && evicted[0] != expectEvicted[0]) {
+					t.Fatalf("expected %v to be evicted, got %v", expectEvicted, evicted)
+				}
+			}
+
+			add("10.0.0.1", u1.ID, true)
+			add("10.0.0.1", u1.ID, false)
+			add("10.0.0.2", u1.ID, true)
+			// the IP is now whitelisted for another user
+			add("10.0.0.2", u2.ID, true)
+			add("10.0.0.3", u1.ID, true)
+			add("10.0.0.4", u1.ID, true, "10.0.0.1")
+			if acl, err := p.GetACL("10.0.0.1"); err != nil || acl != nil {
+				t.Fatalf("expected the ACL of an evicted IP to be removed, got %v (%v)", acl, err)
+			}
+
+			u1.MaxIPsPolicy = MaxIPsPolicyReject
+			if err := p.UpdateUser(u1); err != nil {
+				t.Fatal(err)
+			}
+			if _, _, err := p.AddUserIp("10.0.0.5", &ACL{UserID: u1.ID, Source: ACLSourceChallenge}); err != ErrMaxIPsReached {
+				t.Fatalf("expected %v, got %v", ErrMaxIPsReached, err)
+			}
+			if acl, _ := p.GetACL("10.0.0.5"); acl != nil {
+				t.Fatal("expected a rejected IP not to be whitelisted")
+			}
+		})
+	}
+}
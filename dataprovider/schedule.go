@@ -0,0 +1,167 @@
+package dataprovider
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+var (
+	// error generated when a user is used before its valid_from date
+	ErrUserNotYetValid = fmt.Errorf("user is not valid yet")
+	// error generated when a user is used after its valid_until date
+	ErrUserExpired = fmt.Errorf("user has expired")
+	// error generated when a user is used outside of its access windows
+	ErrOutsideAccessWindow = fmt.Errorf("user is outside of its access windows")
+)
+
+// abbreviations of the days of the week, as used in access windows
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+// AccessWindow is a recurring period of the week during which a user has access.
+// a window which ends before it starts spans midnight, it then ends on the following day.
+type AccessWindow struct {
+	// The days of the week this window starts on (sun, mon, tue, wed, thu, fri, sat)
+	Days []string `json:"days" example:"mon,tue,wed,thu,fri"`
+	// The time of day this window starts at (HH:MM)
+	Start string `json:"start" example:"08:00"`
+	// The time of day this window ends at (HH:MM)
+	End string `json:"end" example:"18:00"`
+}
+
+// Validate returns an error when the window is invalid. Days are lowercased
+func (w *AccessWindow) Validate() error {
+	if len(w.Days) == 0 {
+		return fmt.Errorf("access window requires at least one day")
+	}
+	for i, day := range w.Days {
+		w.Days[i] = strings.ToLower(day)
+		if _, ok := weekdays[w.Days[i]]; !ok {
+			return fmt.Errorf("invalid day in access window: %q", day)
+		}
+	}
+	start, err := parseTimeOfDay(w.Start)
+	if err != nil {
+		return err
+	}
+	end, err := parseTimeOfDay(w.End)
+	if err != nil {
+		return err
+	}
+	if start == end {
+		return fmt.Errorf("access window must not start and end at the same time")
+	}
+	return nil
+}
+
+// Contains returns true if t is within this window. t must be in the timezone of the window
+func (w *AccessWindow) Contains(t time.Time) bool {
+	start, err := parseTimeOfDay(w.Start)
+	if err != nil {
+		return false
+	}
+	end, err := parseTimeOfDay(w.End)
+	if err != nil {
+		return false
+	}
+	now := time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute
+	yesterday := t.AddDate(0, 0, -1).Weekday()
+	for _, day := range w.Days {
+		weekday := weekdays[day]
+		switch {
+		case start < end && weekday == t.Weekday() && now >= start && now < end:
+			return true
+		// spans midnight: either the first part (today) or the second part (started yesterday)
+		case start > end && weekday == t.Weekday() && now >= start:
+			return true
+		case start > end && weekday == yesterday && now < end:
+			return true
+		}
+	}
+	return false
+}
+
+// String returns the window in the format accepted by ParseAccessWindow
+func (w AccessWindow) String() string {
+	return fmt.Sprintf("%s %s-%s", strings.Join(w.Days, ","), w.Start, w.End)
+}
+
+// ParseAccessWindow parses a window like "mon,tue 08:00-18:00" or "mon-fri 22:00-06:00"
+func ParseAccessWindow(s string) (w AccessWindow, err error) {
+	fields := strings.Fields(s)
+	if len(fields) != 2 {
+		return w, fmt.Errorf("access window must look like 'mon-fri 08:00-18:00': %q", s)
+	}
+	for _, days := range strings.Split(fields[0], ",") {
+		if i := strings.Index(days, "-"); i > 0 {
+			from, okFrom := weekdays[strings.ToLower(days[:i])]
+			to, okTo := weekdays[strings.ToLower(days[i+1:])]
+			if !okFrom || !okTo {
+				return w, fmt.Errorf("invalid range of days in access window: %q", days)
+			}
+			for day := from; ; day = (day + 1) % 7 {
+				w.Days = append(w.Days, weekdayName(day))
+				if day == to {
+					break
+				}
+			}
+			continue
+		}
+		w.Days = append(w.Days, days)
+	}
+	times := strings.Split(fields[1], "-")
+	if len(times) != 2 {
+		return w, fmt.Errorf("access window must look like 'mon-fri 08:00-18:00': %q", s)
+	}
+	w.Start, w.End = times[0], times[1]
+	return w, w.Validate()
+}
+
+// CheckAccessTime returns an error when the user can not be used at t:
+// before ValidFrom, after ValidUntil, or outside of all its access windows
+// (which are evaluated in loc). A user without access windows has access at all times.
+func (u *User) CheckAccessTime(t time.Time, loc *time.Location) error {
+	if u.ValidFrom != nil && t.Before(*u.ValidFrom) {
+		return ErrUserNotYetValid
+	}
+	if u.ValidUntil != nil && !t.Before(*u.ValidUntil) {
+		return ErrUserExpired
+	}
+	if len(u.AccessWindows) == 0 {
+		return nil
+	}
+	local := t.In(loc)
+	for i := range u.AccessWindows {
+		if u.AccessWindows[i].Contains(local) {
+			return nil
+		}
+	}
+	return ErrOutsideAccessWindow
+}
+
+// parseTimeOfDay parses HH:MM into the duration since midnight
+func parseTimeOfDay(s string) (time.Duration, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time of day in access window (must be HH:MM): %q", s)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// weekdayName returns the abbreviation of a day of the week
+func weekdayName(day time.Weekday) string {
+	for name, weekday := range weekdays {
+		if weekday == day {
+			return name
+		}
+	}
+	return ""
+}
@@ -0,0 +1,124 @@
+package dataprovider
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCheckAccessTime(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	validFrom := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	validUntil := time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		windows []string
+		loc     *time.Location
+		// in UTC
+		at       time.Time
+		expected error
+	}{
+		{"no windows", nil, time.UTC, time.Date(2024, 3, 4, 3, 0, 0, 0, time.UTC), nil},
+		{"before valid_from", nil, time.UTC, validFrom.Add(-time.Second), ErrUserNotYetValid},
+		{"at valid_from", nil, time.UTC, validFrom, nil},
+		{"at valid_until", nil, time.UTC, validUntil, ErrUserExpired},
+		{"expiry before windows", []string{"mon-sun 00:00-23:59"}, time.UTC, validUntil.Add(time.Hour), ErrUserExpired},
+		// 2024-03-04 is a monday
+		{"within a window", []string{"mon-fri 08:00-18:00"}, time.UTC, time.Date(2024, 3, 4, 12, 0, 0, 0, time.UTC), nil},
+		{"at the start of a window", []string{"mon-fri 08:00-18:00"}, time.UTC, time.Date(2024, 3, 4, 8, 0, 0, 0, time.UTC), nil},
+		{"at the end of a window", []string{"mon-fri 08:00-18:00"}, time.UTC, time.Date(2024, 3, 4, 18, 0, 0, 0, time.UTC), ErrOutsideAccessWindow},
+		{"on another day", []string{"mon-fri 08:00-18:00"}, time.UTC, time.Date(2024, 3, 9, 12, 0, 0, 0, time.UTC), ErrOutsideAccessWindow},
+		{"any of several windows", []string{"mon 08:00-10:00", "sat 10:00-14:00"}, time.UTC, time.Date(2024, 3, 9, 12, 0, 0, 0, time.UTC), nil},
+		// overnight windows end on the following day
+		{"overnight, before midnight", []string{"fri 22:00-06:00"}, time.UTC, time.Date(2024, 3, 8, 23, 0, 0, 0, time.UTC), nil},
+		{"overnight, after midnight", []string{"fri 22:00-06:00"}, time.UTC, time.Date(2024, 3, 9, 5, 59, 0, 0, time.UTC), nil},
+		{"overnight, at its end", []string{"fri 22:00-06:00"}, time.UTC, time.Date(2024, 3, 9, 6, 0, 0, 0, time.UTC), ErrOutsideAccessWindow},
+		{"overnight, after midnight of its day", []string{"fri 22:00-06:00"}, time.UTC, time.Date(2024, 3, 8, 5, 0, 0, 0, time.UTC), ErrOutsideAccessWindow},
+		{"overnight, across the end of the week", []string{"sat 22:00-06:00"}, time.UTC, time.Date(2024, 3, 10, 1, 0, 0, 0, time.UTC), nil},
+		// windows are evaluated in loc
+		{"timezone, same day", []string{"mon 08:00-18:00"}, newYork, time.Date(2024, 3, 4, 13, 0, 0, 0, time.UTC), nil},
+		{"timezone, outside", []string{"mon 08:00-18:00"}, newYork, time.Date(2024, 3, 4, 23, 30, 0, 0, time.UTC), ErrOutsideAccessWindow},
+		{"timezone, other day than UTC", []string{"tue 08:00-12:00"}, tokyo, time.Date(2024, 3, 4, 23, 30, 0, 0, time.UTC), nil},
+		// daylight saving time: windows follow the wall clock of loc
+		{"DST start, before the gap", []string{"sun 01:00-04:00"}, newYork, time.Date(2024, 3, 10, 6, 30, 0, 0, time.UTC), nil},
+		{"DST start, after the gap", []string{"sun 01:00-04:00"}, newYork, time.Date(2024, 3, 10, 7, 30, 0, 0, time.UTC), nil},
+		{"DST start, wall clock end", []string{"sun 01:00-04:00"}, newYork, time.Date(2024, 3, 10, 8, 0, 0, 0, time.UTC), ErrOutsideAccessWindow},
+		{"DST start, same time in summer", []string{"mon 08:00-18:00"}, newYork, time.Date(2024, 3, 11, 12, 30, 0, 0, time.UTC), nil},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			u := User{ValidFrom: &validFrom, ValidUntil: &validUntil}
+			for _, s := range test.windows {
+				w, err := ParseAccessWindow(s)
+				if err != nil {
+					t.Fatal(err)
+				}
+				u.AccessWindows = append(u.AccessWindows, w)
+			}
+			if err := u.CheckAccessTime(test.at, test.loc); err != test.expected {
+				t.Fatalf("expected %v at %s, got %v", test.expected, test.at.In(test.loc), err)
+			}
+		})
+	}
+}
+
+// the hour repeated when daylight saving time ends is within a window twice
+func TestCheckAccessTimeDSTEnd(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	w, err := ParseAccessWindow("sun 01:00-02:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+	u := User{AccessWindows: []AccessWindow{w}}
+	for _, at := range []time.Time{
+		// 01:30 EDT
+		time.Date(2024, 11, 3, 5, 30, 0, 0, time.UTC),
+		// 01:30 EST
+		time.Date(2024, 11, 3, 6, 30, 0, 0, time.UTC),
+	} {
+		if err = u.CheckAccessTime(at, newYork); err != nil {
+			t.Errorf("expected access at %s, got %v", at.In(newYork), err)
+		}
+	}
+	// 02:30 EST
+	if err = u.CheckAccessTime(time.Date(2024, 11, 3, 7, 30, 0, 0, time.UTC), newYork); err != ErrOutsideAccessWindow {
+		t.Errorf("expected %v after the window, got %v", ErrOutsideAccessWindow, err)
+	}
+}
+
+func TestParseAccessWindow(t *testing.T) {
+	tests := []struct {
+		s     string
+		days  []string
+		valid bool
+	}{
+		{"mon-fri 08:00-18:00", []string{"mon", "tue", "wed", "thu", "fri"}, true},
+		{"fri-mon 22:00-06:00", []string{"fri", "sat", "sun", "mon"}, true},
+		{"Sat,SUN 10:00-14:00", []string{"sat", "sun"}, true},
+		{"mon 08:00-08:00", nil, false},
+		{"mon 8-18", nil, false},
+		{"mon-xyz 08:00-18:00", nil, false},
+		{"someday 08:00-18:00", nil, false},
+		{"08:00-18:00", nil, false},
+	}
+	for _, test := range tests {
+		w, err := ParseAccessWindow(test.s)
+		if (err == nil) != test.valid {
+			t.Errorf("expected %q to be valid: %v, got %v", test.s, test.valid, err)
+			continue
+		}
+		if test.valid && !reflect.DeepEqual(w.Days, test.days) {
+			t.Errorf("expected the days of %q to be %v, got %v", test.s, test.days, w.Days)
+		}
+	}
+}
@@ -0,0 +1,127 @@
+package dataprovider
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// scopes which can be granted to an admin API token
+const (
+	// retrieve users
+	ScopeUsersRead = "users:read"
+	// add, update and remove users
+	ScopeUsersWrite = "users:write"
+	// list and revoke ACLs
+	ScopeACLsManage = "acls:manage"
+	// retrieve audit events
+	ScopeAuditRead = "audit:read"
+	// add, list and remove admin API tokens
+	ScopeTokensManage = "tokens:manage"
+)
+
+// AllScopes lists every scope an admin API token can be granted
+var AllScopes = []string{ScopeUsersRead, ScopeUsersWrite, ScopeACLsManage, ScopeAuditRead, ScopeTokensManage}
+
+// prefix of every admin API token, which makes leaked tokens easy to recognize
+const tokenPrefix = "ptg_"
+
+var (
+	// error generated when attempting to add a token that already exists
+	ErrTokenExists = fmt.Errorf("token already exists")
+	// error generated when attempting to remove a token that does not exist
+	ErrTokenNotFound = fmt.Errorf("token was not found")
+)
+
+// Token is a named admin API token. Only a hash of the token is stored,
+// the token itself is returned once when it is created.
+type Token struct {
+	// A unique name for this Token
+	Name string `json:"name" example:"ci-pipeline"`
+	// SHA256 hash (hex) of the token
+	Hash string `json:"hash,omitempty"`
+	// What this Token is allowed to do
+	Scopes []string `json:"scopes" example:"users:read,audit:read"`
+	// When this Token was created
+	CreatedAt time.Time `json:"created_at" example:"2020-03-22T14:28:00Z"`
+	// After this date, the Token is no longer valid. Never expires when not set
+	ExpiresAt *time.Time `json:"expires_at,omitempty" example:"2021-03-22T14:28:00Z"`
+}
+
+// NewToken generates a random token and returns it, along with a Token holding its hash
+func NewToken(name string, scopes []string, expiresAt *time.Time) (t *Token, secret string, err error) {
+	if !isValidName(name) {
+		return nil, "", fmt.Errorf("token name must be 1 to 64 letters, digits, '_', '.' or '-': %q", name)
+	}
+	if len(scopes) == 0 {
+		return nil, "", fmt.Errorf("a token requires at least one scope")
+	}
+	for _, scope := range scopes {
+		if !isValidScope(scope) {
+			return nil, "", fmt.Errorf("unknown scope: %q", scope)
+		}
+	}
+	if expiresAt != nil && expiresAt.Before(time.Now()) {
+		return nil, "", fmt.Errorf("token expiry must be in the future")
+	}
+	random := make([]byte, 32)
+	if _, err = rand.Read(random); err != nil {
+		return nil, "", err
+	}
+	secret = tokenPrefix + hex.EncodeToString(random)
+	t = &Token{
+		Name:      name,
+		Hash:      hashToken(secret),
+		Scopes:    scopes,
+		CreatedAt: time.Now().UTC(),
+		ExpiresAt: expiresAt,
+	}
+	return
+}
+
+// hashToken returns the SHA256 hash (hex) of a token.
+// tokens are random, so a (fast) unsalted hash is sufficient and bcrypt is not needed
+func hashToken(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+// CheckSecret returns true when secret is this token. The comparison is constant-time
+func (t *Token) CheckSecret(secret string) bool {
+	return subtle.ConstantTimeCompare([]byte(hashToken(secret)), []byte(t.Hash)) == 1
+}
+
+// IsExpired returns true if the token is past its expiry
+func (t *Token) IsExpired() bool {
+	return t.ExpiresAt != nil && t.ExpiresAt.Before(time.Now())
+}
+
+// HasScope returns true if the token was granted scope
+func (t *Token) HasScope(scope string) bool {
+	for _, s := range t.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// Encode this object for storage to db
+func (t *Token) Encode() (encoded []byte) {
+	encoded, _ = json.Marshal(t)
+	return
+}
+
+// isValidScope returns true if scope is one of AllScopes
+func isValidScope(scope string) bool {
+	for _, s := range AllScopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
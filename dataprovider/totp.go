@@ -0,0 +1,92 @@
+package dataprovider
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// TOTP (RFC 6238) parameters, which are the defaults of every authenticator app
+const (
+	totpDigits = 6
+	totpPeriod = 30
+	// codes of the previous and next period are also accepted, to allow for clock drift
+	totpSkew = 1
+)
+
+// error generated when a TOTP code (or a code of a later period) has already been accepted
+var ErrTOTPReplayed = fmt.Errorf("TOTP code has already been used")
+
+// secrets are base32 encoded without padding, as expected by authenticator apps
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateTOTPSecret returns a random TOTP secret (base32)
+func GenerateTOTPSecret() (string, error) {
+	random := make([]byte, 20)
+	if _, err := rand.Read(random); err != nil {
+		return "", err
+	}
+	return totpEncoding.EncodeToString(random), nil
+}
+
+// TOTPURI returns the otpauth URI of a TOTP secret, which authenticator apps can import (as a QR code)
+func TOTPURI(account, secret string) string {
+	label := url.PathEscape("Protego:" + account)
+	return fmt.Sprintf("otpauth://totp/%s?secret=%s&issuer=Protego&digits=%d&period=%d", label, secret, totpDigits, totpPeriod)
+}
+
+// CheckTOTP returns the period of code when it is the TOTP of secret at t (or of an adjacent period).
+// a code must only be accepted once (see Provider.UseTOTPCounter)
+func CheckTOTP(secret, code string, t time.Time) (counter int64, ok bool) {
+	key, err := totpEncoding.DecodeString(strings.ToUpper(secret))
+	if err != nil || len(code) != totpDigits {
+		return 0, false
+	}
+	current := t.Unix() / totpPeriod
+	for i := int64(-totpSkew); i <= totpSkew; i++ {
+		if subtle.ConstantTimeCompare([]byte(hotp(key, uint64(current+i))), []byte(code)) == 1 {
+			counter, ok = current+i, true
+		}
+	}
+	return
+}
+
+// useTOTPCounter records that the TOTP code of period counter was accepted from this User,
+// and returns ErrTOTPReplayed when a code of this period or a later one already was
+func (u *User) useTOTPCounter(counter int64) error {
+	if counter <= u.TOTPCounter {
+		return ErrTOTPReplayed
+	}
+	u.TOTPCounter = counter
+	return nil
+}
+
+// keepTOTPCounter keeps the TOTP counter of existing (the stored version of this User),
+// unless its TOTP secret was changed, so that updating a User does not allow codes to be replayed
+func (u *User) keepTOTPCounter(existing *User) {
+	if u.TOTPSecret == existing.TOTPSecret {
+		u.TOTPCounter = existing.TOTPCounter
+	} else {
+		u.TOTPCounter = 0
+	}
+}
+
+// hotp returns the HOTP (RFC 4226) of key for counter
+func hotp(key []byte, counter uint64) string {
+	msg := make([]byte, 8)
+	binary.BigEndian.PutUint64(msg, counter)
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg)
+	sum := mac.Sum(nil)
+	// dynamic truncation
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	return fmt.Sprintf("%0*d", totpDigits, value%1000000)
+}
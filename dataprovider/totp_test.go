@@ -0,0 +1,88 @@
+package dataprovider
+
+import (
+	"testing"
+	"time"
+)
+
+// the SHA1 secret of the test vectors of RFC 6238 (12345678901234567890), in base32
+const rfc6238Secret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestCheckTOTP(t *testing.T) {
+	tests := []struct {
+		name    string
+		code    string
+		at      int64
+		counter int64
+		ok      bool
+	}{
+		// the last 6 digits of the 8 digit codes of RFC 6238
+		{"first test vector", "287082", 59, 1, true},
+		{"second test vector", "081804", 1111111109, 37037036, true},
+		{"previous period", "081804", 1111111109 + totpPeriod, 37037036, true},
+		{"next period", "081804", 1111111109 - totpPeriod, 37037036, true},
+		{"outside of the skew", "081804", 1111111109 + 2*totpPeriod, 0, false},
+		{"wrong code", "081805", 1111111109, 0, false},
+		{"too short", "81804", 1111111109, 0, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			counter, ok := CheckTOTP(rfc6238Secret, test.code, time.Unix(test.at, 0))
+			if counter != test.counter || ok != test.ok {
+				t.Fatalf("expected period %d (ok: %v), got %d (ok: %v)", test.counter, test.ok, counter, ok)
+			}
+		})
+	}
+	if _, ok := CheckTOTP("not base32!", "287082", time.Unix(59, 0)); ok {
+		t.Fatal("expected an invalid secret to be refused")
+	}
+}
+
+func TestUseTOTPCounter(t *testing.T) {
+	for name, p := range testProviders(t) {
+		t.Run(name, func(t *testing.T) {
+			u, err := NewUser("secret123", "totp")
+			if err != nil {
+				t.Fatal(err)
+			}
+			u.TOTPSecret = rfc6238Secret
+			if err = p.AddUser(u); err != nil {
+				t.Fatal(err)
+			}
+			use := func(counter int64, expected error) {
+				t.Helper()
+				if err := p.UseTOTPCounter(u.ID, counter); err != expected {
+					t.Fatalf("expected period %d to return %v, got %v", counter, expected, err)
+				}
+			}
+
+			use(10, nil)
+			// a code can not be used twice, nor a code of an earlier period
+			use(10, ErrTOTPReplayed)
+			use(9, ErrTOTPReplayed)
+			use(11, nil)
+
+			// updating the user keeps its counter
+			stale, err := p.GetUser(u.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			stale.TOTPCounter = 0
+			if err = p.UpdateUser(stale); err != nil {
+				t.Fatal(err)
+			}
+			use(11, ErrTOTPReplayed)
+
+			// unless its TOTP secret changes
+			stale.TOTPSecret = "JBSWY3DPEHPK3PXP"
+			if err = p.UpdateUser(stale); err != nil {
+				t.Fatal(err)
+			}
+			use(5, nil)
+
+			if err = p.UseTOTPCounter("unknown", 1); err != ErrUserNotFound {
+				t.Fatalf("expected %v for an unknown user, got %v", ErrUserNotFound, err)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	validate "github.com/asaskevich/govalidator"
 )
@@ -17,6 +18,16 @@ var (
 	ErrUserExists = fmt.Errorf("user already exists")
 	// error generated when attempting to modify a user that does not exist
 	ErrUserNotFound = fmt.Errorf("user was not found")
+	// error generated when a challenge would exceed the max IPs of a user with the reject policy
+	ErrMaxIPsReached = fmt.Errorf("user has reached its maximum number of IPs")
+)
+
+// what happens when a user which has reached its max IPs passes a challenge from a new IP
+const (
+	// the ACL of the user's least recently whitelisted IP is removed (default)
+	MaxIPsPolicyEvictOldest = "evict_oldest"
+	// the challenge is rejected
+	MaxIPsPolicyReject = "reject"
 )
 
 // User represents a user/client which is registered by the admin.
@@ -27,23 +38,47 @@ var (
 // it notices that the DNS names resolves differently.
 type User struct {
 	// Determines if this User is enabled
-	Enabled         bool     `json:"enabled" example:"true"`
+	Enabled          bool           `json:"enabled" example:"true"`
 	// A brief description of this User
-	Description     string   `json:"description" example:"Cloud Strife"`
+	Description      string         `json:"description" example:"Cloud Strife"`
+	// When set, this User is notified by email when its access is used from a new IP
+	Email            string         `json:"email,omitempty" example:"cloud@example.com"`
 	// A unique identifier for this User
-	ID              string   `json:"id" example:"5e8848"`
+	ID               string         `json:"id" example:"5e8848"`
 	// This secret is used as a challenge to whitelist a User's IP
-	Secret          string   `json:"secret,omitempty" example:"supersecret"`
+	Secret           string         `json:"secret,omitempty" example:"supersecret"`
 	// Determines if this User is allowed to access ALL resources
-	ACLAllowAll     bool     `json:"acl_allow_all" example:"false"`
+	ACLAllowAll      bool           `json:"acl_allow_all" example:"false"`
 	// A list of hosts (FQDN) this User is allowed to access
-	ACLAllowedHosts []string `json:"acl_allowed_hosts" example:"git.example.com,wiki.example.com"`
+	ACLAllowedHosts  []string       `json:"acl_allowed_hosts" example:"git.example.com,wiki.example.com"`
 	// A list of DNS names that resolve this User's IPs which get whitelisted automatically without a challenge.
-	DNSNames        []string `json:"dns_names" example:"myhome.no-ip.info"`
+	DNSNames         []string       `json:"dns_names" example:"myhome.no-ip.info"`
 	// Represents the number of minutes this User's IP is whitelisted for after a successful challenge
-	TTLMinutes      int      `json:"ttl_minutes" example:"60"`
-	// Keeps track of IPs associated with this User
-	IPs             []string `json:"ip_addresses" example:"1.1.1.1,1.1.1.2"`
+	TTLMinutes       int            `json:"ttl_minutes" example:"60"`
+	// When true, the TTL is extended by TTLMinutes whenever this User's IP is authorized
+	SlidingTTL       bool           `json:"sliding_ttl" example:"false"`
+	// The absolute maximum number of minutes a sliding TTL can extend this User's IP for, since the challenge (0 means unlimited)
+	MaxTTLMinutes    int            `json:"max_ttl_minutes" example:"480"`
+	// The names of the groups this User is a member of
+	Groups           []string       `json:"groups" example:"family"`
+	// When set, a challenge also requires the current TOTP code of this secret (base32)
+	TOTPSecret       string         `json:"totp_secret,omitempty"`
+	// The period of the last TOTP code accepted from this User, codes of this period or an earlier one are refused
+	TOTPCounter      int64          `json:"totp_counter,omitempty"`
+	// This User can not be used before this date
+	ValidFrom        *time.Time     `json:"valid_from,omitempty" example:"2020-03-20T18:00:00Z"`
+	// This User can not be used after this date
+	ValidUntil       *time.Time     `json:"valid_until,omitempty" example:"2020-03-22T18:00:00Z"`
+	// When set, this User only has access during these recurring windows (in the configured timezone)
+	AccessWindows    []AccessWindow `json:"access_windows,omitempty"`
+	// The maximum number of IPs whitelisted by challenges of this User at the same time (0 means unlimited)
+	MaxIPs           int            `json:"max_ips" example:"3"`
+	// What happens when a challenge exceeds MaxIPs: evict_oldest (default) or reject
+	MaxIPsPolicy     string         `json:"max_ips_policy,omitempty" example:"evict_oldest"`
+	// When set, this User only has access from these countries (ISO 3166-1 alpha-2), located with the GeoIP database
+	AllowedCountries []string       `json:"allowed_countries,omitempty" example:"CA,FR"`
+	// Keeps track of IPs whitelisted by challenges of this User, from least to most recently whitelisted
+	IPs              []string       `json:"ip_addresses" example:"1.1.1.1,1.1.1.2"`
 }
 
 // NewUser returns a User with safe defaults
@@ -88,9 +123,56 @@ func DecodeUser(data []byte) (u *User, err error) {
 	u.TTLMinutes = tempUser.TTLMinutes
 	u.DNSNames = tempUser.DNSNames
 	u.Enabled = tempUser.Enabled
+	u.Groups = tempUser.Groups
+	u.ValidFrom = tempUser.ValidFrom
+	u.ValidUntil = tempUser.ValidUntil
+	u.AccessWindows = tempUser.AccessWindows
+	u.SlidingTTL = tempUser.SlidingTTL
+	u.MaxTTLMinutes = tempUser.MaxTTLMinutes
+	u.MaxIPs = tempUser.MaxIPs
+	u.MaxIPsPolicy = tempUser.MaxIPsPolicy
+	u.AllowedCountries = tempUser.AllowedCountries
+	u.Email = tempUser.Email
+	err = u.Validate()
+	if err != nil {
+		u = nil
+	}
 	return
 }
 
+// Validate returns an error when the email, TTL, validity dates, access windows, max IPs or countries are invalid
+func (u *User) Validate() error {
+	if u.Email != "" && !validate.IsEmail(u.Email) {
+		return fmt.Errorf("validation error for email: %s", u.Email)
+	}
+	if u.TTLMinutes < 0 || u.MaxTTLMinutes < 0 {
+		return fmt.Errorf("ttl_minutes and max_ttl_minutes cannot be negative")
+	}
+	if u.SlidingTTL && u.TTLMinutes == 0 {
+		return fmt.Errorf("sliding_ttl requires ttl_minutes")
+	}
+	if u.MaxTTLMinutes > 0 && (!u.SlidingTTL || u.MaxTTLMinutes < u.TTLMinutes) {
+		return fmt.Errorf("max_ttl_minutes requires sliding_ttl, and cannot be less than ttl_minutes")
+	}
+	if u.MaxIPs < 0 {
+		return fmt.Errorf("max_ips cannot be negative")
+	}
+	switch u.MaxIPsPolicy {
+	case "", MaxIPsPolicyEvictOldest, MaxIPsPolicyReject:
+	default:
+		return fmt.Errorf("max_ips_policy must be %s or %s: %q", MaxIPsPolicyEvictOldest, MaxIPsPolicyReject, u.MaxIPsPolicy)
+	}
+	if u.ValidFrom != nil && u.ValidUntil != nil && !u.ValidFrom.Before(*u.ValidUntil) {
+		return fmt.Errorf("valid_from must be before valid_until")
+	}
+	for i := range u.AccessWindows {
+		if err := u.AccessWindows[i].Validate(); err != nil {
+			return err
+		}
+	}
+	return normalizeCountries(u.AllowedCountries)
+}
+
 // Encode this object for storage to db
 func (u *User) Encode() (encoded []byte) {
 	// ignore errors for this call, since I don't think it's really possible here...
@@ -164,9 +246,38 @@ func (u *User) RemoveIp(ip string) {
 	if u.CheckIp(ip) {
 		for index, thisIp := range u.IPs {
 			if strings.EqualFold(thisIp, ip) {
-				u.ACLAllowedHosts = append(u.IPs[:index], u.IPs[index+1:]...)
+				u.IPs = append(u.IPs[:index], u.IPs[index+1:]...)
 				return
 			}
 		}
 	}
 }
+
+// admitIp records ip as the most recently whitelisted IP of this user, while enforcing MaxIPs.
+// it returns the IPs which no longer belong to this user and must be evicted
+func (u *User) admitIp(ip string) (evicted []string, err error) {
+	if u.CheckIp(ip) {
+		// a renewed IP becomes the most recently whitelisted one
+		u.RemoveIp(ip)
+	} else if u.MaxIPs > 0 && len(u.IPs) >= u.MaxIPs {
+		if u.MaxIPsPolicy == MaxIPsPolicyReject {
+			return nil, ErrMaxIPsReached
+		}
+		n := len(u.IPs) - u.MaxIPs + 1
+		evicted = append(evicted, u.IPs[:n]...)
+		u.IPs = append([]string(nil), u.IPs[n:]...)
+	}
+	return evicted, u.AddIp(ip)
+}
+
+// pruneIps forgets the IPs which are no longer whitelisted by a challenge of this user.
+// acl returns the current ACL of an IP, or nil
+func (u *User) pruneIps(acl func(ip string) *ACL) {
+	var ips []string
+	for _, ip := range u.IPs {
+		if a := acl(ip); a != nil && a.isChallengeOf(u.ID) {
+			ips = append(ips, ip)
+		}
+	}
+	u.IPs = ips
+}
@@ -0,0 +1,65 @@
+package dataprovider
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestAdmitIp(t *testing.T) {
+	tests := []struct {
+		name     string
+		maxIPs   int
+		policy   string
+		ips      []string
+		ip       string
+		expected []string
+		evicted  []string
+		err      error
+	}{
+		{"no limit", 0, "", []string{"10.0.0.1", "10.0.0.2"}, "10.0.0.3", []string{"10.0.0.1", "10.0.0.2", "10.0.0.3"}, nil, nil},
+		{"below the limit", 3, MaxIPsPolicyEvictOldest, []string{"10.0.0.1"}, "10.0.0.2", []string{"10.0.0.1", "10.0.0.2"}, nil, nil},
+		{"evicts the oldest", 2, MaxIPsPolicyEvictOldest, []string{"10.0.0.1", "10.0.0.2"}, "10.0.0.3", []string{"10.0.0.2", "10.0.0.3"}, []string{"10.0.0.1"}, nil},
+		{"evicts down to a lowered limit", 1, MaxIPsPolicyEvictOldest, []string{"10.0.0.1", "10.0.0.2", "10.0.0.3"}, "10.0.0.4", []string{"10.0.0.4"}, []string{"10.0.0.1", "10.0.0.2", "10.0.0.3"}, nil},
+		{"evict is the default policy", 1, "", []string{"10.0.0.1"}, "10.0.0.2", []string{"10.0.0.2"}, []string{"10.0.0.1"}, nil},
+		// a renewed IP becomes the most recently whitelisted one, and does not count twice
+		{"renewed IP", 2, MaxIPsPolicyEvictOldest, []string{"10.0.0.1", "10.0.0.2"}, "10.0.0.1", []string{"10.0.0.2", "10.0.0.1"}, nil, nil},
+		{"rejects a new IP", 2, MaxIPsPolicyReject, []string{"10.0.0.1", "10.0.0.2"}, "10.0.0.3", []string{"10.0.0.1", "10.0.0.2"}, nil, ErrMaxIPsReached},
+		{"renewal at the limit with reject", 2, MaxIPsPolicyReject, []string{"10.0.0.1", "10.0.0.2"}, "10.0.0.1", []string{"10.0.0.2", "10.0.0.1"}, nil, nil},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			u := User{MaxIPs: test.maxIPs, MaxIPsPolicy: test.policy, IPs: append([]string(nil), test.ips...)}
+			evicted, err := u.admitIp(test.ip)
+			if err != test.err {
+				t.Fatalf("expected error %v, got %v", test.err, err)
+			}
+			if !reflect.DeepEqual(evicted, test.evicted) {
+				t.Fatalf("expected %v to be evicted, got %v", test.evicted, evicted)
+			}
+			if !reflect.DeepEqual(u.IPs, test.expected) {
+				t.Fatalf("expected IPs %v, got %v", test.expected, u.IPs)
+			}
+		})
+	}
+}
+
+func TestPruneIps(t *testing.T) {
+	past := time.Now().Add(-time.Minute)
+	future := time.Now().Add(time.Hour)
+	acls := map[string]*ACL{
+		"10.0.0.1": {UserID: "u1", Source: ACLSourceChallenge},
+		"10.0.0.2": {UserID: "u1", Source: ACLSourceChallenge, TTL: &future},
+		// expired
+		"10.0.0.3": {UserID: "u1", Source: ACLSourceChallenge, TTL: &past},
+		// whitelisted for another user since
+		"10.0.0.4": {UserID: "u2", Source: ACLSourceChallenge},
+		// replaced by the dynamic DNS name of the user
+		"10.0.0.5": {UserID: "u1", Source: ACLSourceDdns},
+	}
+	u := User{ID: "u1", IPs: []string{"10.0.0.1", "10.0.0.2", "10.0.0.3", "10.0.0.4", "10.0.0.5", "10.0.0.6"}}
+	u.pruneIps(func(ip string) *ACL { return acls[ip] })
+	if expected := []string{"10.0.0.1", "10.0.0.2"}; !reflect.DeepEqual(u.IPs, expected) {
+		t.Fatalf("expected IPs %v, got %v", expected, u.IPs)
+	}
+}
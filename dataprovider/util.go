@@ -3,10 +3,19 @@ package dataprovider
 import (
 	"crypto/sha256"
 	"fmt"
+	"regexp"
 
 	"golang.org/x/crypto/bcrypt"
 )
 
+// names (of tokens, groups and host sets) are used in the audit log and API paths
+var nameRegex = regexp.MustCompile(`^[a-zA-Z0-9_.-]{1,64}$`)
+
+// isValidName returns true if name can be used to identify a token, group or host set
+func isValidName(name string) bool {
+	return nameRegex.MatchString(name)
+}
+
 // hashSecret generates a cryptographic hash (using bcrypt) of a plain secret.
 func hashSecret(secret string) (bcryptHash string, err error) {
 	bytes, err := bcrypt.GenerateFromPassword([]byte(secret), 10)
@@ -1,6 +1,6 @@
 // GENERATED BY THE COMMAND ABOVE; DO NOT EDIT
 // This file was generated by swaggo/swag at
-// 2020-03-22 10:28:00.765142113 -0400 EDT m=+0.036106023
+// 2026-10-19 18:19:46.424715466 +0000 UTC m=+0.082422966
 
 package docs
 
@@ -33,6 +33,160 @@ var doc = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
+        "/acl": {
+            "get": {
+                "description": "get the ACL of every whitelisted IP",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ACL"
+                ],
+                "summary": "Retrieve all ACLs",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer followed by an admin API token or the admin secret",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/server.getACL"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/acl/{ip}": {
+            "delete": {
+                "description": "remove the ACL of an IP. An IP whitelisted by a DNS name is added again while the name resolves to it",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ACL"
+                ],
+                "summary": "Revoke the ACL of an IP",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer followed by an admin API token or the admin secret",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "IP address",
+                        "name": "ip",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/server.getACL"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request: no ACL was found for this IP"
+                    }
+                }
+            }
+        },
+        "/audit": {
+            "get": {
+                "description": "get audit events (newest first) matching all of the provided filters",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Audit"
+                ],
+                "summary": "Retrieve audit events",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer followed by an admin API token or the admin secret",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "only events of this User ID",
+                        "name": "user_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "only events caused by this IP address",
+                        "name": "client_ip",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "authorize",
+                            "challenge",
+                            "admin_auth",
+                            "user_add",
+                            "user_update",
+                            "user_remove",
+                            "acl_revoke",
+                            "db_import",
+                            "token_add",
+                            "token_remove"
+                        ],
+                        "type": "string",
+                        "description": "only events of this type",
+                        "name": "event_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "only events which occurred at or after this time (RFC3339)",
+                        "name": "since",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "only events which occurred before this time (RFC3339)",
+                        "name": "until",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "maximum number of events to return (default 100, max 1000)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/audit.Event"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "bad request: a filter is invalid"
+                    }
+                }
+            }
+        },
         "/authorize": {
             "get": {
                 "description": "Configure NGINX auth_request to this endpoint",
@@ -89,6 +243,12 @@ var doc = `{
                         "name": "User-Secret",
                         "in": "header",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Current TOTP code, required when the user is enrolled in TOTP",
+                        "name": "User-TOTP",
+                        "in": "header"
                     }
                 ],
                 "responses": {
@@ -101,27 +261,30 @@ var doc = `{
                     "401": {
                         "description": "unauthorized: the user secret is incorrect or the user is disabled"
                     },
+                    "403": {
+                        "description": "forbidden: the value of X-Real-IP is blocked, or located in a country the user is not allowed in"
+                    },
                     "500": {
                         "description": "server could not process the request"
                     }
                 }
             }
         },
-        "/user": {
+        "/deny": {
             "get": {
-                "description": "get all Users",
+                "description": "get all DenyEntries which have not expired",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Deny"
                 ],
-                "summary": "Retrieve all Users",
+                "summary": "Retrieve all blocked IP addresses and ranges",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Admin Secret",
-                        "name": "Admin-Secret",
+                        "description": "Bearer followed by an admin API token or the admin secret",
+                        "name": "Authorization",
                         "in": "header",
                         "required": true
                     }
@@ -132,14 +295,14 @@ var doc = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/server.getUser"
+                                "$ref": "#/definitions/dataprovider.DenyEntry"
                             }
                         }
                     }
                 }
             },
             "post": {
-                "description": "add by json user",
+                "description": "block an IP address or CIDR. It takes precedence over every ACL, and clients within it can not pass a challenge",
                 "consumes": [
                     "application/json"
                 ],
@@ -147,24 +310,24 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Deny"
                 ],
-                "summary": "Add a new User",
+                "summary": "Block an IP address or range",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Admin Secret",
-                        "name": "Admin-Secret",
+                        "description": "Bearer followed by an admin API token or the admin secret",
+                        "name": "Authorization",
                         "in": "header",
                         "required": true
                     },
                     {
-                        "description": "Add User",
-                        "name": "user",
+                        "description": "Add DenyEntry",
+                        "name": "entry",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/server.addUser"
+                            "$ref": "#/definitions/server.addDenyEntry"
                         }
                     }
                 ],
@@ -172,34 +335,159 @@ var doc = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/server.getUser"
+                            "$ref": "#/definitions/dataprovider.DenyEntry"
                         }
+                    },
+                    "400": {
+                        "description": "bad request: the IP address or CIDR is invalid"
+                    },
+                    "409": {
+                        "description": "this range is already blocked"
                     }
                 }
             }
         },
-        "/user/{id}": {
+        "/deny/{cidr}": {
+            "delete": {
+                "description": "remove a DenyEntry by its IP address or CIDR (like /deny/203.0.113.0/24)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Deny"
+                ],
+                "summary": "Unblock an IP address or range",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer followed by an admin API token or the admin secret",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "IP address or CIDR",
+                        "name": "cidr",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dataprovider.DenyEntry"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request: the IP address or CIDR is invalid"
+                    },
+                    "404": {
+                        "description": "the IP address or CIDR is not blocked"
+                    }
+                }
+            }
+        },
+        "/group": {
             "get": {
-                "description": "get User by ID",
+                "description": "get all Groups",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Group"
                 ],
-                "summary": "Retrieve a User based on provided ID",
+                "summary": "Retrieve all Groups",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer followed by an admin API token or the admin secret",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dataprovider.Group"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "add a group of users, which is granted access to host sets",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Group"
+                ],
+                "summary": "Add a new Group",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer followed by an admin API token or the admin secret",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Add Group",
+                        "name": "group",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dataprovider.Group"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dataprovider.Group"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request: the group is invalid or a host set does not exist"
+                    },
+                    "409": {
+                        "description": "a group with this name already exists"
+                    }
+                }
+            }
+        },
+        "/group/{name}": {
+            "get": {
+                "description": "get Group by name",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Group"
+                ],
+                "summary": "Retrieve a Group based on provided name",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Admin Secret",
-                        "name": "Admin-Secret",
+                        "description": "Bearer followed by an admin API token or the admin secret",
+                        "name": "Authorization",
                         "in": "header",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "User ID",
-                        "name": "id",
+                        "description": "Group name",
+                        "name": "name",
                         "in": "path",
                         "required": true
                     }
@@ -208,13 +496,13 @@ var doc = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/server.getUser"
+                            "$ref": "#/definitions/dataprovider.Group"
                         }
                     }
                 }
             },
             "put": {
-                "description": "update by json user",
+                "description": "update a group, the change applies to IPs which are already whitelisted",
                 "consumes": [
                     "application/json"
                 ],
@@ -222,24 +510,31 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Group"
                 ],
-                "summary": "Update an existing User",
+                "summary": "Update an existing Group",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Admin Secret",
-                        "name": "Admin-Secret",
+                        "description": "Bearer followed by an admin API token or the admin secret",
+                        "name": "Authorization",
                         "in": "header",
                         "required": true
                     },
                     {
-                        "description": "Update User",
-                        "name": "user",
+                        "type": "string",
+                        "description": "Group name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update Group",
+                        "name": "group",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/server.modifyUser"
+                            "$ref": "#/definitions/dataprovider.Group"
                         }
                     }
                 ],
@@ -247,32 +542,35 @@ var doc = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/server.getUser"
+                            "$ref": "#/definitions/dataprovider.Group"
                         }
+                    },
+                    "400": {
+                        "description": "bad request: the group is invalid or was not found"
                     }
                 }
             },
             "delete": {
-                "description": "remove a User by ID",
+                "description": "remove a Group by name, its members are removed from it",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Group"
                 ],
-                "summary": "Remove a User based on provided ID",
+                "summary": "Remove a Group based on provided name",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Admin Secret",
-                        "name": "Admin-Secret",
+                        "description": "Bearer followed by an admin API token or the admin secret",
+                        "name": "Authorization",
                         "in": "header",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "User ID",
-                        "name": "id",
+                        "description": "Group name",
+                        "name": "name",
                         "in": "path",
                         "required": true
                     }
@@ -281,38 +579,1058 @@ var doc = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/server.getUser"
+                            "$ref": "#/definitions/dataprovider.Group"
                         }
                     }
                 }
             }
         },
-        "/version": {
+        "/hostpolicy": {
             "get": {
-                "description": "Retrieve the version information of this Protego server",
+                "description": "get all HostPolicies",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Version"
+                    "HostPolicy"
+                ],
+                "summary": "Retrieve all HostPolicies",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer followed by an admin API token or the admin secret",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
                 ],
-                "summary": "Version information",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/server.version"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dataprovider.HostPolicy"
+                            }
                         }
                     }
                 }
-            }
-        }
-    },
-    "definitions": {
-        "server.addUser": {
-            "type": "object",
-            "properties": {
-                "acl_allow_all": {
+            },
+            "post": {
+                "description": "add a policy which restricts access to a host further than the permissions of users",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "HostPolicy"
+                ],
+                "summary": "Add a new HostPolicy",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer followed by an admin API token or the admin secret",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Add HostPolicy",
+                        "name": "hostpolicy",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dataprovider.HostPolicy"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dataprovider.HostPolicy"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request: the host policy is invalid or a group does not exist"
+                    },
+                    "409": {
+                        "description": "a policy for this host already exists"
+                    }
+                }
+            }
+        },
+        "/hostpolicy/{host}": {
+            "get": {
+                "description": "get HostPolicy by host",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "HostPolicy"
+                ],
+                "summary": "Retrieve a HostPolicy based on provided host",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer followed by an admin API token or the admin secret",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Host (FQDN)",
+                        "name": "host",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dataprovider.HostPolicy"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "update a host policy, the change applies to IPs which are already whitelisted",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "HostPolicy"
+                ],
+                "summary": "Update an existing HostPolicy",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer followed by an admin API token or the admin secret",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Host (FQDN)",
+                        "name": "host",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update HostPolicy",
+                        "name": "hostpolicy",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dataprovider.HostPolicy"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dataprovider.HostPolicy"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request: the host policy is invalid or was not found"
+                    }
+                }
+            },
+            "delete": {
+                "description": "remove a HostPolicy by host, access to the host is then only restricted by the permissions of users",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "HostPolicy"
+                ],
+                "summary": "Remove a HostPolicy based on provided host",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer followed by an admin API token or the admin secret",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Host (FQDN)",
+                        "name": "host",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dataprovider.HostPolicy"
+                        }
+                    }
+                }
+            }
+        },
+        "/hostset": {
+            "get": {
+                "description": "get all HostSets",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "HostSet"
+                ],
+                "summary": "Retrieve all HostSets",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer followed by an admin API token or the admin secret",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dataprovider.HostSet"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "add a named list of hosts, which can be granted to groups",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "HostSet"
+                ],
+                "summary": "Add a new HostSet",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer followed by an admin API token or the admin secret",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Add HostSet",
+                        "name": "hostset",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dataprovider.HostSet"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dataprovider.HostSet"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request: the host set is invalid"
+                    },
+                    "409": {
+                        "description": "a host set with this name already exists"
+                    }
+                }
+            }
+        },
+        "/hostset/{name}": {
+            "get": {
+                "description": "get HostSet by name",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "HostSet"
+                ],
+                "summary": "Retrieve a HostSet based on provided name",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer followed by an admin API token or the admin secret",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "HostSet name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dataprovider.HostSet"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "update a host set, the change applies to IPs which are already whitelisted",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "HostSet"
+                ],
+                "summary": "Update an existing HostSet",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer followed by an admin API token or the admin secret",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "HostSet name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update HostSet",
+                        "name": "hostset",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dataprovider.HostSet"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dataprovider.HostSet"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request: the host set is invalid or was not found"
+                    }
+                }
+            },
+            "delete": {
+                "description": "remove a HostSet by name, it is no longer granted to any group",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "HostSet"
+                ],
+                "summary": "Remove a HostSet based on provided name",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer followed by an admin API token or the admin secret",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "HostSet name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dataprovider.HostSet"
+                        }
+                    }
+                }
+            }
+        },
+        "/token": {
+            "get": {
+                "description": "get all admin API tokens, without the tokens themselves",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Token"
+                ],
+                "summary": "Retrieve all admin API tokens",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer followed by an admin API token or the admin secret",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/server.getToken"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "the token is only returned in this response, only its hash is stored",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Token"
+                ],
+                "summary": "Add a new admin API token",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer followed by an admin API token or the admin secret",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Add Token",
+                        "name": "token",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/server.addToken"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/server.tokenCreated"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request: the token is invalid"
+                    },
+                    "403": {
+                        "description": "the token would be granted more than the admin API token creating it"
+                    },
+                    "409": {
+                        "description": "a token with this name already exists"
+                    }
+                }
+            }
+        },
+        "/token/{name}": {
+            "delete": {
+                "description": "remove an admin API token by name, it can no longer be used afterwards",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Token"
+                ],
+                "summary": "Remove an admin API token",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer followed by an admin API token or the admin secret",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Token name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/server.getToken"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request: the token was not found"
+                    }
+                }
+            }
+        },
+        "/user": {
+            "get": {
+                "description": "get all Users",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Retrieve all Users",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer followed by an admin API token or the admin secret",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/server.getUser"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "add by json user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Add a new User",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer followed by an admin API token or the admin secret",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Add User",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/server.addUser"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/server.getUser"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/{id}": {
+            "get": {
+                "description": "get User by ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Retrieve a User based on provided ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer followed by an admin API token or the admin secret",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/server.getUser"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "update by json user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Update an existing User",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer followed by an admin API token or the admin secret",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Update User",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/server.modifyUser"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/server.getUser"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "remove a User by ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Remove a User based on provided ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer followed by an admin API token or the admin secret",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/server.getUser"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/{id}/totp": {
+            "post": {
+                "description": "generate a new TOTP secret for a User, which then also requires its current TOTP code (header User-TOTP) to pass a challenge. The secret is only returned once",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Enroll a User in TOTP",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer followed by an admin API token or the admin secret",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/server.totpEnrollment"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "remove the TOTP secret of a User, a challenge then only requires its secret",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Remove the TOTP of a User",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer followed by an admin API token or the admin secret",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/server.getUser"
+                        }
+                    }
+                }
+            }
+        },
+        "/version": {
+            "get": {
+                "description": "Retrieve the version information of this Protego server",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Version"
+                ],
+                "summary": "Version information",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/server.version"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "audit.Event": {
+            "type": "object",
+            "properties": {
+                "actor": {
+                    "description": "the identity of the admin which performed the action",
+                    "type": "string"
+                },
+                "client_ip": {
+                    "description": "the IP address of the client which caused the event",
+                    "type": "string"
+                },
+                "country": {
+                    "description": "the country (ISO 3166-1 alpha-2) the client is located in, when it was looked up",
+                    "type": "string"
+                },
+                "decision": {
+                    "description": "the decision taken (allow or deny)",
+                    "type": "string"
+                },
+                "event_type": {
+                    "description": "the type of event",
+                    "type": "string"
+                },
+                "host": {
+                    "description": "the host (FQDN) the client requested",
+                    "type": "string"
+                },
+                "reason": {
+                    "description": "the reason behind the decision",
+                    "type": "string"
+                },
+                "target": {
+                    "description": "what an action changed, when it is not a user (like a revoked or evicted IP, or a token name)",
+                    "type": "string"
+                },
+                "timestamp": {
+                    "description": "when the event occurred",
+                    "type": "string"
+                },
+                "user_id": {
+                    "description": "the user affected by the event",
+                    "type": "string"
+                },
+                "version": {
+                    "description": "the version of this schema",
+                    "type": "integer"
+                }
+            }
+        },
+        "dataprovider.AccessWindow": {
+            "type": "object",
+            "properties": {
+                "days": {
+                    "description": "The days of the week this window starts on (sun, mon, tue, wed, thu, fri, sat)",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "mon",
+                        "tue",
+                        "wed",
+                        "thu",
+                        "fri"
+                    ]
+                },
+                "end": {
+                    "description": "The time of day this window ends at (HH:MM)",
+                    "type": "string",
+                    "example": "18:00"
+                },
+                "start": {
+                    "description": "The time of day this window starts at (HH:MM)",
+                    "type": "string",
+                    "example": "08:00"
+                }
+            }
+        },
+        "dataprovider.DenyEntry": {
+            "type": "object",
+            "properties": {
+                "cidr": {
+                    "description": "The blocked range in CIDR notation. A single IP address is stored as a /32 (or /128)",
+                    "type": "string",
+                    "example": "203.0.113.0/24"
+                },
+                "created_at": {
+                    "description": "When this DenyEntry was created",
+                    "type": "string",
+                    "example": "2020-03-22T14:28:00Z"
+                },
+                "expires_at": {
+                    "description": "After this date, the range is no longer blocked. Never expires when not set",
+                    "type": "string",
+                    "example": "2020-03-23T14:28:00Z"
+                },
+                "reason": {
+                    "description": "Why this range is blocked",
+                    "type": "string",
+                    "example": "credential stuffing"
+                }
+            }
+        },
+        "dataprovider.Group": {
+            "type": "object",
+            "properties": {
+                "allow_all": {
+                    "description": "Determines if members of this Group are allowed to access ALL resources",
+                    "type": "boolean",
+                    "example": false
+                },
+                "description": {
+                    "description": "A brief description of this Group",
+                    "type": "string",
+                    "example": "everyone at home"
+                },
+                "host_sets": {
+                    "description": "The names of the host sets members of this Group are allowed to access",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "media",
+                        "wiki"
+                    ]
+                },
+                "name": {
+                    "description": "A unique name for this Group",
+                    "type": "string",
+                    "example": "family"
+                }
+            }
+        },
+        "dataprovider.HostPolicy": {
+            "type": "object",
+            "properties": {
+                "allowed_countries": {
+                    "description": "When set, this host can only be accessed from these countries (ISO 3166-1 alpha-2), located with the GeoIP database",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "CA",
+                        "FR"
+                    ]
+                },
+                "allowed_groups": {
+                    "description": "When set, only members of these groups can access this host",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "admins"
+                    ]
+                },
+                "description": {
+                    "description": "A brief description of this HostPolicy",
+                    "type": "string",
+                    "example": "NAS admin panel"
+                },
+                "host": {
+                    "description": "The host (FQDN) this HostPolicy applies to",
+                    "type": "string",
+                    "example": "nas.example.com"
+                },
+                "max_ttl_minutes": {
+                    "description": "Access is denied once the challenge of an IP is older than this many minutes (0 means no limit)",
+                    "type": "integer",
+                    "example": 15
+                },
+                "require_totp": {
+                    "description": "Access requires a challenge which included a valid TOTP code",
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "dataprovider.HostSet": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "description": "A brief description of this HostSet",
+                    "type": "string",
+                    "example": "streaming services"
+                },
+                "hosts": {
+                    "description": "A list of hosts (FQDN)",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "plex.example.com",
+                        "jellyfin.example.com"
+                    ]
+                },
+                "name": {
+                    "description": "A unique name for this HostSet",
+                    "type": "string",
+                    "example": "media"
+                }
+            }
+        },
+        "server.addDenyEntry": {
+            "type": "object",
+            "properties": {
+                "cidr": {
+                    "description": "The IP address or range (CIDR) to block",
+                    "type": "string",
+                    "example": "203.0.113.0/24"
+                },
+                "expires_at": {
+                    "description": "After this date, the range is no longer blocked. Never expires when not set",
+                    "type": "string",
+                    "example": "2020-03-23T14:28:00Z"
+                },
+                "reason": {
+                    "description": "Why this range is blocked",
+                    "type": "string",
+                    "example": "credential stuffing"
+                }
+            }
+        },
+        "server.addToken": {
+            "type": "object",
+            "properties": {
+                "expires_at": {
+                    "description": "After this date, the Token is no longer valid. Never expires when not set",
+                    "type": "string",
+                    "example": "2021-03-22T14:28:00Z"
+                },
+                "name": {
+                    "description": "A unique name for this Token",
+                    "type": "string",
+                    "example": "ci-pipeline"
+                },
+                "scopes": {
+                    "description": "What this Token is allowed to do: users:read, users:write, acls:manage, audit:read, tokens:manage",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "users:read",
+                        "audit:read"
+                    ]
+                }
+            }
+        },
+        "server.addUser": {
+            "type": "object",
+            "properties": {
+                "access_windows": {
+                    "description": "When set, this User only has access during these recurring windows (in the configured timezone)",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dataprovider.AccessWindow"
+                    }
+                },
+                "acl_allow_all": {
                     "description": "Determines if this User is allowed to access ALL resources",
                     "type": "boolean",
                     "example": false
@@ -328,6 +1646,17 @@ var doc = `{
                         "wiki.example.com"
                     ]
                 },
+                "allowed_countries": {
+                    "description": "When set, this User only has access from these countries (ISO 3166-1 alpha-2), located with the GeoIP database",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "CA",
+                        "FR"
+                    ]
+                },
                 "description": {
                     "description": "A brief description of this User",
                     "type": "string",
@@ -343,26 +1672,174 @@ var doc = `{
                         "myhome.no-ip.info"
                     ]
                 },
+                "email": {
+                    "description": "When set, this User is notified by email when its access is used from a new IP",
+                    "type": "string",
+                    "example": "cloud@example.com"
+                },
                 "enabled": {
                     "description": "Determines if this User is enabled",
                     "type": "boolean",
                     "example": true
                 },
+                "groups": {
+                    "description": "The names of the groups this User is a member of",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "family"
+                    ]
+                },
+                "max_ips": {
+                    "description": "The maximum number of IPs whitelisted by challenges of this User at the same time (0 means unlimited)",
+                    "type": "integer",
+                    "example": 3
+                },
+                "max_ips_policy": {
+                    "description": "What happens when a challenge exceeds MaxIPs: evict_oldest (default) or reject",
+                    "type": "string",
+                    "example": "evict_oldest"
+                },
+                "max_ttl_minutes": {
+                    "description": "The absolute maximum number of minutes a sliding TTL can extend this User's IP for, since the challenge (0 means unlimited)",
+                    "type": "integer",
+                    "example": 480
+                },
                 "secret": {
                     "description": "This secret is used as a challenge to whitelist a User's IP",
                     "type": "string",
                     "example": "supersecret"
                 },
+                "sliding_ttl": {
+                    "description": "When true, the TTL is extended by TTLMinutes whenever this User's IP is authorized",
+                    "type": "boolean",
+                    "example": false
+                },
                 "ttl_minutes": {
                     "description": "Represents the number of minutes this User's IP is whitelisted for after a successful challenge",
                     "type": "integer",
                     "example": 60
+                },
+                "valid_from": {
+                    "description": "This User can not be used before this date",
+                    "type": "string",
+                    "example": "2020-03-20T18:00:00Z"
+                },
+                "valid_until": {
+                    "description": "This User can not be used after this date",
+                    "type": "string",
+                    "example": "2020-03-22T18:00:00Z"
+                }
+            }
+        },
+        "server.getACL": {
+            "type": "object",
+            "properties": {
+                "allow_all": {
+                    "description": "when true, client is allowed to access everything",
+                    "type": "boolean"
+                },
+                "allowed_hosts": {
+                    "description": "represents a list of host headers the client is allowed to access",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "challenged_at": {
+                    "description": "when the challenge which created this ACL occurred (challenge only)",
+                    "type": "string"
+                },
+                "dns_name": {
+                    "description": "the DNS name which resolved to this IP (ddns only)",
+                    "type": "string"
+                },
+                "ip_address": {
+                    "description": "The whitelisted IP address",
+                    "type": "string",
+                    "example": "1.1.1.1"
+                },
+                "max_ttl": {
+                    "description": "a sliding TTL is never extended past this date",
+                    "type": "string"
+                },
+                "sliding_minutes": {
+                    "description": "when set, the TTL is extended to this many minutes after each authorized request",
+                    "type": "integer"
+                },
+                "source": {
+                    "description": "what created this ACL (challenge or ddns)",
+                    "type": "string"
+                },
+                "totp_verified": {
+                    "description": "true when the challenge which created this ACL included a valid TOTP code",
+                    "type": "boolean"
+                },
+                "ttl": {
+                    "description": "after this date, the ACL is no longer valid",
+                    "type": "string"
+                },
+                "user_id": {
+                    "description": "the ID of the user this ACL was created for. When set, access is evaluated\nagainst the user's current permissions, AllowAll and AllowedHosts are only a snapshot",
+                    "type": "string"
+                },
+                "user_ids": {
+                    "description": "the IDs of every user this ACL was created for, when there is more than one (ddns only):\nthe DNS names of these users resolve to the same IP. Access is then evaluated against\nthe permissions of each of them",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "server.getToken": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "When this Token was created",
+                    "type": "string",
+                    "example": "2020-03-22T14:28:00Z"
+                },
+                "expired": {
+                    "description": "True when this Token is past its expiry",
+                    "type": "boolean",
+                    "example": false
+                },
+                "expires_at": {
+                    "description": "After this date, the Token is no longer valid",
+                    "type": "string",
+                    "example": "2021-03-22T14:28:00Z"
+                },
+                "name": {
+                    "description": "A unique name for this Token",
+                    "type": "string",
+                    "example": "ci-pipeline"
+                },
+                "scopes": {
+                    "description": "What this Token is allowed to do",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "users:read",
+                        "audit:read"
+                    ]
                 }
             }
         },
         "server.getUser": {
             "type": "object",
             "properties": {
+                "access_windows": {
+                    "description": "When set, this User only has access during these recurring windows (in the configured timezone)",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dataprovider.AccessWindow"
+                    }
+                },
                 "acl_allow_all": {
                     "description": "Determines if this User is allowed to access ALL resources",
                     "type": "boolean",
@@ -379,6 +1856,17 @@ var doc = `{
                         "wiki.example.com"
                     ]
                 },
+                "allowed_countries": {
+                    "description": "When set, this User only has access from these countries (ISO 3166-1 alpha-2), located with the GeoIP database",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "CA",
+                        "FR"
+                    ]
+                },
                 "description": {
                     "description": "A brief description of this User",
                     "type": "string",
@@ -394,26 +1882,114 @@ var doc = `{
                         "myhome.no-ip.info"
                     ]
                 },
+                "email": {
+                    "description": "When set, this User is notified by email when its access is used from a new IP",
+                    "type": "string",
+                    "example": "cloud@example.com"
+                },
                 "enabled": {
                     "description": "Determines if this User is enabled",
                     "type": "boolean",
                     "example": true
                 },
+                "groups": {
+                    "description": "The names of the groups this User is a member of",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "family"
+                    ]
+                },
                 "id": {
                     "description": "A unique identifier for this User",
                     "type": "string",
                     "example": "5e8848"
                 },
+                "ip_addresses": {
+                    "description": "IPs whitelisted by challenges of this User, from least to most recently whitelisted",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "1.1.1.1",
+                        "1.1.1.2"
+                    ]
+                },
+                "last_seen": {
+                    "description": "The last successful challenge of this User (based on audit history)",
+                    "type": "object",
+                    "$ref": "#/definitions/server.lastSeen"
+                },
+                "max_ips": {
+                    "description": "The maximum number of IPs whitelisted by challenges of this User at the same time (0 means unlimited)",
+                    "type": "integer",
+                    "example": 3
+                },
+                "max_ips_policy": {
+                    "description": "What happens when a challenge exceeds MaxIPs: evict_oldest (default) or reject",
+                    "type": "string",
+                    "example": "evict_oldest"
+                },
+                "max_ttl_minutes": {
+                    "description": "The absolute maximum number of minutes a sliding TTL can extend this User's IP for, since the challenge (0 means unlimited)",
+                    "type": "integer",
+                    "example": 480
+                },
+                "sliding_ttl": {
+                    "description": "When true, the TTL is extended by TTLMinutes whenever this User's IP is authorized",
+                    "type": "boolean",
+                    "example": false
+                },
+                "totp_enabled": {
+                    "description": "Determines if this User is enrolled in TOTP",
+                    "type": "boolean",
+                    "example": false
+                },
                 "ttl_minutes": {
                     "description": "Represents the number of minutes this User's IP is whitelisted for after a successful challenge",
                     "type": "integer",
                     "example": 60
+                },
+                "valid_from": {
+                    "description": "This User can not be used before this date",
+                    "type": "string",
+                    "example": "2020-03-20T18:00:00Z"
+                },
+                "valid_until": {
+                    "description": "This User can not be used after this date",
+                    "type": "string",
+                    "example": "2020-03-22T18:00:00Z"
+                }
+            }
+        },
+        "server.lastSeen": {
+            "type": "object",
+            "properties": {
+                "ip_address": {
+                    "description": "The IP address which was whitelisted",
+                    "type": "string",
+                    "example": "1.1.1.1"
+                },
+                "timestamp": {
+                    "description": "When the challenge occurred",
+                    "type": "string",
+                    "example": "2020-03-22T14:28:00Z"
                 }
             }
         },
         "server.modifyUser": {
             "type": "object",
             "properties": {
+                "access_windows": {
+                    "description": "When set, this User only has access during these recurring windows (in the configured timezone)",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dataprovider.AccessWindow"
+                    }
+                },
                 "acl_allow_all": {
                     "description": "Determines if this User is allowed to access ALL resources",
                     "type": "boolean",
@@ -430,6 +2006,17 @@ var doc = `{
                         "wiki.example.com"
                     ]
                 },
+                "allowed_countries": {
+                    "description": "When set, this User only has access from these countries (ISO 3166-1 alpha-2), located with the GeoIP database",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "CA",
+                        "FR"
+                    ]
+                },
                 "description": {
                     "description": "A brief description of this User",
                     "type": "string",
@@ -445,15 +2032,116 @@ var doc = `{
                         "myhome.no-ip.info"
                     ]
                 },
+                "email": {
+                    "description": "When set, this User is notified by email when its access is used from a new IP",
+                    "type": "string",
+                    "example": "cloud@example.com"
+                },
                 "enabled": {
                     "description": "Determines if this User is enabled",
                     "type": "boolean",
                     "example": true
                 },
+                "groups": {
+                    "description": "The names of the groups this User is a member of",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "family"
+                    ]
+                },
+                "max_ips": {
+                    "description": "The maximum number of IPs whitelisted by challenges of this User at the same time (0 means unlimited)",
+                    "type": "integer",
+                    "example": 3
+                },
+                "max_ips_policy": {
+                    "description": "What happens when a challenge exceeds MaxIPs: evict_oldest (default) or reject",
+                    "type": "string",
+                    "example": "evict_oldest"
+                },
+                "max_ttl_minutes": {
+                    "description": "The absolute maximum number of minutes a sliding TTL can extend this User's IP for, since the challenge (0 means unlimited)",
+                    "type": "integer",
+                    "example": 480
+                },
+                "sliding_ttl": {
+                    "description": "When true, the TTL is extended by TTLMinutes whenever this User's IP is authorized",
+                    "type": "boolean",
+                    "example": false
+                },
                 "ttl_minutes": {
                     "description": "Represents the number of minutes this User's IP is whitelisted for after a successful challenge",
                     "type": "integer",
                     "example": 60
+                },
+                "valid_from": {
+                    "description": "This User can not be used before this date",
+                    "type": "string",
+                    "example": "2020-03-20T18:00:00Z"
+                },
+                "valid_until": {
+                    "description": "This User can not be used after this date",
+                    "type": "string",
+                    "example": "2020-03-22T18:00:00Z"
+                }
+            }
+        },
+        "server.tokenCreated": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "When this Token was created",
+                    "type": "string",
+                    "example": "2020-03-22T14:28:00Z"
+                },
+                "expired": {
+                    "description": "True when this Token is past its expiry",
+                    "type": "boolean",
+                    "example": false
+                },
+                "expires_at": {
+                    "description": "After this date, the Token is no longer valid",
+                    "type": "string",
+                    "example": "2021-03-22T14:28:00Z"
+                },
+                "name": {
+                    "description": "A unique name for this Token",
+                    "type": "string",
+                    "example": "ci-pipeline"
+                },
+                "scopes": {
+                    "description": "What this Token is allowed to do",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "users:read",
+                        "audit:read"
+                    ]
+                },
+                "token": {
+                    "description": "The token, which must be sent as a bearer token. It can not be retrieved again",
+                    "type": "string",
+                    "example": "ptg_3f1c..."
+                }
+            }
+        },
+        "server.totpEnrollment": {
+            "type": "object",
+            "properties": {
+                "secret": {
+                    "description": "The TOTP secret (base32), which is only returned once",
+                    "type": "string",
+                    "example": "JBSWY3DPEHPK3PXPJBSWY3DPEHPK3PXP"
+                },
+                "uri": {
+                    "description": "The otpauth URI of the secret, which authenticator apps can import (as a QR code)",
+                    "type": "string",
+                    "example": "otpauth://totp/Protego:5e8848?secret=JBSWY3DPEHPK3PXPJBSWY3DPEHPK3PXP\u0026issuer=Protego"
                 }
             }
         },
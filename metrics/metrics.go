@@ -0,0 +1,156 @@
+package metrics
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	namespace = "protego"
+	// the maximum number of distinct hosts used as a label value.
+	// the host header is controlled by the client, so it must be bounded
+	maxHostLabels = 256
+	// the label value used for hosts once maxHostLabels is reached
+	otherHostLabel = "other"
+)
+
+var (
+	// AuthorizeDecisions counts the decisions made by the authorize endpoint
+	AuthorizeDecisions = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "authorize_decisions_total",
+		Help:      "Number of authorize decisions by decision, reason and host.",
+	}, []string{"decision", "reason", "host"})
+
+	// AuthorizeCacheLookups counts the lookups of the authorize decision cache
+	AuthorizeCacheLookups = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "authorize_cache_lookups_total",
+		Help:      "Number of lookups of the authorize decision cache by result (hit or miss).",
+	}, []string{"result"})
+
+	// ChallengeResults counts the results of the challenge endpoint
+	ChallengeResults = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "challenge_results_total",
+		Help:      "Number of challenges by result and reason.",
+	}, []string{"result", "reason"})
+
+	// DdnsLookups counts the DNS lookups done for users' DNS names
+	DdnsLookups = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "ddns_lookups_total",
+		Help:      "Number of DNS lookups of users' DNS names by result.",
+	}, []string{"result"})
+
+	// DdnsLookupDuration observes the latency of the DNS lookups done for users' DNS names
+	DdnsLookupDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "ddns_lookup_duration_seconds",
+		Help:      "Latency of DNS lookups of users' DNS names.",
+		Buckets:   prometheus.DefBuckets,
+	})
+
+	// ProviderOperationDuration observes the latency of data provider operations
+	ProviderOperationDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "provider_operation_duration_seconds",
+		Help:      "Latency of data provider operations by provider and operation.",
+		Buckets:   []float64{.0001, .0005, .001, .005, .01, .05, .1, .5, 1},
+	}, []string{"provider", "operation"})
+
+	// HTTPRequestDuration observes the latency of http requests
+	HTTPRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "http_request_duration_seconds",
+		Help:      "Latency of http requests by route, method and status code.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"route", "method", "code"})
+
+	// WebhookDeliveries counts the deliveries of audit events to webhooks
+	WebhookDeliveries = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "webhook_deliveries_total",
+		Help:      "Number of audit events delivered to webhooks by result (success, failure or dropped).",
+	}, []string{"result"})
+
+	// EmailNotifications counts the email notifications sent to users
+	EmailNotifications = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "email_notifications_total",
+		Help:      "Number of email notifications sent to users by result (success, failure or dropped).",
+	}, []string{"result"})
+
+	// keeps track of the hosts used as label values
+	hostLabels    = make(map[string]bool)
+	hostLabelLock = new(sync.Mutex)
+)
+
+func init() {
+	prometheus.MustRegister(
+		AuthorizeDecisions,
+		AuthorizeCacheLookups,
+		ChallengeResults,
+		DdnsLookups,
+		DdnsLookupDuration,
+		ProviderOperationDuration,
+		HTTPRequestDuration,
+		WebhookDeliveries,
+		EmailNotifications,
+	)
+}
+
+// HostLabel returns the label value to use for a host.
+// Only the first maxHostLabels distinct hosts get their own label value.
+func HostLabel(host string) string {
+	host = strings.ToLower(host)
+	hostLabelLock.Lock()
+	defer hostLabelLock.Unlock()
+	if hostLabels[host] {
+		return host
+	}
+	if len(hostLabels) >= maxHostLabels {
+		return otherHostLabel
+	}
+	hostLabels[host] = true
+	return host
+}
+
+// ACLCounter returns the number of active ACLs per source
+type ACLCounter func() (map[string]int, error)
+
+// aclCollector exposes the number of active ACLs per source.
+// the counts are retrieved on every scrape.
+type aclCollector struct {
+	counter ACLCounter
+	desc    *prometheus.Desc
+}
+
+// RegisterACLCounter exposes the counts returned by counter as a gauge
+func RegisterACLCounter(counter ACLCounter) error {
+	return prometheus.Register(&aclCollector{
+		counter: counter,
+		desc: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "", "active_acls"),
+			"Number of active ACLs by source.",
+			[]string{"source"}, nil,
+		),
+	})
+}
+
+func (c *aclCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+func (c *aclCollector) Collect(ch chan<- prometheus.Metric) {
+	counts, err := c.counter()
+	if err != nil {
+		ch <- prometheus.NewInvalidMetric(c.desc, err)
+		return
+	}
+	for source, count := range counts {
+		ch <- prometheus.MustNewConstMetric(c.desc, prometheus.GaugeValue, float64(count), source)
+	}
+}
@@ -0,0 +1,136 @@
+package server
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/gbolo/protego/audit"
+	"github.com/gbolo/protego/config"
+	"github.com/gbolo/protego/dataprovider"
+)
+
+const (
+	// identity of the admin when the admin secret is used
+	actorAdminSecret = "admin-secret"
+	// identity of the admin when authentication is disabled
+	actorAnonymous = "anonymous"
+	// prefix of the identity of an admin API token
+	actorTokenPrefix = "token:"
+)
+
+// authenticateAdmin validates the admin credentials of a request, and that
+// they grant the required scope. It returns the identity of the admin.
+// When the credentials are rejected, a response is written and ok is false.
+// Credentials are an admin API token or the admin secret (which grants every scope),
+// sent as a bearer token in the Authorization header or in the Admin-Secret header.
+// when server.tls.client_auth_admin_identity is enabled, a verified client
+// certificate authenticates the admin (with every scope), identified by the certificate subject.
+func authenticateAdmin(w http.ResponseWriter, req *http.Request, scope string) (actor string, ok bool) {
+	if config.GetBool("server.tls.client_auth_admin_identity") {
+		if subject := clientCertSubject(req); subject != "" {
+			return subject, true
+		}
+	}
+
+	credential := adminCredential(req)
+	secret := config.GetString("admin.secret")
+	switch {
+	case credential != "" && secret != "" && subtle.ConstantTimeCompare([]byte(credential), []byte(secret)) == 1:
+		return actorAdminSecret, true
+	case credential != "":
+		token, reason := findToken(credential)
+		if token == nil {
+			break
+		}
+		actor = actorTokenPrefix + token.Name
+		if reason == "" && !token.HasScope(scope) {
+			reason = reasonInsufficientScope
+		}
+		if reason != "" {
+			log.Warningf("admin API token %s rejected: %s", token.Name, reason)
+			recordAdminAuthFailure(req, actor, reason)
+			writeJSONResponse(w, http.StatusForbidden, errorResponse{fmt.Sprintf("admin API token rejected: %s", reason)})
+			return "", false
+		}
+		return actor, true
+	case secret == "" && config.GetBool("admin.allow_unauthenticated"):
+		// an empty secret only disables authentication when explicitly allowed
+		return actorAnonymous, true
+	}
+
+	log.Warningf("admin credentials rejected")
+	recordAdminAuthFailure(req, "", reasonBadCredentials)
+	writeJSONResponse(w, http.StatusUnauthorized, errorResponse{"admin credentials rejected"})
+	return "", false
+}
+
+// adminCredential returns the admin credential sent with a request
+func adminCredential(req *http.Request) string {
+	if authorization := req.Header.Get("Authorization"); strings.HasPrefix(authorization, "Bearer ") {
+		return strings.TrimSpace(strings.TrimPrefix(authorization, "Bearer "))
+	}
+	return req.Header.Get("Admin-Secret")
+}
+
+// findToken returns the admin API token matching credential, or nil.
+// reason is set when the token can not be used
+func findToken(credential string) (token *dataprovider.Token, reason string) {
+	token, err := dataProvider.FindToken(credential)
+	if err != nil {
+		log.Errorf("unable to retrieve admin API token: %v", err)
+		return nil, ""
+	}
+	if token != nil && token.IsExpired() {
+		reason = reasonTokenExpired
+	}
+	return
+}
+
+// checkTokenGrant returns why actor may not create token, or an empty string.
+// an admin API token can only create tokens with the scopes it holds, which expire
+// no later than itself. The admin secret and client certificates grant every scope.
+func checkTokenGrant(actor string, token *dataprovider.Token) string {
+	if !strings.HasPrefix(actor, actorTokenPrefix) {
+		return ""
+	}
+	creator, err := dataProvider.GetToken(strings.TrimPrefix(actor, actorTokenPrefix))
+	if err != nil || creator == nil {
+		return "the admin API token creating it was not found"
+	}
+	for _, scope := range token.Scopes {
+		if !creator.HasScope(scope) {
+			return fmt.Sprintf("scope %s is not held by %s", scope, actor)
+		}
+	}
+	if creator.ExpiresAt != nil && (token.ExpiresAt == nil || token.ExpiresAt.After(*creator.ExpiresAt)) {
+		return fmt.Sprintf("the token can not expire after %s", actor)
+	}
+	return ""
+}
+
+// recordAdminAuthFailure records rejected admin credentials
+func recordAdminAuthFailure(req *http.Request, actor, reason string) {
+	audit.Record(audit.Event{
+		Type:     audit.EventAdminAuth,
+		ClientIP: remoteIP(req),
+		Decision: audit.DecisionDeny,
+		Reason:   reason,
+		Actor:    actor,
+	})
+}
+
+// remoteIP returns the IP of the client which made the request.
+// X-Real-IP is preferred when set by a proxy.
+func remoteIP(req *http.Request) string {
+	if ip := net.ParseIP(req.Header.Get("X-Real-IP")); ip != nil {
+		return ip.String()
+	}
+	host, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		return req.RemoteAddr
+	}
+	return host
+}
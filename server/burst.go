@@ -0,0 +1,82 @@
+package server
+
+import (
+	"sync"
+	"time"
+
+	"github.com/gbolo/protego/audit"
+	"github.com/gbolo/protego/config"
+)
+
+// the maximum number of client IPs tracked by the burst detector.
+// once it is reached, IPs which have not failed within the window are forgotten
+const maxBurstClients = 10000
+
+// the failed challenges of every client, used to detect bursts
+var challengeFailures = &burstDetector{
+	clients: make(map[string]*failures),
+	lock:    new(sync.Mutex),
+}
+
+// burstDetector counts the failed challenges of clients within a window of time
+type burstDetector struct {
+	clients map[string]*failures
+	lock    *sync.Mutex
+}
+
+// the failed challenges of a client since start
+type failures struct {
+	start time.Time
+	count int
+}
+
+// add counts a failed challenge of clientIP, and returns true when it
+// reaches threshold within window. It is only reported once per window
+func (b *burstDetector) add(clientIP string, now time.Time, threshold int, window time.Duration) bool {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	f, ok := b.clients[clientIP]
+	if !ok || now.Sub(f.start) >= window {
+		if len(b.clients) >= maxBurstClients {
+			b.prune(now, window)
+		}
+		f = &failures{start: now}
+		b.clients[clientIP] = f
+	}
+	f.count++
+	return f.count == threshold
+}
+
+// prune forgets the clients which have not failed within window.
+// Must be called with the lock held
+func (b *burstDetector) prune(now time.Time, window time.Duration) {
+	for ip, f := range b.clients {
+		if now.Sub(f.start) >= window {
+			delete(b.clients, ip)
+		}
+	}
+	// every client is failing: start over rather than growing without bounds
+	if len(b.clients) >= maxBurstClients {
+		b.clients = make(map[string]*failures)
+	}
+}
+
+// checkChallengeBurst records a challenge_burst event when clientIP has failed
+// audit.failure_burst.threshold challenges within audit.failure_burst.window
+func checkChallengeBurst(clientIP, userID, reason string) {
+	threshold := config.GetInt("audit.failure_burst.threshold")
+	if threshold <= 0 {
+		return
+	}
+	if !challengeFailures.add(clientIP, time.Now(), threshold, config.GetDuration("audit.failure_burst.window")) {
+		return
+	}
+	log.Warningf("client %s has failed %d challenges within %v", clientIP, threshold, config.GetDuration("audit.failure_burst.window"))
+	audit.Record(audit.Event{
+		Type:     audit.EventChallengeBurst,
+		ClientIP: clientIP,
+		UserID:   userID,
+		Decision: audit.DecisionDeny,
+		Reason:   reason,
+	})
+}
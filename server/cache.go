@@ -0,0 +1,270 @@
+package server
+
+import (
+	"container/list"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gbolo/protego/config"
+	"github.com/gbolo/protego/dataprovider"
+	"github.com/gbolo/protego/metrics"
+)
+
+// the decisions of the authorize endpoint, nil when authorize_cache.enabled is false
+var decisions *decisionCache
+
+// authorizeDecision is the outcome of handlerAuthorize for an IP and host
+type authorizeDecision struct {
+	allowed bool
+	reason  string
+	userID  string
+	// the decision must be made again after this
+	expires time.Time
+}
+
+// decisionKey identifies a cached decision. hosts are lowercased
+type decisionKey struct {
+	ip   string
+	host string
+}
+
+// decisionCache is an LRU cache of authorize decisions.
+// decisions are also bounded by authorize_cache.ttl, so that settings and time
+// based rules (like access windows) which are not tracked are applied soon enough.
+type decisionCache struct {
+	maxEntries int
+	entries    map[decisionKey]*list.Element
+	// the least recently used decision is at the back
+	order *list.List
+	// incremented by every invalidation, so that decisions made
+	// concurrently with a change of the data provider are not cached
+	generation uint64
+	lock       *sync.Mutex
+}
+
+// a decision and its key, as stored in decisionCache.order
+type decisionEntry struct {
+	key      decisionKey
+	decision authorizeDecision
+}
+
+// newDecisionCache returns a decisionCache holding at most maxEntries decisions
+func newDecisionCache(maxEntries int) *decisionCache {
+	return &decisionCache{
+		maxEntries: maxEntries,
+		entries:    make(map[decisionKey]*list.Element),
+		order:      list.New(),
+		lock:       new(sync.Mutex),
+	}
+}
+
+// get returns the decision for ip and host, if it is cached and has not expired.
+// generation must be passed to put when the decision is made again
+func (c *decisionCache) get(ip, host string) (d authorizeDecision, ok bool, generation uint64) {
+	if c == nil {
+		return
+	}
+	key := decisionKey{ip, strings.ToLower(host)}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	generation = c.generation
+	if elem, found := c.entries[key]; found {
+		entry := elem.Value.(*decisionEntry)
+		if time.Now().Before(entry.decision.expires) {
+			c.order.MoveToFront(elem)
+			d, ok = entry.decision, true
+		} else {
+			c.remove(elem)
+		}
+	}
+	metrics.AuthorizeCacheLookups.WithLabelValues(cacheResult(ok)).Inc()
+	return
+}
+
+// put caches the decision for ip and host, evicting the least recently used decision when full.
+// the decision is dropped when the cache was invalidated since generation was returned by get
+func (c *decisionCache) put(ip, host string, d authorizeDecision, generation uint64) {
+	if c == nil || !time.Now().Before(d.expires) {
+		return
+	}
+	key := decisionKey{ip, strings.ToLower(host)}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if generation != c.generation {
+		return
+	}
+	if elem, found := c.entries[key]; found {
+		elem.Value.(*decisionEntry).decision = d
+		c.order.MoveToFront(elem)
+		return
+	}
+	c.entries[key] = c.order.PushFront(&decisionEntry{key: key, decision: d})
+	for len(c.entries) > c.maxEntries {
+		c.remove(c.order.Back())
+	}
+}
+
+// invalidateIP removes the decisions for ips (in any form), whatever their host
+func (c *decisionCache) invalidateIP(ips ...string) {
+	if c == nil || len(ips) == 0 {
+		return
+	}
+	normalized := make(map[string]bool, len(ips))
+	for _, ip := range ips {
+		normalized[dataprovider.NormalizeIP(ip)] = true
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.generation++
+	for key, elem := range c.entries {
+		if normalized[key.ip] {
+			c.remove(elem)
+		}
+	}
+}
+
+// flush removes every decision
+func (c *decisionCache) flush() {
+	if c == nil {
+		return
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.generation++
+	c.entries = make(map[decisionKey]*list.Element)
+	c.order.Init()
+}
+
+// remove removes a decision. the lock must be held
+func (c *decisionCache) remove(elem *list.Element) {
+	c.order.Remove(elem)
+	delete(c.entries, elem.Value.(*decisionEntry).key)
+}
+
+// cacheResult returns the metrics label of a cache lookup
+func cacheResult(hit bool) string {
+	if hit {
+		return "hit"
+	}
+	return "miss"
+}
+
+// decisionExpiry returns when a decision made at now based on acl (which may be nil) must be made again:
+// after authorize_cache.ttl, once the ACL expires, or once its sliding TTL is due to be renewed
+func decisionExpiry(acl *dataprovider.ACL, now time.Time) time.Time {
+	expires := now.Add(config.GetDuration("authorize_cache.ttl"))
+	if acl == nil {
+		return expires
+	}
+	earliest := func(t *time.Time) {
+		if t != nil && t.Before(expires) {
+			expires = *t
+		}
+	}
+	earliest(acl.TTL)
+	earliest(acl.MaxTTL)
+	if acl.SlidingMinutes > 0 && acl.TTL != nil {
+		renewal := acl.TTL.Add(config.GetDuration("sliding_ttl.renew_interval") - time.Duration(acl.SlidingMinutes)*time.Minute)
+		earliest(&renewal)
+	}
+	return expires
+}
+
+// cachingProvider wraps the data provider and invalidates cached decisions whenever it is changed.
+// changes to ACLs invalidate the decisions of their IP, any other change invalidates every decision
+type cachingProvider struct {
+	dataprovider.Provider
+	cache *decisionCache
+}
+
+func (p *cachingProvider) AddIp(ip string, acl *dataprovider.ACL) error {
+	defer p.cache.invalidateIP(ip)
+	return p.Provider.AddIp(ip, acl)
+}
+
+func (p *cachingProvider) RemoveIp(ip string) error {
+	defer p.cache.invalidateIP(ip)
+	return p.Provider.RemoveIp(ip)
+}
+
+func (p *cachingProvider) UpdateACL(ip string, acl *dataprovider.ACL) error {
+	defer p.cache.invalidateIP(ip)
+	return p.Provider.UpdateACL(ip, acl)
+}
+
+func (p *cachingProvider) AddUserIp(ip string, acl *dataprovider.ACL) (bool, []string, error) {
+	newIP, evicted, err := p.Provider.AddUserIp(ip, acl)
+	p.cache.invalidateIP(append(evicted, ip)...)
+	return newIP, evicted, err
+}
+
+func (p *cachingProvider) AddUser(u *dataprovider.User) error {
+	defer p.cache.flush()
+	return p.Provider.AddUser(u)
+}
+
+func (p *cachingProvider) RemoveUser(u *dataprovider.User) error {
+	defer p.cache.flush()
+	return p.Provider.RemoveUser(u)
+}
+
+func (p *cachingProvider) UpdateUser(u *dataprovider.User) error {
+	defer p.cache.flush()
+	return p.Provider.UpdateUser(u)
+}
+
+func (p *cachingProvider) AddGroup(g *dataprovider.Group) error {
+	defer p.cache.flush()
+	return p.Provider.AddGroup(g)
+}
+
+func (p *cachingProvider) UpdateGroup(g *dataprovider.Group) error {
+	defer p.cache.flush()
+	return p.Provider.UpdateGroup(g)
+}
+
+func (p *cachingProvider) RemoveGroup(name string) error {
+	defer p.cache.flush()
+	return p.Provider.RemoveGroup(name)
+}
+
+func (p *cachingProvider) AddHostSet(h *dataprovider.HostSet) error {
+	defer p.cache.flush()
+	return p.Provider.AddHostSet(h)
+}
+
+func (p *cachingProvider) UpdateHostSet(h *dataprovider.HostSet) error {
+	defer p.cache.flush()
+	return p.Provider.UpdateHostSet(h)
+}
+
+func (p *cachingProvider) RemoveHostSet(name string) error {
+	defer p.cache.flush()
+	return p.Provider.RemoveHostSet(name)
+}
+
+func (p *cachingProvider) AddHostPolicy(pol *dataprovider.HostPolicy) error {
+	defer p.cache.flush()
+	return p.Provider.AddHostPolicy(pol)
+}
+
+func (p *cachingProvider) UpdateHostPolicy(pol *dataprovider.HostPolicy) error {
+	defer p.cache.flush()
+	return p.Provider.UpdateHostPolicy(pol)
+}
+
+func (p *cachingProvider) RemoveHostPolicy(host string) error {
+	defer p.cache.flush()
+	return p.Provider.RemoveHostPolicy(host)
+}
+
+func (p *cachingProvider) AddDenyEntry(d *dataprovider.DenyEntry) error {
+	defer p.cache.flush()
+	return p.Provider.AddDenyEntry(d)
+}
+
+func (p *cachingProvider) RemoveDenyEntry(cidr string) (*dataprovider.DenyEntry, error) {
+	defer p.cache.flush()
+	return p.Provider.RemoveDenyEntry(cidr)
+}
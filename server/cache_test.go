@@ -0,0 +1,167 @@
+package server
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gbolo/protego/dataprovider"
+	"github.com/spf13/viper"
+)
+
+func testDecision(allowed bool) authorizeDecision {
+	return authorizeDecision{allowed: allowed, expires: time.Now().Add(time.Minute)}
+}
+
+func TestDecisionCacheLRU(t *testing.T) {
+	c := newDecisionCache(2)
+	for _, ip := range []string{"10.0.0.1", "10.0.0.2"} {
+		_, _, gen := c.get(ip, "a.example.com")
+		c.put(ip, "a.example.com", testDecision(true), gen)
+	}
+	// 10.0.0.1 becomes the most recently used, so 10.0.0.2 is evicted
+	if _, ok, _ := c.get("10.0.0.1", "A.example.com"); !ok {
+		t.Fatal("expected a cached decision for 10.0.0.1, whatever the case of the host")
+	}
+	_, _, gen := c.get("10.0.0.3", "a.example.com")
+	c.put("10.0.0.3", "a.example.com", testDecision(true), gen)
+	if len(c.entries) != 2 || c.order.Len() != 2 {
+		t.Fatalf("expected 2 cached decisions, got %d (%d in order)", len(c.entries), c.order.Len())
+	}
+	if _, ok, _ := c.get("10.0.0.2", "a.example.com"); ok {
+		t.Fatal("expected the least recently used decision to be evicted")
+	}
+	for _, ip := range []string{"10.0.0.1", "10.0.0.3"} {
+		if _, ok, _ := c.get(ip, "a.example.com"); !ok {
+			t.Fatalf("expected a cached decision for %s", ip)
+		}
+	}
+}
+
+func TestDecisionCacheExpiry(t *testing.T) {
+	c := newDecisionCache(10)
+	_, _, gen := c.get("10.0.0.1", "a.example.com")
+	c.put("10.0.0.1", "a.example.com", authorizeDecision{allowed: true, expires: time.Now().Add(-time.Second)}, gen)
+	if _, ok, _ := c.get("10.0.0.1", "a.example.com"); ok {
+		t.Fatal("expected an expired decision not to be cached")
+	}
+	c.put("10.0.0.1", "a.example.com", authorizeDecision{allowed: true, expires: time.Now().Add(50 * time.Millisecond)}, gen)
+	time.Sleep(100 * time.Millisecond)
+	if _, ok, _ := c.get("10.0.0.1", "a.example.com"); ok {
+		t.Fatal("expected a decision not to be returned once it expired")
+	}
+	if len(c.entries) != 0 {
+		t.Fatalf("expected the expired decision to be removed, got %d decisions", len(c.entries))
+	}
+}
+
+// a decision made while the data provider changes must not be cached,
+// since it may have been made on the state before the change
+func TestDecisionCacheGeneration(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		invalidate func(c *decisionCache)
+		cached     bool
+	}{
+		{"invalidate another IP", func(c *decisionCache) { c.invalidateIP("10.0.0.9") }, false},
+		{"invalidate the same IP", func(c *decisionCache) { c.invalidateIP("::ffff:10.0.0.1") }, false},
+		{"flush", func(c *decisionCache) { c.flush() }, false},
+		{"invalidate nothing", func(c *decisionCache) { c.invalidateIP() }, true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			c := newDecisionCache(10)
+			_, _, gen := c.get("10.0.0.1", "a.example.com")
+			tc.invalidate(c)
+			c.put("10.0.0.1", "a.example.com", testDecision(true), gen)
+			if _, ok, _ := c.get("10.0.0.1", "a.example.com"); ok != tc.cached {
+				t.Fatalf("expected the decision to be cached: %t, got %t", tc.cached, ok)
+			}
+		})
+	}
+}
+
+func TestDecisionCacheInvalidateIP(t *testing.T) {
+	c := newDecisionCache(10)
+	for _, key := range []decisionKey{{"::1", "a.example.com"}, {"::1", "b.example.com"}, {"10.0.0.1", "a.example.com"}} {
+		_, _, gen := c.get(key.ip, key.host)
+		c.put(key.ip, key.host, testDecision(true), gen)
+	}
+	// any form of the address invalidates every host of the IP
+	c.invalidateIP("0:0:0:0:0:0:0:1")
+	for _, host := range []string{"a.example.com", "b.example.com"} {
+		if _, ok, _ := c.get("::1", host); ok {
+			t.Fatalf("expected the decision of ::1 for %s to be invalidated", host)
+		}
+	}
+	if _, ok, _ := c.get("10.0.0.1", "a.example.com"); !ok {
+		t.Fatal("expected the decision of another IP to be kept")
+	}
+}
+
+// changes made through the data provider apply immediately to cached decisions
+func TestCachingProviderInvalidates(t *testing.T) {
+	p := setupTestProvider(t)
+	viper.Set("authorize_cache.ttl", time.Hour)
+	defer viper.Set("authorize_cache.ttl", nil)
+	decisions = newDecisionCache(100)
+	dataProvider = &cachingProvider{Provider: p, cache: decisions}
+
+	u := addTestUser(t, "secret123", []string{"a.example.com"}, "10.0.0.1")
+	if code := authorize("10.0.0.1", "a.example.com"); code != 200 {
+		t.Fatalf("expected 200, got %d", code)
+	}
+	if _, ok, _ := decisions.get("10.0.0.1", "a.example.com"); !ok {
+		t.Fatal("expected the decision to be cached")
+	}
+
+	// revoking the IP
+	if err := dataProvider.RemoveIp("10.0.0.1"); err != nil {
+		t.Fatal(err)
+	}
+	if code := authorize("10.0.0.1", "a.example.com"); code != 401 {
+		t.Fatalf("expected 401 once the IP was revoked, got %d", code)
+	}
+
+	// disabling the user
+	acl := dataprovider.ACL{AllowedHosts: u.ACLAllowedHosts, Source: dataprovider.ACLSourceChallenge, UserID: u.ID}
+	if _, _, err := dataProvider.AddUserIp("10.0.0.1", &acl); err != nil {
+		t.Fatal(err)
+	}
+	if code := authorize("10.0.0.1", "a.example.com"); code != 200 {
+		t.Fatalf("expected 200 once the IP was whitelisted again, got %d", code)
+	}
+	u.Enabled = false
+	if err := dataProvider.UpdateUser(u); err != nil {
+		t.Fatal(err)
+	}
+	if code := authorize("10.0.0.1", "a.example.com"); code != 401 {
+		t.Fatalf("expected 401 once the user was disabled, got %d", code)
+	}
+}
+
+// BenchmarkAuthorize compares authorize requests with and without the decision cache,
+// with the bolt data provider and 1000 whitelisted IPs
+func BenchmarkAuthorize(b *testing.B) {
+	for _, cached := range []bool{false, true} {
+		b.Run(fmt.Sprintf("cached=%t", cached), func(b *testing.B) {
+			p := setupTestProvider(b)
+			viper.Set("authorize_cache.ttl", time.Hour)
+			defer viper.Set("authorize_cache.ttl", nil)
+			if cached {
+				decisions = newDecisionCache(10000)
+				dataProvider = &cachingProvider{Provider: p, cache: decisions}
+			}
+			ips := make([]string, 1000)
+			for i := range ips {
+				ips[i] = fmt.Sprintf("10.0.%d.%d", i/256, i%256)
+			}
+			addTestUser(b, "secret123", []string{"a.example.com"}, ips...)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if code := authorize(ips[i%len(ips)], "a.example.com"); code != 200 {
+					b.Fatalf("expected 200, got %d", code)
+				}
+			}
+		})
+	}
+}
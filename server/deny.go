@@ -0,0 +1,138 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/gbolo/protego/audit"
+	"github.com/gbolo/protego/dataprovider"
+	"github.com/gorilla/mux"
+)
+
+// checkDenyList returns the reason clientIP must be refused when it is blocked, or an empty string.
+// access is refused when the deny list can not be retrieved
+func checkDenyList(clientIP string) string {
+	entry, err := dataProvider.FindDenyEntry(clientIP)
+	if err != nil {
+		log.Errorf("unable to retrieve deny list: %v", err)
+		return reasonInternalError
+	}
+	if entry != nil {
+		log.Debugf("client (%s) is blocked by %s: %s", clientIP, entry.CIDR, entry.Reason)
+		return reasonIPDenied
+	}
+	return ""
+}
+
+// handlerDenyAdd godoc
+// @Summary Block an IP address or range
+// @Description block an IP address or CIDR. It takes precedence over every ACL, and clients within it can not pass a challenge
+// @Tags Deny
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "Bearer followed by an admin API token or the admin secret"
+// @Param entry body server.addDenyEntry true "Add DenyEntry"
+// @Success 200 {object} dataprovider.DenyEntry
+// @Failure 400 "bad request: the IP address or CIDR is invalid" {object} errorResponse
+// @Failure 409 "this range is already blocked" {object} errorResponse
+// @Router /deny [post]
+func handlerDenyAdd(w http.ResponseWriter, req *http.Request) {
+	// validate admin credentials
+	actor, ok := authenticateAdmin(w, req, dataprovider.ScopeACLsManage)
+	if !ok {
+		return
+	}
+
+	var newEntry addDenyEntry
+	if err := json.NewDecoder(req.Body).Decode(&newEntry); err != nil {
+		writeJSONResponse(w, http.StatusBadRequest, errorResponse{"Bad request: " + err.Error()})
+		return
+	}
+	entry, err := dataprovider.NewDenyEntry(newEntry.CIDR, newEntry.Reason, newEntry.ExpiresAt)
+	if err != nil {
+		writeJSONResponse(w, http.StatusBadRequest, errorResponse{"Bad request: " + err.Error()})
+		return
+	}
+	err = dataProvider.AddDenyEntry(entry)
+	switch {
+	case err == dataprovider.ErrDenyEntryExists:
+		writeJSONResponse(w, http.StatusConflict, errorResponse{fmt.Sprintf("Range is already blocked (cidr: %s)", entry.CIDR)})
+		return
+	case err != nil:
+		log.Errorf("couldn't add new deny entry: %v", err)
+		writeJSONResponse(w, http.StatusInternalServerError, errorResponse{"Could not add deny entry"})
+		return
+	}
+
+	// range has been blocked
+	log.Infof("range has been blocked: %s (%s)", entry.CIDR, entry.Reason)
+	recordAdminChange(req, audit.Event{Type: audit.EventDenyAdd, Target: entry.CIDR, Reason: entry.Reason}, actor)
+	writeJSONResponse(w, http.StatusOK, entry)
+}
+
+// handlerDenyGetAll godoc
+// @Summary Retrieve all blocked IP addresses and ranges
+// @Description get all DenyEntries which have not expired
+// @Tags Deny
+// @Produce json
+// @Param Authorization header string true "Bearer followed by an admin API token or the admin secret"
+// @Success 200 {array} dataprovider.DenyEntry
+// @Router /deny [get]
+func handlerDenyGetAll(w http.ResponseWriter, req *http.Request) {
+	// validate admin credentials
+	if _, ok := authenticateAdmin(w, req, dataprovider.ScopeACLsManage); !ok {
+		return
+	}
+
+	entries, err := dataProvider.GetAllDenyEntries()
+	if err != nil {
+		log.Warningf("could not get all deny entries: %v", err)
+		writeJSONResponse(w, http.StatusServiceUnavailable, errorResponse{"could not retrieve all deny entries"})
+		return
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].CIDR < entries[j].CIDR })
+	writeJSONResponse(w, http.StatusOK, entries)
+}
+
+// handlerDenyDelete godoc
+// @Summary Unblock an IP address or range
+// @Description remove a DenyEntry by its IP address or CIDR (like /deny/203.0.113.0/24)
+// @Tags Deny
+// @Produce json
+// @Param Authorization header string true "Bearer followed by an admin API token or the admin secret"
+// @Param cidr path string true "IP address or CIDR"
+// @Success 200 {object} dataprovider.DenyEntry
+// @Failure 400 "bad request: the IP address or CIDR is invalid" {object} errorResponse
+// @Failure 404 "the IP address or CIDR is not blocked" {object} errorResponse
+// @Router /deny/{cidr} [delete]
+func handlerDenyDelete(w http.ResponseWriter, req *http.Request) {
+	// validate admin credentials
+	actor, ok := authenticateAdmin(w, req, dataprovider.ScopeACLsManage)
+	if !ok {
+		return
+	}
+
+	cidr, err := dataprovider.NormalizeCIDR(mux.Vars(req)["cidr"])
+	if err != nil {
+		writeJSONResponse(w, http.StatusBadRequest, errorResponse{"Bad request: " + err.Error()})
+		return
+	}
+	entry, err := dataProvider.RemoveDenyEntry(cidr)
+	switch {
+	case err == dataprovider.ErrDenyEntryNotFound:
+		log.Warningf("deny entry was not found: %s", cidr)
+		writeJSONResponse(w, http.StatusNotFound, errorResponse{"deny entry was not found"})
+		return
+	case err != nil:
+		log.Warningf("unable to remove deny entry %s: %v", cidr, err)
+		writeJSONResponse(w, http.StatusInternalServerError, errorResponse{"unable to remove deny entry"})
+		return
+	}
+
+	// range has been unblocked
+	log.Infof("range has been unblocked: %s", cidr)
+	recordAdminChange(req, audit.Event{Type: audit.EventDenyRemove, Target: cidr}, actor)
+	writeJSONResponse(w, http.StatusOK, entry)
+}
@@ -0,0 +1,62 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/spf13/viper"
+)
+
+// denyRequest returns the status code of handler, called by the admin with body for cidr
+func denyRequest(handler http.HandlerFunc, method, cidr, body string) int {
+	req := httptest.NewRequest(method, "/api/v1/deny/"+cidr, strings.NewReader(body))
+	req.Header.Set("Admin-Secret", viper.GetString("admin.secret"))
+	req = mux.SetURLVars(req, map[string]string{"cidr": cidr})
+	w := httptest.NewRecorder()
+	handler(w, req)
+	return w.Code
+}
+
+func TestDenyListPrecedence(t *testing.T) {
+	setupTestProvider(t)
+	viper.Set("admin.secret", "supersecret")
+	t.Cleanup(func() { viper.Set("admin.secret", "") })
+	addTestUser(t, "secret123", []string{"a.example.com"}, "203.0.113.10")
+	if status := authorize("203.0.113.10", "a.example.com"); status != http.StatusOK {
+		t.Fatalf("expected the whitelisted IP to be authorized, got %d", status)
+	}
+
+	if status := denyRequest(handlerDenyAdd, http.MethodPost, "", `{"cidr":"203.0.113.0/24","reason":"test"}`); status != http.StatusOK {
+		t.Fatalf("expected the range to be blocked, got %d", status)
+	}
+	// the deny list takes precedence over the ACL of the IP, and over a valid secret
+	if status := authorize("203.0.113.10", "a.example.com"); status == http.StatusOK {
+		t.Fatal("expected a blocked IP to be denied despite its ACL")
+	}
+	if status := challenge("203.0.113.20", "secret123"); status != http.StatusForbidden {
+		t.Fatalf("expected a challenge from a blocked IP to be refused, got %d", status)
+	}
+	if acl, _ := dataProvider.GetACL("203.0.113.20"); acl != nil {
+		t.Fatal("expected a blocked IP not to be whitelisted")
+	}
+	// clients outside of the range are not affected
+	if status := challenge("198.51.100.1", "secret123"); status != http.StatusAccepted {
+		t.Fatalf("expected a challenge from outside of the range to be accepted, got %d", status)
+	}
+
+	if status := denyRequest(handlerDenyDelete, http.MethodDelete, "203.0.113.0/24", ""); status != http.StatusOK {
+		t.Fatalf("expected the range to be unblocked, got %d", status)
+	}
+	if status := authorize("203.0.113.10", "a.example.com"); status != http.StatusOK {
+		t.Fatalf("expected the IP to be authorized once unblocked, got %d", status)
+	}
+	if status := denyRequest(handlerDenyDelete, http.MethodDelete, "203.0.113.0/24", ""); status != http.StatusNotFound {
+		t.Fatalf("expected a range which is not blocked to be reported as not found, got %d", status)
+	}
+	if status := denyRequest(handlerDenyDelete, http.MethodDelete, "not-an-ip", ""); status != http.StatusBadRequest {
+		t.Fatalf("expected an invalid range to be refused, got %d", status)
+	}
+}
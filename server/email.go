@@ -0,0 +1,259 @@
+package server
+
+import (
+	"bytes"
+	"crypto/tls"
+	"fmt"
+	"io/ioutil"
+	"mime"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/gbolo/protego/audit"
+	"github.com/gbolo/protego/config"
+	"github.com/gbolo/protego/dataprovider"
+	"github.com/gbolo/protego/metrics"
+)
+
+const (
+	// the number of notifications waiting to be sent, newer notifications are dropped
+	emailQueueSize = 100
+	// a notification is abandoned when the SMTP server does not respond for this long
+	smtpTimeout = 30 * time.Second
+)
+
+// how the connection to the SMTP server is secured
+const (
+	// STARTTLS is required (default)
+	smtpSecurityStartTLS = "starttls"
+	// the connection is TLS from the start (usually port 465)
+	smtpSecurityTLS = "tls"
+	// the connection is never encrypted, only meant for a local SMTP server
+	smtpSecurityNone = "none"
+)
+
+// the body of notifications, unless email.template is set
+const defaultEmailTemplate = `Hello {{or .Description .UserID}},
+
+your Protego access was used from a new IP address:
+
+  IP address: {{.IP}}
+{{- with .Location}}
+  Location:   {{.}}
+{{- end}}
+  Time:       {{.Time.Format "2006-01-02 15:04:05 MST"}}
+
+If this was not you, your secret may have been stolen.
+Please contact your administrator, who can revoke this IP address and change your secret.
+`
+
+// emailSink notifies users by email when their access is used from a new IP
+// (see audit.EventChallengeNewIP). Notifications are sent in the background.
+type emailSink struct {
+	provider dataprovider.Provider
+	subject  *template.Template
+	body     *template.Template
+	queue    chan audit.Event
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+// emailNotification is what the subject and body templates are executed with
+type emailNotification struct {
+	// the ID and description of the user
+	UserID      string
+	Description string
+	// the new IP, and where it is located (when GeoIP is enabled)
+	IP       string
+	City     string
+	Country  string
+	Location string
+	// when the challenge was passed
+	Time time.Time
+}
+
+// newEmailSink returns an emailSink with the configured templates, and starts sending
+func newEmailSink(p dataprovider.Provider) (*emailSink, error) {
+	subject, err := template.New("subject").Parse(config.GetString("email.subject"))
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse email.subject: %v", err)
+	}
+	body := template.New("body")
+	if path := config.GetString("email.template"); path != "" {
+		text, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read email.template: %v", err)
+		}
+		body, err = body.Parse(string(text))
+	} else {
+		body, err = body.Parse(defaultEmailTemplate)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse email.template: %v", err)
+	}
+	s := &emailSink{
+		provider: p,
+		subject:  subject,
+		body:     body,
+		queue:    make(chan audit.Event, emailQueueSize),
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+	go s.run()
+	return s, nil
+}
+
+// Write queues a notification for a challenge from a new IP.
+// it never blocks: the notification is dropped when the queue is full
+func (s *emailSink) Write(e *audit.Event) error {
+	if e.Type != audit.EventChallengeNewIP {
+		return nil
+	}
+	select {
+	case s.queue <- *e:
+		return nil
+	default:
+		metrics.EmailNotifications.WithLabelValues("dropped").Inc()
+		return fmt.Errorf("email queue is full, dropping notification of user %s", e.UserID)
+	}
+}
+
+// Close stops sending notifications. Notifications which are still queued are dropped
+func (s *emailSink) Close() error {
+	close(s.stop)
+	<-s.done
+	if dropped := len(s.queue); dropped > 0 {
+		metrics.EmailNotifications.WithLabelValues("dropped").Add(float64(dropped))
+		log.Warningf("dropped %d email notification(s) which were not sent", dropped)
+	}
+	return nil
+}
+
+// run sends queued notifications until the sink is closed
+func (s *emailSink) run() {
+	defer close(s.done)
+	for {
+		select {
+		case <-s.stop:
+			return
+		case e := <-s.queue:
+			if err := s.notify(&e); err != nil {
+				metrics.EmailNotifications.WithLabelValues("failure").Inc()
+				log.Errorf("unable to notify user %s by email: %v", e.UserID, err)
+			}
+		}
+	}
+}
+
+// notify sends a notification of e to its user, when it has an email address
+func (s *emailSink) notify(e *audit.Event) error {
+	user, err := s.provider.GetUser(e.UserID)
+	if err != nil || user == nil || user.Email == "" {
+		return err
+	}
+	n := emailNotification{
+		UserID:      user.ID,
+		Description: user.Description,
+		IP:          e.ClientIP,
+		Country:     e.Country,
+		Time:        e.Timestamp.Local(),
+	}
+	if n.Country == "" {
+		n.Country = locate(n.IP)
+	}
+	n.City, _ = geoipDB.city(n.IP)
+	var location []string
+	for _, part := range []string{n.City, n.Country} {
+		if part != "" {
+			location = append(location, part)
+		}
+	}
+	n.Location = strings.Join(location, ", ")
+
+	subject, body := new(bytes.Buffer), new(bytes.Buffer)
+	if err = s.subject.Execute(subject, n); err != nil {
+		return err
+	}
+	if err = s.body.Execute(body, n); err != nil {
+		return err
+	}
+	if err = sendMail(user.Email, subject.String(), body.String()); err != nil {
+		return err
+	}
+	metrics.EmailNotifications.WithLabelValues("success").Inc()
+	log.Infof("user %s has been notified by email of its new IP (%s)", user.ID, n.IP)
+	return nil
+}
+
+// sendMail sends a plain text email to a single recipient, through the configured SMTP server
+func sendMail(to, subject, body string) error {
+	from, err := mail.ParseAddress(config.GetString("email.from"))
+	if err != nil {
+		return fmt.Errorf("invalid email.from: %v", err)
+	}
+	host := config.GetString("email.smtp.host")
+	address := net.JoinHostPort(host, config.GetString("email.smtp.port"))
+	security := strings.ToLower(config.GetString("email.smtp.security"))
+	tlsConfig := &tls.Config{ServerName: host, MinVersion: tlsMinVersion}
+
+	dialer := &net.Dialer{Timeout: smtpTimeout}
+	var conn net.Conn
+	if security == smtpSecurityTLS {
+		conn, err = tls.DialWithDialer(dialer, "tcp", address, tlsConfig)
+	} else {
+		conn, err = dialer.Dial("tcp", address)
+	}
+	if err != nil {
+		return err
+	}
+	conn.SetDeadline(time.Now().Add(smtpTimeout))
+	c, err := smtp.NewClient(conn, host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer c.Close()
+	if security == smtpSecurityStartTLS {
+		if err = c.StartTLS(tlsConfig); err != nil {
+			return fmt.Errorf("unable to STARTTLS: %v", err)
+		}
+	}
+	if username := config.GetString("email.smtp.username"); username != "" {
+		if err = c.Auth(smtp.PlainAuth("", username, config.GetString("email.smtp.password"), host)); err != nil {
+			return err
+		}
+	}
+	if err = c.Mail(from.Address); err != nil {
+		return err
+	}
+	if err = c.Rcpt(to); err != nil {
+		return err
+	}
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	// the subject must fit on a single header line
+	subject = strings.Join(strings.Fields(subject), " ")
+	headers := []string{
+		"From: " + from.String(),
+		"To: " + to,
+		"Subject: " + mime.QEncoding.Encode("utf-8", subject),
+		"Date: " + time.Now().Format(time.RFC1123Z),
+		"MIME-Version: 1.0",
+		"Content-Type: text/plain; charset=utf-8",
+		"Content-Transfer-Encoding: 8bit",
+	}
+	message := strings.Join(headers, "\r\n") + "\r\n\r\n" + strings.ReplaceAll(body, "\n", "\r\n")
+	if _, err = w.Write([]byte(message)); err != nil {
+		return err
+	}
+	if err = w.Close(); err != nil {
+		return err
+	}
+	return c.Quit()
+}
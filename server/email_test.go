@@ -0,0 +1,373 @@
+package server
+
+import (
+	"bufio"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"io/ioutil"
+	"math/big"
+	"mime"
+	"net"
+	"net/mail"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gbolo/protego/audit"
+	"github.com/spf13/viper"
+)
+
+// testSMTPServer is an SMTP server which accepts every message, and keeps them
+type testSMTPServer struct {
+	listener net.Listener
+	// when set, STARTTLS is advertised and the connection is upgraded with it
+	tlsConfig *tls.Config
+	messages  []string
+	// the credentials of AUTH PLAIN, decoded
+	auth string
+	// the result of every TLS handshake
+	handshakes chan error
+	lock       sync.Mutex
+	done       chan struct{}
+}
+
+// newTestSMTPServer starts an SMTP server, which is stopped when the test ends
+func newTestSMTPServer(t *testing.T, startTLS bool) *testSMTPServer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &testSMTPServer{listener: listener, handshakes: make(chan error, 10), done: make(chan struct{})}
+	if startTLS {
+		s.tlsConfig = &tls.Config{Certificates: []tls.Certificate{selfSignedCertificate(t)}}
+	}
+	go func() {
+		defer close(s.done)
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			s.serve(conn)
+		}
+	}()
+	t.Cleanup(func() {
+		listener.Close()
+		<-s.done
+	})
+	return s
+}
+
+// port returns the port the server listens on
+func (s *testSMTPServer) port() string {
+	_, port, _ := net.SplitHostPort(s.listener.Addr().String())
+	return port
+}
+
+// serve handles a single connection, one at a time
+func (s *testSMTPServer) serve(conn net.Conn) {
+	defer func() { conn.Close() }()
+	conn.SetDeadline(time.Now().Add(5 * time.Second))
+	r, w := bufio.NewReader(conn), bufio.NewWriter(conn)
+	reply := func(lines ...string) {
+		for _, line := range lines {
+			w.WriteString(line + "\r\n")
+		}
+		w.Flush()
+	}
+	reply("220 localhost test SMTP server")
+	secure := false
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		command := strings.ToUpper(strings.Fields(line + " x")[0])
+		switch command {
+		case "EHLO", "HELO":
+			if s.tlsConfig != nil && !secure {
+				reply("250-localhost", "250-STARTTLS", "250 AUTH PLAIN")
+			} else {
+				reply("250-localhost", "250 AUTH PLAIN")
+			}
+		case "STARTTLS":
+			if s.tlsConfig == nil {
+				reply("502 STARTTLS not supported")
+				continue
+			}
+			reply("220 ready to start TLS")
+			tlsConn := tls.Server(conn, s.tlsConfig)
+			err = tlsConn.Handshake()
+			s.handshakes <- err
+			if err != nil {
+				return
+			}
+			conn, secure = tlsConn, true
+			r, w = bufio.NewReader(conn), bufio.NewWriter(conn)
+		case "AUTH":
+			credentials, _ := base64.StdEncoding.DecodeString(strings.Fields(line)[2])
+			s.lock.Lock()
+			s.auth = string(credentials)
+			s.lock.Unlock()
+			reply("235 authenticated")
+		case "MAIL", "RCPT", "RSET", "NOOP":
+			reply("250 ok")
+		case "DATA":
+			reply("354 end with <CRLF>.<CRLF>")
+			var message strings.Builder
+			for {
+				line, err = r.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if line == ".\r\n" {
+					break
+				}
+				message.WriteString(strings.TrimPrefix(line, "."))
+			}
+			s.lock.Lock()
+			s.messages = append(s.messages, message.String())
+			s.lock.Unlock()
+			reply("250 queued")
+		case "QUIT":
+			reply("221 bye")
+			return
+		default:
+			reply("502 not implemented")
+		}
+	}
+}
+
+// received returns the messages received so far
+func (s *testSMTPServer) received() []string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return append([]string(nil), s.messages...)
+}
+
+// selfSignedCertificate returns a certificate for 127.0.0.1, which clients do not trust
+func selfSignedCertificate(t *testing.T) tls.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "127.0.0.1"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+}
+
+// setupTestEmail configures notifications through server, until the test ends
+func setupTestEmail(t *testing.T, server *testSMTPServer, security string) {
+	settings := map[string]interface{}{
+		"email.from":          "Protego <protego@example.com>",
+		"email.subject":       "Your access was used from {{.IP}}{{with .Location}}, {{.}}{{end}}",
+		"email.template":      "",
+		"email.smtp.host":     "127.0.0.1",
+		"email.smtp.port":     server.port(),
+		"email.smtp.security": security,
+		"email.smtp.username": "",
+		"email.smtp.password": "",
+	}
+	for key, value := range settings {
+		viper.Set(key, value)
+	}
+	t.Cleanup(func() {
+		for key := range settings {
+			viper.Set(key, "")
+		}
+	})
+}
+
+// parseMessage parses a received message, and fails the test when it is invalid
+func parseMessage(t *testing.T, message string) (*mail.Message, string) {
+	t.Helper()
+	if strings.Contains(strings.ReplaceAll(message, "\r\n", ""), "\n") {
+		t.Fatalf("expected every line of the message to end with CRLF: %q", message)
+	}
+	msg, err := mail.ReadMessage(strings.NewReader(message))
+	if err != nil {
+		t.Fatalf("unable to parse message: %v", err)
+	}
+	body, _ := ioutil.ReadAll(msg.Body)
+	return msg, strings.ReplaceAll(string(body), "\r\n", "\n")
+}
+
+func TestSendMail(t *testing.T) {
+	server := newTestSMTPServer(t, false)
+	setupTestEmail(t, server, smtpSecurityNone)
+	viper.Set("email.smtp.username", "protego")
+	viper.Set("email.smtp.password", "changeme")
+
+	if err := sendMail("alice@example.com", "Accès utilisé\r\nBcc: mallory@example.com", "line 1\nline 2\n"); err != nil {
+		t.Fatal(err)
+	}
+	messages := server.received()
+	if len(messages) != 1 {
+		t.Fatalf("expected a single message, got %d", len(messages))
+	}
+	msg, body := parseMessage(t, messages[0])
+
+	for header, expected := range map[string]string{
+		"From":                      `"Protego" <protego@example.com>`,
+		"To":                        "alice@example.com",
+		"Bcc":                       "",
+		"MIME-Version":              "1.0",
+		"Content-Type":              "text/plain; charset=utf-8",
+		"Content-Transfer-Encoding": "8bit",
+	} {
+		if value := msg.Header.Get(header); value != expected {
+			t.Errorf("expected header %s to be %q, got %q", header, expected, value)
+		}
+	}
+	// the subject is encoded, and kept on a single line
+	subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	if err != nil || subject != "Accès utilisé Bcc: mallory@example.com" {
+		t.Errorf("unexpected subject %q (error: %v)", subject, err)
+	}
+	if date, err := msg.Header.Date(); err != nil || time.Since(date) > time.Minute {
+		t.Errorf("expected the current date, got %q", msg.Header.Get("Date"))
+	}
+	if body != "line 1\nline 2\n" {
+		t.Errorf("unexpected body %q", body)
+	}
+	server.lock.Lock()
+	auth := server.auth
+	server.lock.Unlock()
+	if auth != "\x00protego\x00changeme" {
+		t.Errorf("expected to authenticate with AUTH PLAIN, got %q", auth)
+	}
+
+	viper.Set("email.from", "not an address")
+	if err := sendMail("alice@example.com", "subject", "body"); err == nil {
+		t.Error("expected an error for an invalid email.from")
+	}
+}
+
+func TestSendMailStartTLS(t *testing.T) {
+	// STARTTLS is required, even when the server does not advertise it
+	plain := newTestSMTPServer(t, false)
+	setupTestEmail(t, plain, smtpSecurityStartTLS)
+	if err := sendMail("alice@example.com", "subject", "body"); err == nil || !strings.Contains(err.Error(), "STARTTLS") {
+		t.Fatalf("expected STARTTLS to be required, got %v", err)
+	}
+	if len(plain.received()) != 0 {
+		t.Fatal("expected no message to be sent without STARTTLS")
+	}
+
+	// the certificate of the server is verified
+	server := newTestSMTPServer(t, true)
+	setupTestEmail(t, server, smtpSecurityStartTLS)
+	err := sendMail("alice@example.com", "subject", "body")
+	if err == nil || !strings.Contains(err.Error(), "STARTTLS") {
+		t.Fatalf("expected an untrusted certificate to be refused, got %v", err)
+	}
+	select {
+	case err = <-server.handshakes:
+		if err == nil {
+			t.Fatal("expected the TLS handshake to fail")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected a TLS handshake")
+	}
+	if len(server.received()) != 0 {
+		t.Fatal("expected no message to be sent over an untrusted connection")
+	}
+}
+
+func TestEmailNotification(t *testing.T) {
+	setupTestProvider(t)
+	setupTestGeoIP(t, geoipPolicyReject)
+	server := newTestSMTPServer(t, false)
+	setupTestEmail(t, server, smtpSecurityNone)
+	u := addTestUser(t, "secret123", []string{"a.example.com"})
+	u.Description = "Alice"
+	u.Email = "alice@example.com"
+	if err := dataProvider.UpdateUser(u); err != nil {
+		t.Fatal(err)
+	}
+	nobody := addTestUser(t, "secret456", []string{"a.example.com"})
+	timestamp := time.Date(2020, 3, 22, 14, 28, 0, 0, time.UTC)
+
+	notify := func(userID, clientIP string) (subject, body string) {
+		t.Helper()
+		s, err := newEmailSink(dataProvider)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer s.Close()
+		before := len(server.received())
+		e := audit.Event{Type: audit.EventChallengeNewIP, UserID: userID, ClientIP: clientIP, Timestamp: timestamp}
+		if err = s.notify(&e); err != nil {
+			t.Fatal(err)
+		}
+		messages := server.received()
+		if len(messages) == before {
+			return "", ""
+		}
+		msg, body := parseMessage(t, messages[len(messages)-1])
+		subject, _ = new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+		return subject, body
+	}
+
+	// the default templates, with the location of the IP
+	subject, body := notify(u.ID, "81.2.69.1")
+	if subject != "Your access was used from 81.2.69.1, London, GB" {
+		t.Errorf("unexpected subject %q", subject)
+	}
+	for _, expected := range []string{
+		"Hello Alice,",
+		"IP address: 81.2.69.1\n",
+		"Location:   London, GB\n",
+		"Time:       " + timestamp.Local().Format("2006-01-02 15:04:05 MST"),
+	} {
+		if !strings.Contains(body, expected) {
+			t.Errorf("expected the body to contain %q:\n%s", expected, body)
+		}
+	}
+	// the location is left out when it is unknown
+	if _, body = notify(u.ID, "10.0.0.1"); strings.Contains(body, "Location") {
+		t.Errorf("expected no location for an unknown IP:\n%s", body)
+	}
+
+	// a template file
+	path := filepath.Join(t.TempDir(), "email.tmpl")
+	if err := ioutil.WriteFile(path, []byte("{{.UserID}} {{.IP}} {{.City}} {{.Country}}"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	viper.Set("email.template", path)
+	if _, body = notify(u.ID, "89.160.20.1"); strings.TrimSpace(body) != u.ID+" 89.160.20.1 Linkoping SE" {
+		t.Errorf("unexpected body from a template file %q", body)
+	}
+
+	// users without an email address are not notified
+	if subject, _ = notify(nobody.ID, "81.2.69.1"); subject != "" {
+		t.Errorf("expected no notification for a user without an email address, got %q", subject)
+	}
+
+	// invalid templates are refused
+	viper.Set("email.template", filepath.Join(t.TempDir(), "missing.tmpl"))
+	if _, err := newEmailSink(dataProvider); err == nil {
+		t.Error("expected an error for a missing template file")
+	}
+	viper.Set("email.template", "")
+	viper.Set("email.subject", "{{.IP")
+	if _, err := newEmailSink(dataProvider); err == nil {
+		t.Error("expected an error for an invalid subject template")
+	}
+}
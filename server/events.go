@@ -0,0 +1,173 @@
+package server
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gbolo/protego/audit"
+	"github.com/gbolo/protego/config"
+	"github.com/gbolo/protego/metrics"
+)
+
+// reasons behind authorize and challenge decisions
+const (
+	reasonInvalidIP      = "invalid_ip"
+	reasonUnknownIP      = "unknown_ip"
+	reasonAllowAll       = "allow_all"
+	reasonHostAllowed    = "host_allowed"
+	reasonHostNotAllowed = "host_not_allowed"
+	reasonInvalidSecret  = "invalid_secret"
+	reasonUnknownUser    = "unknown_user"
+	reasonUserDisabled   = "user_disabled"
+	reasonNotYetValid    = "user_not_yet_valid"
+	reasonUserExpired    = "user_expired"
+	reasonOutsideWindow  = "outside_access_window"
+	reasonMaxIPsReached  = "max_ips_reached"
+	reasonInvalidTOTP    = "invalid_totp"
+	reasonTOTPReplayed   = "totp_replayed"
+	reasonIPDenied       = "ip_denied"
+	reasonInternalError  = "internal_error"
+	reasonAccessGranted  = "access_granted"
+	reasonBadCredentials = "bad_credentials"
+	reasonTokenExpired   = "token_expired"
+	// the admin API token lacks the scope required by the request
+	reasonInsufficientScope = "insufficient_scope"
+	// reasons a host policy denied access
+	reasonHostTTLExpired  = "host_ttl_expired"
+	reasonTOTPRequired    = "totp_required"
+	reasonGroupNotAllowed = "group_not_allowed"
+	// the client is located in a country the user or host policy does not allow
+	reasonCountryNotAllowed = "country_not_allowed"
+)
+
+// at most this many authorize denies of clients without an ACL are audited per second.
+// anyone can cause them, so they must not flood the audit sinks. Metrics still count every one of them
+const maxUnknownDeniesPerSecond = 10
+
+// limits the audited authorize denies of clients without an ACL
+var unknownDenies = newRateLimiter(maxUnknownDeniesPerSecond, time.Second)
+
+// rateLimiter allows a limited number of events per interval
+type rateLimiter struct {
+	limit    int
+	interval time.Duration
+	start    time.Time
+	count    int
+	// the events which were not allowed since start
+	dropped int
+	lock    *sync.Mutex
+}
+
+func newRateLimiter(limit int, interval time.Duration) *rateLimiter {
+	return &rateLimiter{limit: limit, interval: interval, lock: new(sync.Mutex)}
+}
+
+// allow returns true when an event at now is allowed. Once an interval is over,
+// the number of events which were not allowed during that interval is returned
+func (r *rateLimiter) allow(now time.Time) (allowed bool, dropped int) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if now.Sub(r.start) >= r.interval {
+		dropped = r.dropped
+		r.start, r.count, r.dropped = now, 0, 0
+	}
+	if r.count >= r.limit {
+		r.dropped++
+		return false, dropped
+	}
+	r.count++
+	return true, dropped
+}
+
+// decision returns the audit decision for allowed
+func decision(allowed bool) string {
+	if allowed {
+		return audit.DecisionAllow
+	}
+	return audit.DecisionDeny
+}
+
+// recordAuthorize records the decision made by handlerAuthorize.
+// allowed decisions are only audited when audit.log_authorize_allow is set,
+// since there is one for every request that is proxied. Denies of clients
+// without an ACL are limited to maxUnknownDeniesPerSecond.
+func recordAuthorize(req *http.Request, clientIP, userID string, allowed bool, reason string) {
+	metrics.AuthorizeDecisions.WithLabelValues(decision(allowed), reason, metrics.HostLabel(req.Host)).Inc()
+	if allowed && !config.GetBool("audit.log_authorize_allow") {
+		return
+	}
+	if !allowed && userID == "" {
+		ok, dropped := unknownDenies.allow(time.Now())
+		if dropped > 0 {
+			log.Warningf("%d authorize denies of unknown clients were not audited (the limit is %d per second)", dropped, maxUnknownDeniesPerSecond)
+		}
+		if !ok {
+			return
+		}
+	}
+	audit.Record(audit.Event{
+		Type:     audit.EventAuthorize,
+		ClientIP: clientIP,
+		UserID:   userID,
+		Host:     req.Host,
+		Decision: decision(allowed),
+		Reason:   reason,
+	})
+}
+
+// recordChallenge records the result of handlerChallenge
+func recordChallenge(clientIP, userID string, success bool, reason string) {
+	result := "failure"
+	if success {
+		result = "success"
+	}
+	metrics.ChallengeResults.WithLabelValues(result, reason).Inc()
+	audit.Record(audit.Event{
+		Type:     audit.EventChallenge,
+		ClientIP: clientIP,
+		UserID:   userID,
+		Decision: decision(success),
+		Reason:   reason,
+	})
+	if !success {
+		checkChallengeBurst(clientIP, userID, reason)
+	}
+}
+
+// recordNewIP records a challenge passed by a user from an IP which was not whitelisted for it
+func recordNewIP(clientIP, userID, country string) {
+	audit.Record(audit.Event{
+		Type:     audit.EventChallengeNewIP,
+		ClientIP: clientIP,
+		UserID:   userID,
+		Decision: audit.DecisionAllow,
+		Reason:   reasonAccessGranted,
+		Country:  country,
+	})
+}
+
+// recordEviction records the removal of the ACL of evictedIP, caused by a challenge
+// of the user from clientIP which exceeded the user's max IPs
+func recordEviction(clientIP, userID, evictedIP string) {
+	audit.Record(audit.Event{
+		Type:     audit.EventACLEvict,
+		ClientIP: clientIP,
+		UserID:   userID,
+		Reason:   reasonMaxIPsReached,
+		Target:   evictedIP,
+	})
+}
+
+// recordAdminAction records a change made through the admin API
+func recordAdminAction(req *http.Request, eventType, actor, userID string) {
+	recordAdminChange(req, audit.Event{Type: eventType, UserID: userID}, actor)
+}
+
+// recordAdminChange records a change made through the admin API, which may not affect a user
+func recordAdminChange(req *http.Request, e audit.Event, actor string) {
+	e.ClientIP = remoteIP(req)
+	e.Decision = audit.DecisionAllow
+	e.Actor = actor
+	audit.Record(e)
+}
@@ -0,0 +1,57 @@
+package server
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gbolo/protego/audit"
+)
+
+func TestRateLimiter(t *testing.T) {
+	limiter := newRateLimiter(2, time.Second)
+	start := time.Now()
+	for i, expected := range []bool{true, true, false, false} {
+		if allowed, dropped := limiter.allow(start.Add(time.Duration(i) * time.Millisecond)); allowed != expected || dropped != 0 {
+			t.Fatalf("expected event %d to be allowed: %v, got %v (dropped: %d)", i, expected, allowed, dropped)
+		}
+	}
+	// the events which were not allowed are reported once the interval is over
+	if allowed, dropped := limiter.allow(start.Add(time.Second)); !allowed || dropped != 2 {
+		t.Fatalf("expected an event of the next interval to be allowed and 2 dropped events, got %v and %d", allowed, dropped)
+	}
+	if _, dropped := limiter.allow(start.Add(time.Second)); dropped != 0 {
+		t.Fatalf("expected dropped events to be reported once, got %d", dropped)
+	}
+}
+
+func TestRecordAuthorizeUnknownLimit(t *testing.T) {
+	setupTestProvider(t)
+	events := recordEvents(t)
+	unknownDenies = newRateLimiter(maxUnknownDeniesPerSecond, time.Hour)
+	t.Cleanup(func() { unknownDenies = newRateLimiter(maxUnknownDeniesPerSecond, time.Second) })
+	addTestUser(t, "secret123", []string{"a.example.com"}, "10.0.0.1")
+
+	for i := 0; i < 2*maxUnknownDeniesPerSecond; i++ {
+		if status := authorize("10.0.1.1", "a.example.com"); status != http.StatusUnauthorized {
+			t.Fatalf("expected an unknown IP to be denied, got %d", status)
+		}
+	}
+	// denies of known users are always audited
+	for i := 0; i < 3; i++ {
+		authorize("10.0.0.1", "b.example.com")
+	}
+
+	unknown, known := 0, 0
+	for _, e := range events.ofType(audit.EventAuthorize) {
+		switch e.Reason {
+		case reasonUnknownIP:
+			unknown++
+		case reasonHostNotAllowed:
+			known++
+		}
+	}
+	if unknown != maxUnknownDeniesPerSecond || known != 3 {
+		t.Fatalf("expected %d denies of the unknown IP and 3 of the user to be audited, got %d and %d", maxUnknownDeniesPerSecond, unknown, known)
+	}
+}
@@ -0,0 +1,163 @@
+package server
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+
+	"github.com/gbolo/protego/audit"
+	"github.com/gbolo/protego/config"
+	"github.com/gbolo/protego/dataprovider"
+	"github.com/oschwald/maxminddb-golang"
+)
+
+// what happens when a challenge comes from a country its user is not allowed in
+const (
+	// the challenge is rejected (default)
+	geoipPolicyReject = "reject"
+	// the challenge is accepted, and flagged in the audit log
+	geoipPolicyFlag = "flag"
+)
+
+// the GeoIP database used to locate clients
+var geoipDB = &geoipDatabase{lock: new(sync.RWMutex)}
+
+// geoipDatabase holds a MaxMind (GeoLite2 or GeoIP2) database which can be swapped,
+// so that an updated database file is picked up by a reload
+type geoipDatabase struct {
+	reader *maxminddb.Reader
+	lock   *sync.RWMutex
+}
+
+// the fields of a MaxMind country or city record which are needed.
+// the city is only known with a city database
+type geoipRecord struct {
+	Country struct {
+		ISOCode string `maxminddb:"iso_code"`
+	} `maxminddb:"country"`
+	City struct {
+		Names map[string]string `maxminddb:"names"`
+	} `maxminddb:"city"`
+}
+
+// load opens the configured database. The current database is kept on failure.
+func (g *geoipDatabase) load() error {
+	log.Debugf("loading GeoIP database: %s", config.GetString("geoip.database"))
+	reader, err := maxminddb.Open(config.GetString("geoip.database"))
+	if err != nil {
+		return err
+	}
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	if g.reader != nil {
+		g.reader.Close()
+	}
+	g.reader = reader
+	return nil
+}
+
+// close closes the database, countries can no longer be looked up
+func (g *geoipDatabase) close() {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	if g.reader != nil {
+		g.reader.Close()
+		g.reader = nil
+	}
+}
+
+// country returns the ISO code of the country ip is located in.
+// it is empty when the database does not know ip (like private addresses)
+func (g *geoipDatabase) country(ip string) (string, error) {
+	record, err := g.lookup(ip)
+	if err != nil {
+		return "", err
+	}
+	return record.Country.ISOCode, nil
+}
+
+// city returns the (english) name of the city ip is located in.
+// it is empty when the database does not know ip, or is not a city database
+func (g *geoipDatabase) city(ip string) (string, error) {
+	record, err := g.lookup(ip)
+	if err != nil {
+		return "", err
+	}
+	return record.City.Names["en"], nil
+}
+
+// lookup returns the record of ip
+func (g *geoipDatabase) lookup(ip string) (record geoipRecord, err error) {
+	g.lock.RLock()
+	defer g.lock.RUnlock()
+	if g.reader == nil {
+		return record, fmt.Errorf("geoip is not enabled")
+	}
+	err = g.reader.Lookup(net.ParseIP(ip), &record)
+	return
+}
+
+// locate returns the ISO code of the country clientIP is located in, or an empty
+// string when it is unknown (or GeoIP is not enabled)
+func locate(clientIP string) string {
+	country, err := geoipDB.country(clientIP)
+	if err != nil {
+		return ""
+	}
+	return country
+}
+
+// loadGeoIP opens or closes the GeoIP database, depending on geoip.enabled
+func loadGeoIP() error {
+	if !config.GetBool("geoip.enabled") {
+		geoipDB.close()
+		return nil
+	}
+	return geoipDB.load()
+}
+
+// checkCountry returns the reason clientIP is denied by allowed (the check of a user or host policy),
+// or an empty string. The country of clientIP is only looked up when it is restricted.
+func checkCountry(clientIP string, allowed func(country string) error) (country, reason string) {
+	if allowed("") == nil {
+		return "", ""
+	}
+	country, err := geoipDB.country(clientIP)
+	if err != nil {
+		log.Errorf("unable to locate client (%s): %v", clientIP, err)
+		return "", reasonInternalError
+	}
+	if allowed(country) != nil {
+		return country, reasonCountryNotAllowed
+	}
+	return country, ""
+}
+
+// checkUserCountry returns the reason a user is denied access from clientIP, or an empty string.
+// with geoip.challenge_policy set to flag, users are not denied but challenges are flagged
+func checkUserCountry(clientIP string, user *dataprovider.User) string {
+	_, reason := checkCountry(clientIP, user.CheckCountry)
+	if reason == reasonCountryNotAllowed && geoipFlagsCountries() {
+		return ""
+	}
+	return reason
+}
+
+// geoipFlagsCountries returns true when users are not denied access from countries
+// they are not allowed in, but their challenges are flagged in the audit log instead
+func geoipFlagsCountries() bool {
+	return strings.EqualFold(config.GetString("geoip.challenge_policy"), geoipPolicyFlag)
+}
+
+// recordCountryFlag records a challenge which was accepted from a country its user is not allowed in
+func recordCountryFlag(clientIP, userID, country string) {
+	audit.Record(audit.Event{
+		Type:     audit.EventChallengeFlag,
+		ClientIP: clientIP,
+		UserID:   userID,
+		Decision: audit.DecisionAllow,
+		Reason:   reasonCountryNotAllowed,
+		Country:  country,
+	})
+}
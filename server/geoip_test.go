@@ -0,0 +1,150 @@
+package server
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/gbolo/protego/audit"
+	"github.com/gbolo/protego/dataprovider"
+	"github.com/spf13/viper"
+)
+
+// the test database (see testdata/scripts/generate_geoip.py) locates:
+//
+//	81.2.69.0/24    GB, London
+//	89.160.20.0/24  SE, Linkoping
+//	216.160.83.0/24 US, without a city
+const testGeoIPDatabase = "../testdata/geoip/test-city.mmdb"
+
+// setupTestGeoIP enables GeoIP with the test database and policy, until the test ends
+func setupTestGeoIP(tb testing.TB, policy string) {
+	tb.Helper()
+	viper.Set("geoip.enabled", true)
+	viper.Set("geoip.database", testGeoIPDatabase)
+	viper.Set("geoip.challenge_policy", policy)
+	if err := loadGeoIP(); err != nil {
+		tb.Fatalf("unable to load GeoIP database: %v", err)
+	}
+	tb.Cleanup(func() {
+		viper.Set("geoip.enabled", false)
+		viper.Set("geoip.challenge_policy", geoipPolicyReject)
+		geoipDB.close()
+	})
+}
+
+func TestGeoIPLookup(t *testing.T) {
+	if _, err := geoipDB.country("81.2.69.1"); err == nil {
+		t.Fatal("expected an error while GeoIP is not enabled")
+	}
+	if country := locate("81.2.69.1"); country != "" {
+		t.Fatalf("expected no country while GeoIP is not enabled, got %s", country)
+	}
+	setupTestGeoIP(t, geoipPolicyReject)
+
+	for _, test := range []struct {
+		ip, country, city string
+	}{
+		{"81.2.69.1", "GB", "London"},
+		{"::ffff:89.160.20.200", "SE", "Linkoping"},
+		{"216.160.83.9", "US", ""},
+		{"10.0.0.1", "", ""},
+	} {
+		if country := locate(test.ip); country != test.country {
+			t.Errorf("expected %s to be located in %q, got %q", test.ip, test.country, country)
+		}
+		if city, err := geoipDB.city(test.ip); err != nil || city != test.city {
+			t.Errorf("expected %s to be located in the city %q, got %q (error: %v)", test.ip, test.city, city, err)
+		}
+	}
+}
+
+func TestCheckCountry(t *testing.T) {
+	restrict := func(countries ...string) func(string) error {
+		return (&dataprovider.User{AllowedCountries: countries}).CheckCountry
+	}
+	tests := []struct {
+		name     string
+		enabled  bool
+		clientIP string
+		allowed  func(string) error
+		country  string
+		reason   string
+	}{
+		{"unrestricted without geoip", false, "81.2.69.1", restrict(), "", ""},
+		{"restricted without geoip", false, "81.2.69.1", restrict("GB"), "", reasonInternalError},
+		{"unrestricted is not located", true, "81.2.69.1", restrict(), "", ""},
+		{"allowed country", true, "81.2.69.1", restrict("FR", "GB"), "GB", ""},
+		{"other country", true, "89.160.20.1", restrict("GB"), "SE", reasonCountryNotAllowed},
+		{"unknown country", true, "10.0.0.1", restrict("GB"), "", reasonCountryNotAllowed},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.enabled {
+				setupTestGeoIP(t, geoipPolicyReject)
+			}
+			country, reason := checkCountry(test.clientIP, test.allowed)
+			if country != test.country || reason != test.reason {
+				t.Fatalf("expected country %q and reason %q, got %q and %q", test.country, test.reason, country, reason)
+			}
+		})
+	}
+}
+
+func TestChallengeCountryPolicy(t *testing.T) {
+	tests := []struct {
+		policy string
+		// the status of a challenge from another country, and whether it is flagged
+		status  int
+		flagged bool
+	}{
+		{geoipPolicyReject, http.StatusForbidden, false},
+		{geoipPolicyFlag, http.StatusAccepted, true},
+	}
+	for _, test := range tests {
+		t.Run(test.policy, func(t *testing.T) {
+			setupTestProvider(t)
+			setupTestGeoIP(t, test.policy)
+			events := recordEvents(t)
+			u := addTestUser(t, "secret123", []string{"a.example.com"})
+			u.AllowedCountries = []string{"GB"}
+			if err := dataProvider.UpdateUser(u); err != nil {
+				t.Fatal(err)
+			}
+
+			// the allowed country is never flagged
+			if status := challenge("81.2.69.1", "secret123"); status != http.StatusAccepted {
+				t.Fatalf("expected a challenge from GB to be accepted, got %d", status)
+			}
+			if status := authorize("81.2.69.1", "a.example.com"); status != http.StatusOK {
+				t.Fatalf("expected access from GB, got %d", status)
+			}
+
+			if status := challenge("89.160.20.1", "secret123"); status != test.status {
+				t.Fatalf("expected a challenge from SE to return %d, got %d", test.status, status)
+			}
+			flags := events.ofType(audit.EventChallengeFlag)
+			switch {
+			case !test.flagged && len(flags) > 0:
+				t.Fatalf("expected no flagged challenge, got %v", flags)
+			case test.flagged && (len(flags) != 1 || flags[0].ClientIP != "89.160.20.1" || flags[0].Country != "SE" || flags[0].UserID != u.ID):
+				t.Fatalf("expected the challenge from SE to be flagged, got %v", flags)
+			}
+
+			// the IP is only whitelisted when the challenge is accepted
+			acl, err := dataProvider.GetACL("89.160.20.1")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if (acl != nil) != test.flagged {
+				t.Fatalf("expected an ACL for the IP in SE: %v, got %v", test.flagged, acl)
+			}
+			expected := http.StatusUnauthorized
+			if test.flagged {
+				expected = http.StatusOK
+			}
+			if status := authorize("89.160.20.1", "a.example.com"); status != expected {
+				t.Fatalf("expected access from SE to return %d, got %d", expected, status)
+			}
+		})
+	}
+}
@@ -0,0 +1,439 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/gbolo/protego/audit"
+	"github.com/gbolo/protego/dataprovider"
+	"github.com/gorilla/mux"
+)
+
+// checkGroupsExist returns an error for the first group which does not exist
+func checkGroupsExist(groups []string) error {
+	for _, name := range groups {
+		group, err := dataProvider.GetGroup(name)
+		if err != nil {
+			return err
+		}
+		if group == nil {
+			return fmt.Errorf("group does not exist: %s", name)
+		}
+	}
+	return nil
+}
+
+// checkHostSetsExist returns an error for the first host set which does not exist
+func checkHostSetsExist(hostSets []string) error {
+	for _, name := range hostSets {
+		hostSet, err := dataProvider.GetHostSet(name)
+		if err != nil {
+			return err
+		}
+		if hostSet == nil {
+			return fmt.Errorf("host set does not exist: %s", name)
+		}
+	}
+	return nil
+}
+
+// without returns values, except value
+func without(values []string, value string) (result []string) {
+	for _, v := range values {
+		if v != value {
+			result = append(result, v)
+		}
+	}
+	return
+}
+
+// decodeGroup reads a group from the request body, the name is taken from the path when present
+func decodeGroup(req *http.Request) (group *dataprovider.Group, err error) {
+	group = &dataprovider.Group{}
+	if err = json.NewDecoder(req.Body).Decode(group); err != nil {
+		return nil, err
+	}
+	if name, ok := mux.Vars(req)["name"]; ok {
+		group.Name = name
+	}
+	if err = group.Validate(); err != nil {
+		return nil, err
+	}
+	return group, checkHostSetsExist(group.HostSets)
+}
+
+// decodeHostSet reads a host set from the request body, the name is taken from the path when present
+func decodeHostSet(req *http.Request) (hostSet *dataprovider.HostSet, err error) {
+	hostSet = &dataprovider.HostSet{}
+	if err = json.NewDecoder(req.Body).Decode(hostSet); err != nil {
+		return nil, err
+	}
+	if name, ok := mux.Vars(req)["name"]; ok {
+		hostSet.Name = name
+	}
+	return hostSet, hostSet.Validate()
+}
+
+// handlerGroupAdd godoc
+// @Summary Add a new Group
+// @Description add a group of users, which is granted access to host sets
+// @Tags Group
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "Bearer followed by an admin API token or the admin secret"
+// @Param group body dataprovider.Group true "Add Group"
+// @Success 200 {object} dataprovider.Group
+// @Failure 400 "bad request: the group is invalid or a host set does not exist" {object} errorResponse
+// @Failure 409 "a group with this name already exists" {object} errorResponse
+// @Router /group [post]
+func handlerGroupAdd(w http.ResponseWriter, req *http.Request) {
+	// validate admin credentials
+	actor, ok := authenticateAdmin(w, req, dataprovider.ScopeUsersWrite)
+	if !ok {
+		return
+	}
+
+	group, err := decodeGroup(req)
+	if err != nil {
+		writeJSONResponse(w, http.StatusBadRequest, errorResponse{"Bad request: " + err.Error()})
+		return
+	}
+	err = dataProvider.AddGroup(group)
+	switch {
+	case err == dataprovider.ErrGroupExists:
+		writeJSONResponse(w, http.StatusConflict, errorResponse{fmt.Sprintf("Group already exists (name: %s). Try Modifying it", group.Name)})
+		return
+	case err != nil:
+		log.Errorf("couldn't add new group: %v", err)
+		writeJSONResponse(w, http.StatusInternalServerError, errorResponse{"Could not add group"})
+		return
+	}
+
+	// group has been added
+	log.Infof("new group has been added: %s", group.Name)
+	recordAdminChange(req, audit.Event{Type: audit.EventGroupAdd, Target: group.Name}, actor)
+	writeJSONResponse(w, http.StatusOK, group)
+}
+
+// handlerGroupUpdate godoc
+// @Summary Update an existing Group
+// @Description update a group, the change applies to IPs which are already whitelisted
+// @Tags Group
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "Bearer followed by an admin API token or the admin secret"
+// @Param name path string true "Group name"
+// @Param group body dataprovider.Group true "Update Group"
+// @Success 200 {object} dataprovider.Group
+// @Failure 400 "bad request: the group is invalid or was not found" {object} errorResponse
+// @Router /group/{name} [put]
+func handlerGroupUpdate(w http.ResponseWriter, req *http.Request) {
+	// validate admin credentials
+	actor, ok := authenticateAdmin(w, req, dataprovider.ScopeUsersWrite)
+	if !ok {
+		return
+	}
+
+	group, err := decodeGroup(req)
+	if err != nil {
+		writeJSONResponse(w, http.StatusBadRequest, errorResponse{"Bad request: " + err.Error()})
+		return
+	}
+	err = dataProvider.UpdateGroup(group)
+	switch {
+	case err == dataprovider.ErrGroupNotFound:
+		writeJSONResponse(w, http.StatusBadRequest, errorResponse{"group was not found"})
+		return
+	case err != nil:
+		log.Errorf("could not update group: %v", err)
+		writeJSONResponse(w, http.StatusInternalServerError, errorResponse{"Could not update group"})
+		return
+	}
+
+	// group has been updated
+	log.Infof("group has been updated: %s", group.Name)
+	recordAdminChange(req, audit.Event{Type: audit.EventGroupUpdate, Target: group.Name}, actor)
+	writeJSONResponse(w, http.StatusOK, group)
+}
+
+// handlerGroupGet godoc
+// @Summary Retrieve a Group based on provided name
+// @Description get Group by name
+// @Tags Group
+// @Produce json
+// @Param Authorization header string true "Bearer followed by an admin API token or the admin secret"
+// @Param name path string true "Group name"
+// @Success 200 {object} dataprovider.Group
+// @Router /group/{name} [get]
+func handlerGroupGet(w http.ResponseWriter, req *http.Request) {
+	// validate admin credentials
+	if _, ok := authenticateAdmin(w, req, dataprovider.ScopeUsersRead); !ok {
+		return
+	}
+
+	name := mux.Vars(req)["name"]
+	group, err := dataProvider.GetGroup(name)
+	if group == nil || err != nil {
+		log.Warningf("group was not found: %s", name)
+		writeJSONResponse(w, http.StatusBadRequest, errorResponse{"group was not found"})
+		return
+	}
+	writeJSONResponse(w, http.StatusOK, group)
+}
+
+// handlerGroupGetAll godoc
+// @Summary Retrieve all Groups
+// @Description get all Groups
+// @Tags Group
+// @Produce json
+// @Param Authorization header string true "Bearer followed by an admin API token or the admin secret"
+// @Success 200 {array} dataprovider.Group
+// @Router /group [get]
+func handlerGroupGetAll(w http.ResponseWriter, req *http.Request) {
+	// validate admin credentials
+	if _, ok := authenticateAdmin(w, req, dataprovider.ScopeUsersRead); !ok {
+		return
+	}
+
+	groups, err := dataProvider.GetAllGroups()
+	if err != nil {
+		log.Warningf("could not get all groups: %v", err)
+		writeJSONResponse(w, http.StatusServiceUnavailable, errorResponse{"could not retrieve all groups"})
+		return
+	}
+	sort.Slice(groups, func(i, j int) bool { return groups[i].Name < groups[j].Name })
+	writeJSONResponse(w, http.StatusOK, groups)
+}
+
+// handlerGroupDelete godoc
+// @Summary Remove a Group based on provided name
+// @Description remove a Group by name, its members are removed from it
+// @Tags Group
+// @Produce json
+// @Param Authorization header string true "Bearer followed by an admin API token or the admin secret"
+// @Param name path string true "Group name"
+// @Success 200 {object} dataprovider.Group
+// @Router /group/{name} [delete]
+func handlerGroupDelete(w http.ResponseWriter, req *http.Request) {
+	// validate admin credentials
+	actor, ok := authenticateAdmin(w, req, dataprovider.ScopeUsersWrite)
+	if !ok {
+		return
+	}
+
+	name := mux.Vars(req)["name"]
+	group, err := dataProvider.GetGroup(name)
+	if group == nil || err != nil {
+		log.Warningf("group was not found: %s", name)
+		writeJSONResponse(w, http.StatusBadRequest, errorResponse{"group was not found"})
+		return
+	}
+	if err = dataProvider.RemoveGroup(name); err != nil {
+		log.Warningf("unable to remove group %s: %v", name, err)
+		writeJSONResponse(w, http.StatusInternalServerError, errorResponse{"unable to remove group"})
+		return
+	}
+
+	// remove the group from its members
+	users, err := dataProvider.GetAllUsers()
+	if err != nil {
+		log.Errorf("unable to remove group %s from its members: %v", name, err)
+	}
+	for i := range users {
+		if len(without(users[i].Groups, name)) == len(users[i].Groups) {
+			continue
+		}
+		users[i].Groups = without(users[i].Groups, name)
+		if err = dataProvider.UpdateUser(&users[i]); err != nil {
+			log.Errorf("unable to remove group %s from user %s: %v", name, users[i].ID, err)
+		}
+	}
+
+	// group has been removed
+	log.Infof("group has been removed: %s", name)
+	recordAdminChange(req, audit.Event{Type: audit.EventGroupRemove, Target: name}, actor)
+	writeJSONResponse(w, http.StatusOK, group)
+}
+
+// handlerHostSetAdd godoc
+// @Summary Add a new HostSet
+// @Description add a named list of hosts, which can be granted to groups
+// @Tags HostSet
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "Bearer followed by an admin API token or the admin secret"
+// @Param hostset body dataprovider.HostSet true "Add HostSet"
+// @Success 200 {object} dataprovider.HostSet
+// @Failure 400 "bad request: the host set is invalid" {object} errorResponse
+// @Failure 409 "a host set with this name already exists" {object} errorResponse
+// @Router /hostset [post]
+func handlerHostSetAdd(w http.ResponseWriter, req *http.Request) {
+	// validate admin credentials
+	actor, ok := authenticateAdmin(w, req, dataprovider.ScopeUsersWrite)
+	if !ok {
+		return
+	}
+
+	hostSet, err := decodeHostSet(req)
+	if err != nil {
+		writeJSONResponse(w, http.StatusBadRequest, errorResponse{"Bad request: " + err.Error()})
+		return
+	}
+	err = dataProvider.AddHostSet(hostSet)
+	switch {
+	case err == dataprovider.ErrHostSetExists:
+		writeJSONResponse(w, http.StatusConflict, errorResponse{fmt.Sprintf("Host set already exists (name: %s). Try Modifying it", hostSet.Name)})
+		return
+	case err != nil:
+		log.Errorf("couldn't add new host set: %v", err)
+		writeJSONResponse(w, http.StatusInternalServerError, errorResponse{"Could not add host set"})
+		return
+	}
+
+	// host set has been added
+	log.Infof("new host set has been added: %s", hostSet.Name)
+	recordAdminChange(req, audit.Event{Type: audit.EventHostSetAdd, Target: hostSet.Name}, actor)
+	writeJSONResponse(w, http.StatusOK, hostSet)
+}
+
+// handlerHostSetUpdate godoc
+// @Summary Update an existing HostSet
+// @Description update a host set, the change applies to IPs which are already whitelisted
+// @Tags HostSet
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "Bearer followed by an admin API token or the admin secret"
+// @Param name path string true "HostSet name"
+// @Param hostset body dataprovider.HostSet true "Update HostSet"
+// @Success 200 {object} dataprovider.HostSet
+// @Failure 400 "bad request: the host set is invalid or was not found" {object} errorResponse
+// @Router /hostset/{name} [put]
+func handlerHostSetUpdate(w http.ResponseWriter, req *http.Request) {
+	// validate admin credentials
+	actor, ok := authenticateAdmin(w, req, dataprovider.ScopeUsersWrite)
+	if !ok {
+		return
+	}
+
+	hostSet, err := decodeHostSet(req)
+	if err != nil {
+		writeJSONResponse(w, http.StatusBadRequest, errorResponse{"Bad request: " + err.Error()})
+		return
+	}
+	err = dataProvider.UpdateHostSet(hostSet)
+	switch {
+	case err == dataprovider.ErrHostSetNotFound:
+		writeJSONResponse(w, http.StatusBadRequest, errorResponse{"host set was not found"})
+		return
+	case err != nil:
+		log.Errorf("could not update host set: %v", err)
+		writeJSONResponse(w, http.StatusInternalServerError, errorResponse{"Could not update host set"})
+		return
+	}
+
+	// host set has been updated
+	log.Infof("host set has been updated: %s", hostSet.Name)
+	recordAdminChange(req, audit.Event{Type: audit.EventHostSetUpdate, Target: hostSet.Name}, actor)
+	writeJSONResponse(w, http.StatusOK, hostSet)
+}
+
+// handlerHostSetGet godoc
+// @Summary Retrieve a HostSet based on provided name
+// @Description get HostSet by name
+// @Tags HostSet
+// @Produce json
+// @Param Authorization header string true "Bearer followed by an admin API token or the admin secret"
+// @Param name path string true "HostSet name"
+// @Success 200 {object} dataprovider.HostSet
+// @Router /hostset/{name} [get]
+func handlerHostSetGet(w http.ResponseWriter, req *http.Request) {
+	// validate admin credentials
+	if _, ok := authenticateAdmin(w, req, dataprovider.ScopeUsersRead); !ok {
+		return
+	}
+
+	name := mux.Vars(req)["name"]
+	hostSet, err := dataProvider.GetHostSet(name)
+	if hostSet == nil || err != nil {
+		log.Warningf("host set was not found: %s", name)
+		writeJSONResponse(w, http.StatusBadRequest, errorResponse{"host set was not found"})
+		return
+	}
+	writeJSONResponse(w, http.StatusOK, hostSet)
+}
+
+// handlerHostSetGetAll godoc
+// @Summary Retrieve all HostSets
+// @Description get all HostSets
+// @Tags HostSet
+// @Produce json
+// @Param Authorization header string true "Bearer followed by an admin API token or the admin secret"
+// @Success 200 {array} dataprovider.HostSet
+// @Router /hostset [get]
+func handlerHostSetGetAll(w http.ResponseWriter, req *http.Request) {
+	// validate admin credentials
+	if _, ok := authenticateAdmin(w, req, dataprovider.ScopeUsersRead); !ok {
+		return
+	}
+
+	hostSets, err := dataProvider.GetAllHostSets()
+	if err != nil {
+		log.Warningf("could not get all host sets: %v", err)
+		writeJSONResponse(w, http.StatusServiceUnavailable, errorResponse{"could not retrieve all host sets"})
+		return
+	}
+	sort.Slice(hostSets, func(i, j int) bool { return hostSets[i].Name < hostSets[j].Name })
+	writeJSONResponse(w, http.StatusOK, hostSets)
+}
+
+// handlerHostSetDelete godoc
+// @Summary Remove a HostSet based on provided name
+// @Description remove a HostSet by name, it is no longer granted to any group
+// @Tags HostSet
+// @Produce json
+// @Param Authorization header string true "Bearer followed by an admin API token or the admin secret"
+// @Param name path string true "HostSet name"
+// @Success 200 {object} dataprovider.HostSet
+// @Router /hostset/{name} [delete]
+func handlerHostSetDelete(w http.ResponseWriter, req *http.Request) {
+	// validate admin credentials
+	actor, ok := authenticateAdmin(w, req, dataprovider.ScopeUsersWrite)
+	if !ok {
+		return
+	}
+
+	name := mux.Vars(req)["name"]
+	hostSet, err := dataProvider.GetHostSet(name)
+	if hostSet == nil || err != nil {
+		log.Warningf("host set was not found: %s", name)
+		writeJSONResponse(w, http.StatusBadRequest, errorResponse{"host set was not found"})
+		return
+	}
+	if err = dataProvider.RemoveHostSet(name); err != nil {
+		log.Warningf("unable to remove host set %s: %v", name, err)
+		writeJSONResponse(w, http.StatusInternalServerError, errorResponse{"unable to remove host set"})
+		return
+	}
+
+	// remove the host set from the groups it is granted to
+	groups, err := dataProvider.GetAllGroups()
+	if err != nil {
+		log.Errorf("unable to remove host set %s from groups: %v", name, err)
+	}
+	for i := range groups {
+		if len(without(groups[i].HostSets, name)) == len(groups[i].HostSets) {
+			continue
+		}
+		groups[i].HostSets = without(groups[i].HostSets, name)
+		if err = dataProvider.UpdateGroup(&groups[i]); err != nil {
+			log.Errorf("unable to remove host set %s from group %s: %v", name, groups[i].Name, err)
+		}
+	}
+
+	// host set has been removed
+	log.Infof("host set has been removed: %s", name)
+	recordAdminChange(req, audit.Event{Type: audit.EventHostSetRemove, Target: name}, actor)
+	writeJSONResponse(w, http.StatusOK, hostSet)
+}
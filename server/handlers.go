@@ -5,12 +5,14 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"reflect"
+	"strconv"
 	"time"
 
 	validate "github.com/asaskevich/govalidator"
+	"github.com/gbolo/protego/audit"
 	"github.com/gbolo/protego/dataprovider"
 	"github.com/gorilla/mux"
-	"github.com/spf13/viper"
 )
 
 // @title Protego - REST API
@@ -53,17 +55,52 @@ func handlerAuthorize(w http.ResponseWriter, req *http.Request) {
 	clientIP := req.Header.Get("X-Real-IP")
 	if !validate.IsIP(clientIP) {
 		log.Errorf("X-Real-IP is either set incorrectly or missing! DENYING ACCESS")
+		recordAuthorize(req, remoteIP(req), "", false, reasonInvalidIP)
 		w.WriteHeader(http.StatusUnauthorized)
 		// additional logging for debug
 		log.Debugf("X-Real-IP is of length %d with value: %s", len(clientIP), clientIP)
 		return
 	}
+	// so that every form of an address has the same ACL and cached decisions
+	clientIP = dataprovider.NormalizeIP(clientIP)
+
+	// the decision may already be cached
+	d, cached, generation := decisions.get(clientIP, req.Host)
+	if !cached {
+		d = decideAuthorize(clientIP, req.Host)
+		decisions.put(clientIP, req.Host, d, generation)
+	}
+	recordAuthorize(req, clientIP, d.userID, d.allowed, d.reason)
+	if d.allowed {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	w.WriteHeader(http.StatusUnauthorized)
+}
+
+// decideAuthorize determines whether or not clientIP is allowed to access host
+func decideAuthorize(clientIP, host string) authorizeDecision {
+	now := time.Now()
+	deny := func(acl *dataprovider.ACL, reason string) authorizeDecision {
+		d := authorizeDecision{reason: reason, expires: decisionExpiry(acl, now)}
+		if acl != nil {
+			d.userID = acl.UserID
+		}
+		return d
+	}
+
+	// blocked IPs are denied, whatever their ACL allows
+	if reason := checkDenyList(clientIP); reason != "" {
+		return deny(nil, reason)
+	}
 
 	// lookup this client ip. Deny access if we don't have it
 	acl, err := dataProvider.GetACL(clientIP)
 	if err != nil {
 		log.Warningf("error during dataProvider.GetACL: %v", err)
 	}
+	// only ACLs of the data provider can have a sliding TTL
+	stored := acl != nil
 	// check the dynamic DNS provider if acl is nil
 	if acl == nil {
 		acl = ddnsProvider.GetACL(clientIP)
@@ -71,26 +108,41 @@ func handlerAuthorize(w http.ResponseWriter, req *http.Request) {
 	// if neither provider can find the IP it's blocked
 	if acl == nil {
 		log.Debugf("client (%s) is unknown", clientIP)
-		w.WriteHeader(http.StatusUnauthorized)
-		return
+		return deny(nil, reasonUnknownIP)
 	}
 
 	// the client IP is in our database, now check what hosts it can access
-	if acl.AllowAll {
+	perm, reason := aclPermissions(clientIP, acl)
+	if reason != "" {
+		log.Debugf("client (%s) DENIED access: %s", clientIP, reason)
+		return deny(acl, reason)
+	}
+	// the policy of the host applies on top of what the client is allowed to access
+	if perm.AllowAll || perm.CheckHost(host) {
+		if reason = checkHostPolicy(host, clientIP, acl, &perm); reason != "" {
+			log.Debugf("client (%s) DENIED access to host %s by its policy: %s", clientIP, host, reason)
+			return deny(acl, reason)
+		}
+	}
+	allow := func(reason string) authorizeDecision {
+		if stored {
+			renewACL(clientIP, acl)
+		}
+		return authorizeDecision{allowed: true, reason: reason, userID: acl.UserID, expires: decisionExpiry(acl, now)}
+	}
+	if perm.AllowAll {
 		log.Debugf("client (%s) has ALLOW_ALL privileges", clientIP)
-		w.WriteHeader(http.StatusOK)
-		return
+		return allow(reasonAllowAll)
 	}
-	log.Debugf("client host acl: %v", acl.AllowedHosts)
-	if acl.CheckHost(req.Host) {
-		log.Debugf("client (%s) ALLOWED access to host %s", clientIP, req.Host)
-		w.WriteHeader(http.StatusOK)
-		return
+	log.Debugf("client host acl: %v", perm.Hosts)
+	if perm.CheckHost(host) {
+		log.Debugf("client (%s) ALLOWED access to host %s", clientIP, host)
+		return allow(reasonHostAllowed)
 	}
 
 	// by default we deny everything
-	log.Debugf("client (%s) DENIED access to host %s", clientIP, req.Host)
-	w.WriteHeader(http.StatusUnauthorized)
+	log.Debugf("client (%s) DENIED access to host %s", clientIP, host)
+	return deny(acl, reasonHostNotAllowed)
 }
 
 // handlerChallenge godoc
@@ -100,9 +152,11 @@ func handlerAuthorize(w http.ResponseWriter, req *http.Request) {
 // @Produce  json
 // @Param X-Real-IP header string true "IP address of the user"
 // @Param User-Secret header string true "Secret that was given to/by the user"
+// @Param User-TOTP header string false "Current TOTP code, required when the user is enrolled in TOTP"
 // @Success 200 "challenge was accepted: the value of X-Real-IP has been granted an ACL" {object} challengeResponse
 // @Failure 400 "bad request: X-Real-IP is not set" {object} errorResponse
 // @Failure 401 "unauthorized: the user secret is incorrect or the user is disabled" {object} errorResponse
+// @Failure 403 "forbidden: the value of X-Real-IP is blocked, or located in a country the user is not allowed in" {object} errorResponse
 // @Failure 500 "server could not process the request" {object} errorResponse
 // @Router /challenge [post]
 func handlerChallenge(w http.ResponseWriter, req *http.Request) {
@@ -113,17 +167,29 @@ func handlerChallenge(w http.ResponseWriter, req *http.Request) {
 	clientIP := req.Header.Get("X-Real-IP")
 	if !validate.IsIP(clientIP) {
 		log.Errorf("X-Real-IP is either set incorrectly or missing! DENYING ACCESS")
+		recordChallenge(remoteIP(req), "", false, reasonInvalidIP)
 		writeJSONResponse(w, http.StatusBadRequest, errorResponse{"Unable to properly determine user's IP address"})
 		// additional logging for debug
 		log.Debugf("X-Real-IP is of length %d with value: %s", len(clientIP), clientIP)
 		return
 	}
+	// so that every form of an address has the same ACL and cached decisions
+	clientIP = dataprovider.NormalizeIP(clientIP)
+
+	// blocked IPs can not pass a challenge, whatever secret they provide
+	if reason := checkDenyList(clientIP); reason != "" {
+		log.Infof("user %s was denied due to being blocked", clientIP)
+		recordChallenge(clientIP, "", false, reason)
+		writeJSONResponse(w, http.StatusForbidden, errorResponse{"access from this IP address is blocked"})
+		return
+	}
 
 	// now we check if the actualUser provided a secret
 	clientSecret := req.Header.Get("User-Secret")
 	user, err := dataprovider.NewUser(clientSecret, "")
 	if err == dataprovider.ErrSecretLength {
 		log.Infof("user %s was denied due to challenge failure", clientIP)
+		recordChallenge(clientIP, "", false, reasonInvalidSecret)
 		writeJSONResponse(w, http.StatusUnauthorized, errorResponse{"User-Secret is incorrect"})
 		return
 	}
@@ -132,6 +198,7 @@ func handlerChallenge(w http.ResponseWriter, req *http.Request) {
 	actualUser, err := dataProvider.GetUser(user.ID)
 	if actualUser == nil || err != nil {
 		log.Infof("user %s was denied due to incorrect secret", clientIP)
+		recordChallenge(clientIP, "", false, reasonUnknownUser)
 		writeJSONResponse(w, http.StatusUnauthorized, errorResponse{"unable to find user"})
 		return
 	}
@@ -139,29 +206,164 @@ func handlerChallenge(w http.ResponseWriter, req *http.Request) {
 	// deny the actualUser if it is disabled
 	if !actualUser.Enabled {
 		log.Infof("user %s was denied due to being disabled", clientIP)
+		recordChallenge(clientIP, actualUser.ID, false, reasonUserDisabled)
 		writeJSONResponse(w, http.StatusUnauthorized, errorResponse{"this user is currently disabled"})
 		return
 	}
 
+	// deny the actualUser outside of its validity dates and access windows
+	if reason := checkAccessTime(actualUser); reason != "" {
+		log.Infof("user %s was denied due to %s", clientIP, reason)
+		recordChallenge(clientIP, actualUser.ID, false, reason)
+		writeJSONResponse(w, http.StatusUnauthorized, errorResponse{"this user can not be used at this time"})
+		return
+	}
+
+	// the actualUser must also provide its current TOTP code when it is enrolled in TOTP.
+	// a code can only be used once, so that an intercepted code can not be replayed.
+	// it is only recorded as used once every other check has passed (see below)
+	var totpCounter int64
+	if actualUser.TOTPSecret != "" {
+		counter, ok := dataprovider.CheckTOTP(actualUser.TOTPSecret, req.Header.Get("User-TOTP"), time.Now())
+		reason := reasonInvalidTOTP
+		switch {
+		case ok && counter <= actualUser.TOTPCounter:
+			reason = reasonTOTPReplayed
+		case ok:
+			reason = ""
+			totpCounter = counter
+		}
+		if reason != "" {
+			log.Infof("user %s was denied due to an incorrect TOTP code (%s)", clientIP, reason)
+			recordChallenge(clientIP, actualUser.ID, false, reason)
+			writeJSONResponse(w, http.StatusUnauthorized, errorResponse{"User-TOTP is incorrect"})
+			return
+		}
+	}
+
+	// the actualUser may be restricted to some countries. A leaked secret used from
+	// elsewhere is either rejected or flagged, depending on geoip.challenge_policy
+	country, reason := checkCountry(clientIP, actualUser.CheckCountry)
+	flagged := reason == reasonCountryNotAllowed && geoipFlagsCountries()
+	switch {
+	case flagged:
+		log.Warningf("user %s with IP (%s) is passing a challenge from a country which is not allowed: %s", actualUser.ID, clientIP, country)
+	case reason == reasonCountryNotAllowed:
+		log.Infof("user %s was denied due to its country (%s)", clientIP, country)
+		recordChallenge(clientIP, actualUser.ID, false, reason)
+		writeJSONResponse(w, http.StatusForbidden, errorResponse{"access from this country is not allowed"})
+		return
+	case reason != "":
+		recordChallenge(clientIP, actualUser.ID, false, reason)
+		writeJSONResponse(w, http.StatusInternalServerError, errorResponse{"there was an error handling this request"})
+		return
+	}
+
+	// resolve what this actualUser is allowed to access, including its groups
+	perm, err := dataprovider.ResolvePermissions(dataProvider, actualUser)
+	if err != nil {
+		log.Errorf("unable to resolve permissions of user %s: %v", actualUser.ID, err)
+		recordChallenge(clientIP, actualUser.ID, false, reasonInternalError)
+		writeJSONResponse(w, http.StatusInternalServerError, errorResponse{"there was an error handling this request"})
+		return
+	}
+
 	// add this actualUser's IP to whitelist
+	now := time.Now()
 	acl := dataprovider.ACL{
-		AllowAll:     actualUser.ACLAllowAll,
-		AllowedHosts: actualUser.ACLAllowedHosts,
+		AllowAll:     perm.AllowAll,
+		AllowedHosts: perm.Hosts,
+		Source:       dataprovider.ACLSourceChallenge,
+		UserID:       actualUser.ID,
+		ChallengedAt: &now,
+		TOTPVerified: actualUser.TOTPSecret != "",
 	}
 	if actualUser.TTLMinutes > 0 {
-		ttl := time.Now().Add(time.Duration(actualUser.TTLMinutes) * time.Minute)
+		ttl := now.Add(time.Duration(actualUser.TTLMinutes) * time.Minute)
 		acl.TTL = &ttl
-		log.Infof("set user IP (%s) TTL to: %v", clientIP, ttl)
+		if actualUser.SlidingTTL {
+			acl.SlidingMinutes = actualUser.TTLMinutes
+		}
+	}
+	if acl.SlidingMinutes > 0 && actualUser.MaxTTLMinutes > 0 {
+		maxTTL := now.Add(time.Duration(actualUser.MaxTTLMinutes) * time.Minute)
+		acl.MaxTTL = &maxTTL
+	}
+	// the whitelisting never outlives the actualUser itself
+	if actualUser.ValidUntil != nil && (acl.TTL == nil || actualUser.ValidUntil.Before(*acl.TTL)) {
+		acl.TTL = actualUser.ValidUntil
+	}
+	if acl.SlidingMinutes > 0 && actualUser.ValidUntil != nil && (acl.MaxTTL == nil || actualUser.ValidUntil.Before(*acl.MaxTTL)) {
+		acl.MaxTTL = actualUser.ValidUntil
+	}
+	// nor the max TTL of the policies of every host it grants
+	policyTTL, limited, err := dataprovider.PolicyTTL(dataProvider, &perm, now)
+	if err != nil {
+		log.Errorf("unable to retrieve host policies: %v", err)
+		recordChallenge(clientIP, actualUser.ID, false, reasonInternalError)
+		writeJSONResponse(w, http.StatusInternalServerError, errorResponse{"there was an error handling this request"})
+		return
+	}
+	if limited && (acl.TTL == nil || policyTTL.Before(*acl.TTL)) {
+		acl.TTL = &policyTTL
+	}
+	if limited && acl.SlidingMinutes > 0 && (acl.MaxTTL == nil || policyTTL.Before(*acl.MaxTTL)) {
+		acl.MaxTTL = &policyTTL
+	}
+	if acl.TTL != nil {
+		log.Infof("set user IP (%s) TTL to: %v", clientIP, *acl.TTL)
+	}
+
+	// the TOTP code is recorded as used last, so that a challenge refused for another reason does not spend it.
+	// UseTOTPCounter also refuses a code which was used concurrently since it was checked
+	if totpCounter > 0 {
+		err = dataProvider.UseTOTPCounter(actualUser.ID, totpCounter)
+		switch {
+		case err == dataprovider.ErrTOTPReplayed:
+			log.Infof("user %s was denied due to an incorrect TOTP code (%s)", clientIP, reasonTOTPReplayed)
+			recordChallenge(clientIP, actualUser.ID, false, reasonTOTPReplayed)
+			writeJSONResponse(w, http.StatusUnauthorized, errorResponse{"User-TOTP is incorrect"})
+			return
+		case err != nil:
+			log.Errorf("unable to record TOTP code of user %s: %v", actualUser.ID, err)
+			recordChallenge(clientIP, actualUser.ID, false, reasonInternalError)
+			writeJSONResponse(w, http.StatusInternalServerError, errorResponse{"there was an error handling this request"})
+			return
+		}
+	}
+
+	// a challenge from an IP which was not whitelisted for this user is recorded (see recordNewIP)
+	newIP, evicted, err := dataProvider.AddUserIp(clientIP, &acl)
+	if err == dataprovider.ErrMaxIPsReached {
+		log.Infof("user %s was denied due to reaching its max IPs (%d)", clientIP, actualUser.MaxIPs)
+		recordChallenge(clientIP, actualUser.ID, false, reasonMaxIPsReached)
+		writeJSONResponse(w, http.StatusUnauthorized, errorResponse{"this user has reached its maximum number of IPs"})
+		return
 	}
-	err = dataProvider.AddIp(clientIP, &acl)
 	if err != nil {
 		log.Errorf("unable to add ACL to DB: %s", err)
+		recordChallenge(clientIP, actualUser.ID, false, reasonInternalError)
 		writeJSONResponse(w, http.StatusInternalServerError, errorResponse{"there was an error handling this request"})
 		return
 	}
 
+	for _, ip := range evicted {
+		log.Infof("user %s IP (%s) has been evicted from ACL, since the user reached its max IPs", actualUser.ID, ip)
+		recordEviction(clientIP, actualUser.ID, ip)
+	}
+
 	// successful response
 	log.Infof("user %s with IP (%s) has been added to ACL", user.ID, clientIP)
+	recordChallenge(clientIP, actualUser.ID, true, reasonAccessGranted)
+	if flagged {
+		recordCountryFlag(clientIP, actualUser.ID, country)
+	}
+	if newIP {
+		if country == "" {
+			country = locate(clientIP)
+		}
+		recordNewIP(clientIP, actualUser.ID, country)
+	}
 	apiResponse := challengeResponse{
 		Message:   "access has been granted",
 		UserId:    actualUser.ID,
@@ -178,15 +380,14 @@ func handlerChallenge(w http.ResponseWriter, req *http.Request) {
 // @Tags User
 // @Accept  json
 // @Produce  json
-// @Param Admin-Secret header string true "Admin Secret"
+// @Param Authorization header string true "Bearer followed by an admin API token or the admin secret"
 // @Param user body server.addUser true "Add User"
 // @Success 200 {object} server.getUser
 // @Router /user [post]
 func handlerUserAdd(w http.ResponseWriter, req *http.Request) {
-	// validate authorization header if enabled
-	if viper.GetString("admin.secret") != "" && req.Header.Get("Admin-Secret") != viper.GetString("admin.secret") {
-		log.Warningf("admin credentials rejected")
-		writeJSONResponse(w, http.StatusUnauthorized, errorResponse{"admin credentials rejected"})
+	// validate admin credentials
+	actor, ok := authenticateAdmin(w, req, dataprovider.ScopeUsersWrite)
+	if !ok {
 		return
 	}
 
@@ -207,6 +408,11 @@ func handlerUserAdd(w http.ResponseWriter, req *http.Request) {
 		return
 	}
 
+	if err = checkGroupsExist(user.Groups); err != nil {
+		writeJSONResponse(w, http.StatusBadRequest, errorResponse{"Bad request: " + err.Error()})
+		return
+	}
+
 	// add the user to the backend now
 	err = dataProvider.AddUser(user)
 	switch {
@@ -226,6 +432,7 @@ func handlerUserAdd(w http.ResponseWriter, req *http.Request) {
 
 	// user has been added
 	log.Infof("new user has been added: %s", user.ID)
+	recordAdminAction(req, audit.EventUserAdd, actor, user.ID)
 	writeJSONResponse(w, http.StatusOK, getUserConvert(user))
 }
 
@@ -235,16 +442,15 @@ func handlerUserAdd(w http.ResponseWriter, req *http.Request) {
 // @Tags User
 // @Accept  json
 // @Produce  json
-// @Param Admin-Secret header string true "Admin Secret"
+// @Param Authorization header string true "Bearer followed by an admin API token or the admin secret"
 // @Param user body server.modifyUser true "Update User"
 // @Success 200 {object} server.getUser
 // @Router /user/{id} [put]
 func handlerUserUpdate(w http.ResponseWriter, req *http.Request) {
-	// validate authorization header if enabled
+	// validate admin credentials
 	// TODO: the user should also be able to modify itself
-	if viper.GetString("admin.secret") != "" && req.Header.Get("Admin-Secret") != viper.GetString("admin.secret") {
-		log.Warningf("admin credentials rejected")
-		writeJSONResponse(w, http.StatusUnauthorized, errorResponse{"admin credentials rejected"})
+	actor, ok := authenticateAdmin(w, req, dataprovider.ScopeUsersWrite)
+	if !ok {
 		return
 	}
 
@@ -275,6 +481,13 @@ func handlerUserUpdate(w http.ResponseWriter, req *http.Request) {
 		return
 	}
 
+	if err = checkGroupsExist(modifiedUser.Groups); err != nil {
+		writeJSONResponse(w, http.StatusBadRequest, errorResponse{"Bad request: " + err.Error()})
+		return
+	}
+	// TOTP is managed by /user/{id}/totp
+	modifiedUser.TOTPSecret = user.TOTPSecret
+
 	// add the user to the backend now
 	err = dataProvider.UpdateUser(modifiedUser)
 	if err != nil {
@@ -288,6 +501,7 @@ func handlerUserUpdate(w http.ResponseWriter, req *http.Request) {
 
 	// user has been updated
 	log.Infof("user has been updated: %s", user.ID)
+	recordAdminAction(req, audit.EventUserUpdate, actor, user.ID)
 	writeJSONResponse(w, http.StatusOK, getUserConvert(modifiedUser))
 }
 
@@ -296,16 +510,14 @@ func handlerUserUpdate(w http.ResponseWriter, req *http.Request) {
 // @Description get User by ID
 // @Tags User
 // @Produce json
-// @Param Admin-Secret header string true "Admin Secret"
+// @Param Authorization header string true "Bearer followed by an admin API token or the admin secret"
 // @Param id path string true "User ID"
 // @Success 200 {object} server.getUser
 // @Router /user/{id} [get]
 func handlerUserGet(w http.ResponseWriter, req *http.Request) {
-	// validate authorization header if enabled
+	// validate admin credentials
 	// TODO: the user should also be able to modify itself
-	if viper.GetString("admin.secret") != "" && req.Header.Get("Admin-Secret") != viper.GetString("admin.secret") {
-		log.Warningf("admin credentials rejected")
-		writeJSONResponse(w, http.StatusUnauthorized, errorResponse{"admin credentials rejected"})
+	if _, ok := authenticateAdmin(w, req, dataprovider.ScopeUsersRead); !ok {
 		return
 	}
 
@@ -318,7 +530,9 @@ func handlerUserGet(w http.ResponseWriter, req *http.Request) {
 		writeJSONResponse(w, http.StatusBadRequest, errorResponse{"user was not found"})
 		return
 	}
-	writeJSONResponse(w, http.StatusOK, getUserConvert(user))
+	apiResponse := getUserConvert(user)
+	apiResponse.LastSeen = getLastSeen(user.ID)
+	writeJSONResponse(w, http.StatusOK, apiResponse)
 }
 
 // handlerUserGetAll godoc
@@ -326,15 +540,13 @@ func handlerUserGet(w http.ResponseWriter, req *http.Request) {
 // @Description get all Users
 // @Tags User
 // @Produce json
-// @Param Admin-Secret header string true "Admin Secret"
+// @Param Authorization header string true "Bearer followed by an admin API token or the admin secret"
 // @Success 200 {array} server.getUser
 // @Router /user [get]
 func handlerUserGetAll(w http.ResponseWriter, req *http.Request) {
-	// validate authorization header if enabled
+	// validate admin credentials
 	// TODO: the user should also be able to modify itself
-	if viper.GetString("admin.secret") != "" && req.Header.Get("Admin-Secret") != viper.GetString("admin.secret") {
-		log.Warningf("admin credentials rejected")
-		writeJSONResponse(w, http.StatusUnauthorized, errorResponse{"admin credentials rejected"})
+	if _, ok := authenticateAdmin(w, req, dataprovider.ScopeUsersRead); !ok {
 		return
 	}
 
@@ -344,13 +556,11 @@ func handlerUserGetAll(w http.ResponseWriter, req *http.Request) {
 		writeJSONResponse(w, http.StatusServiceUnavailable, errorResponse{"could not retrieve all users"})
 		return
 	}
-	// TODO: writeJSONResponse cannot properly handle an empty slice
-	if len(users) == 0 {
-		w.WriteHeader(http.StatusOK)
-		w.Write([]byte(`[]`))
-		return
+	apiResponse := getAllUsersConvert(users)
+	for i := range apiResponse {
+		apiResponse[i].LastSeen = getLastSeen(apiResponse[i].ID)
 	}
-	writeJSONResponse(w, http.StatusOK, getAllUsersConvert(users))
+	writeJSONResponse(w, http.StatusOK, apiResponse)
 }
 
 // handlerUserDelete godoc
@@ -358,15 +568,14 @@ func handlerUserGetAll(w http.ResponseWriter, req *http.Request) {
 // @Description remove a User by ID
 // @Tags User
 // @Produce json
-// @Param Admin-Secret header string true "Admin Secret"
+// @Param Authorization header string true "Bearer followed by an admin API token or the admin secret"
 // @Param id path string true "User ID"
 // @Success 200 {object} server.getUser
 // @Router /user/{id} [delete]
 func handlerUserDelete(w http.ResponseWriter, req *http.Request) {
-	// validate authorization header if enabled
-	if viper.GetString("admin.secret") != "" && req.Header.Get("Admin-Secret") != viper.GetString("admin.secret") {
-		log.Warningf("admin credentials rejected")
-		writeJSONResponse(w, http.StatusUnauthorized, errorResponse{"admin credentials rejected"})
+	// validate admin credentials
+	actor, ok := authenticateAdmin(w, req, dataprovider.ScopeUsersWrite)
+	if !ok {
 		return
 	}
 
@@ -386,13 +595,257 @@ func handlerUserDelete(w http.ResponseWriter, req *http.Request) {
 		writeJSONResponse(w, http.StatusInternalServerError, errorResponse{"unable to remove client"})
 		return
 	}
+	// remove this user from dynamic DNS provider
+	ddnsProvider.DeleteUser(user)
+	// and revoke the IPs it whitelisted
+	revokeUserACLs(user.ID)
+
 	// user has been removed
 	log.Infof("user has been removed: %s", userId)
+	recordAdminAction(req, audit.EventUserRemove, actor, userId)
 	writeJSONResponse(w, http.StatusOK, getUserConvert(user))
 }
 
+// handlerAuditGet godoc
+// @Summary Retrieve audit events
+// @Description get audit events (newest first) matching all of the provided filters
+// @Tags Audit
+// @Produce json
+// @Param Authorization header string true "Bearer followed by an admin API token or the admin secret"
+// @Param user_id query string false "only events of this User ID"
+// @Param client_ip query string false "only events caused by this IP address"
+// @Param event_type query string false "only events of this type" Enums(authorize, challenge, admin_auth, user_add, user_update, user_remove, acl_revoke, db_import, token_add, token_remove)
+// @Param since query string false "only events which occurred at or after this time (RFC3339)"
+// @Param until query string false "only events which occurred before this time (RFC3339)"
+// @Param limit query int false "maximum number of events to return (default 100, max 1000)"
+// @Success 200 {array} audit.Event
+// @Failure 400 "bad request: a filter is invalid" {object} errorResponse
+// @Router /audit [get]
+func handlerAuditGet(w http.ResponseWriter, req *http.Request) {
+	// validate admin credentials
+	if _, ok := authenticateAdmin(w, req, dataprovider.ScopeAuditRead); !ok {
+		return
+	}
+
+	// build the filter from query parameters
+	query := req.URL.Query()
+	filter := audit.Filter{
+		UserID:   query.Get("user_id"),
+		ClientIP: query.Get("client_ip"),
+		Type:     query.Get("event_type"),
+		Limit:    defaultAuditLimit,
+	}
+	var err error
+	if since := query.Get("since"); since != "" {
+		if filter.Since, err = time.Parse(time.RFC3339, since); err != nil {
+			writeJSONResponse(w, http.StatusBadRequest, errorResponse{"since is not a valid RFC3339 time"})
+			return
+		}
+	}
+	if until := query.Get("until"); until != "" {
+		if filter.Until, err = time.Parse(time.RFC3339, until); err != nil {
+			writeJSONResponse(w, http.StatusBadRequest, errorResponse{"until is not a valid RFC3339 time"})
+			return
+		}
+	}
+	if limit := query.Get("limit"); limit != "" {
+		if filter.Limit, err = strconv.Atoi(limit); err != nil || filter.Limit < 1 || filter.Limit > maxAuditLimit {
+			writeJSONResponse(w, http.StatusBadRequest, errorResponse{fmt.Sprintf("limit must be between 1 and %d", maxAuditLimit)})
+			return
+		}
+	}
+
+	events, err := dataProvider.GetAuditEvents(&filter)
+	if err != nil {
+		log.Warningf("could not get audit events: %v", err)
+		writeJSONResponse(w, http.StatusServiceUnavailable, errorResponse{"could not retrieve audit events"})
+		return
+	}
+	writeJSONResponse(w, http.StatusOK, events)
+}
+
+// handlerACLGetAll godoc
+// @Summary Retrieve all ACLs
+// @Description get the ACL of every whitelisted IP
+// @Tags ACL
+// @Produce json
+// @Param Authorization header string true "Bearer followed by an admin API token or the admin secret"
+// @Success 200 {array} server.getACL
+// @Router /acl [get]
+func handlerACLGetAll(w http.ResponseWriter, req *http.Request) {
+	// validate admin credentials
+	if _, ok := authenticateAdmin(w, req, dataprovider.ScopeACLsManage); !ok {
+		return
+	}
+
+	acls, err := dataProvider.GetAllACLs()
+	if err != nil {
+		log.Warningf("could not get all ACLs: %v", err)
+		writeJSONResponse(w, http.StatusServiceUnavailable, errorResponse{"could not retrieve all ACLs"})
+		return
+	}
+	writeJSONResponse(w, http.StatusOK, getAllACLsConvert(acls))
+}
+
+// handlerACLDelete godoc
+// @Summary Revoke the ACL of an IP
+// @Description remove the ACL of an IP. An IP whitelisted by a DNS name is added again while the name resolves to it
+// @Tags ACL
+// @Produce json
+// @Param Authorization header string true "Bearer followed by an admin API token or the admin secret"
+// @Param ip path string true "IP address"
+// @Success 200 {object} server.getACL
+// @Failure 400 "bad request: no ACL was found for this IP" {object} errorResponse
+// @Router /acl/{ip} [delete]
+func handlerACLDelete(w http.ResponseWriter, req *http.Request) {
+	// validate admin credentials
+	actor, ok := authenticateAdmin(w, req, dataprovider.ScopeACLsManage)
+	if !ok {
+		return
+	}
+
+	ip := mux.Vars(req)["ip"]
+	acl, err := dataProvider.GetACL(ip)
+	if acl == nil || err != nil {
+		log.Warningf("ACL was not found: %s", ip)
+		writeJSONResponse(w, http.StatusBadRequest, errorResponse{"ACL was not found"})
+		return
+	}
+	if err = dataProvider.RemoveIp(ip); err != nil {
+		log.Warningf("unable to revoke ACL of %s: %v", ip, err)
+		writeJSONResponse(w, http.StatusInternalServerError, errorResponse{"unable to revoke ACL"})
+		return
+	}
+
+	// ACL has been revoked
+	log.Infof("ACL has been revoked: %s", ip)
+	recordAdminChange(req, audit.Event{Type: audit.EventACLRevoke, UserID: acl.UserID, Target: ip}, actor)
+	writeJSONResponse(w, http.StatusOK, getACL{IpAddress: ip, ACL: *acl})
+}
+
+// handlerTokenAdd godoc
+// @Summary Add a new admin API token
+// @Description the token is only returned in this response, only its hash is stored
+// @Tags Token
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "Bearer followed by an admin API token or the admin secret"
+// @Param token body server.addToken true "Add Token"
+// @Success 200 {object} server.tokenCreated
+// @Failure 400 "bad request: the token is invalid" {object} errorResponse
+// @Failure 403 "the token would be granted more than the admin API token creating it" {object} errorResponse
+// @Failure 409 "a token with this name already exists" {object} errorResponse
+// @Router /token [post]
+func handlerTokenAdd(w http.ResponseWriter, req *http.Request) {
+	// validate admin credentials
+	actor, ok := authenticateAdmin(w, req, dataprovider.ScopeTokensManage)
+	if !ok {
+		return
+	}
+
+	// try to unmarshal the body into a valid token
+	var newToken addToken
+	if err := json.NewDecoder(req.Body).Decode(&newToken); err != nil {
+		writeJSONResponse(w, http.StatusBadRequest, errorResponse{"Bad request: " + err.Error()})
+		return
+	}
+	token, secret, err := dataprovider.NewToken(newToken.Name, newToken.Scopes, newToken.ExpiresAt)
+	if err != nil {
+		writeJSONResponse(w, http.StatusBadRequest, errorResponse{"Bad request: " + err.Error()})
+		return
+	}
+	if reason := checkTokenGrant(actor, token); reason != "" {
+		writeJSONResponse(w, http.StatusForbidden, errorResponse{"Forbidden: " + reason})
+		return
+	}
+
+	// add the token to the backend now
+	err = dataProvider.AddToken(token)
+	switch {
+	case err == dataprovider.ErrTokenExists:
+		writeJSONResponse(w, http.StatusConflict, errorResponse{fmt.Sprintf("Token already exists (name: %s)", token.Name)})
+		return
+	case err != nil:
+		log.Errorf("couldn't add new token: %v", err)
+		writeJSONResponse(w, http.StatusInternalServerError, errorResponse{"Could not add token"})
+		return
+	}
+
+	// token has been added
+	log.Infof("new admin API token has been added: %s", token.Name)
+	recordAdminChange(req, audit.Event{Type: audit.EventTokenAdd, Target: token.Name}, actor)
+	writeJSONResponse(w, http.StatusOK, tokenCreated{getTokenConvert(token), secret})
+}
+
+// handlerTokenGetAll godoc
+// @Summary Retrieve all admin API tokens
+// @Description get all admin API tokens, without the tokens themselves
+// @Tags Token
+// @Produce json
+// @Param Authorization header string true "Bearer followed by an admin API token or the admin secret"
+// @Success 200 {array} server.getToken
+// @Router /token [get]
+func handlerTokenGetAll(w http.ResponseWriter, req *http.Request) {
+	// validate admin credentials
+	if _, ok := authenticateAdmin(w, req, dataprovider.ScopeTokensManage); !ok {
+		return
+	}
+
+	tokens, err := dataProvider.GetAllTokens()
+	if err != nil {
+		log.Warningf("could not get all tokens: %v", err)
+		writeJSONResponse(w, http.StatusServiceUnavailable, errorResponse{"could not retrieve all tokens"})
+		return
+	}
+	apiResponse := make([]getToken, 0, len(tokens))
+	for i := range tokens {
+		apiResponse = append(apiResponse, getTokenConvert(&tokens[i]))
+	}
+	writeJSONResponse(w, http.StatusOK, apiResponse)
+}
+
+// handlerTokenDelete godoc
+// @Summary Remove an admin API token
+// @Description remove an admin API token by name, it can no longer be used afterwards
+// @Tags Token
+// @Produce json
+// @Param Authorization header string true "Bearer followed by an admin API token or the admin secret"
+// @Param name path string true "Token name"
+// @Success 200 {object} server.getToken
+// @Failure 400 "bad request: the token was not found" {object} errorResponse
+// @Router /token/{name} [delete]
+func handlerTokenDelete(w http.ResponseWriter, req *http.Request) {
+	// validate admin credentials
+	actor, ok := authenticateAdmin(w, req, dataprovider.ScopeTokensManage)
+	if !ok {
+		return
+	}
+
+	name := mux.Vars(req)["name"]
+	token, err := dataProvider.GetToken(name)
+	if token == nil || err != nil {
+		log.Warningf("token was not found: %s", name)
+		writeJSONResponse(w, http.StatusBadRequest, errorResponse{"token was not found"})
+		return
+	}
+	if err = dataProvider.RemoveToken(name); err != nil {
+		log.Warningf("unable to remove token %s: %v", name, err)
+		writeJSONResponse(w, http.StatusInternalServerError, errorResponse{"unable to remove token"})
+		return
+	}
+
+	// token has been removed
+	log.Infof("admin API token has been removed: %s", name)
+	recordAdminChange(req, audit.Event{Type: audit.EventTokenRemove, Target: name}, actor)
+	writeJSONResponse(w, http.StatusOK, getTokenConvert(token))
+}
+
 // wrapper for json responses
 func writeJSONResponse(w http.ResponseWriter, status int, body interface{}) {
+	// a nil slice is written as an empty array rather than null
+	if v := reflect.ValueOf(body); v.Kind() == reflect.Slice && v.IsNil() {
+		body = reflect.MakeSlice(v.Type(), 0, 0).Interface()
+	}
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(status)
 	b, _ := json.MarshalIndent(body, "", "  ")
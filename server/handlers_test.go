@@ -0,0 +1,243 @@
+package server
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gbolo/protego/dataprovider"
+	"github.com/gorilla/mux"
+	"github.com/spf13/viper"
+)
+
+func TestWriteJSONResponse(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     interface{}
+		expected string
+	}{
+		{"nil slice", []dataprovider.DenyEntry(nil), "[]"},
+		{"empty slice", []string{}, "[]"},
+		{"slice", []string{"a"}, "[\n  \"a\"\n]"},
+		{"nil", nil, "null"},
+		{"object", errorResponse{"oops"}, "{\n  \"error\": \"oops\"\n}"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			writeJSONResponse(w, http.StatusOK, test.body)
+			if body := strings.TrimSpace(w.Body.String()); body != test.expected {
+				t.Fatalf("expected %s, got %s", test.expected, body)
+			}
+			if contentType := w.Header().Get("Content-Type"); contentType != "application/json" {
+				t.Fatalf("expected a json content type, got %s", contentType)
+			}
+		})
+	}
+}
+
+func TestGetAllEmpty(t *testing.T) {
+	setupTestProvider(t)
+	viper.Set("admin.secret", "supersecret")
+	t.Cleanup(func() { viper.Set("admin.secret", "") })
+	for name, handler := range map[string]http.HandlerFunc{
+		"/user":       handlerUserGetAll,
+		"/acl":        handlerACLGetAll,
+		"/token":      handlerTokenGetAll,
+		"/deny":       handlerDenyGetAll,
+		"/group":      handlerGroupGetAll,
+		"/hostset":    handlerHostSetGetAll,
+		"/hostpolicy": handlerHostPolicyGetAll,
+		"/audit":      handlerAuditGet,
+	} {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1"+name, nil)
+		req.Header.Set("Admin-Secret", "supersecret")
+		w := httptest.NewRecorder()
+		handler(w, req)
+		if w.Code != http.StatusOK || strings.TrimSpace(w.Body.String()) != "[]" {
+			t.Errorf("expected %s to return an empty array, got %d: %s", name, w.Code, w.Body.String())
+		}
+	}
+}
+
+func TestAdminAuthentication(t *testing.T) {
+	setupTestProvider(t)
+	t.Cleanup(func() {
+		viper.Set("admin.secret", "")
+		viper.Set("admin.allow_unauthenticated", false)
+	})
+	tests := []struct {
+		name                 string
+		secret               string
+		allowUnauthenticated bool
+		credential           string
+		expected             int
+	}{
+		{"secret", "supersecret", false, "supersecret", http.StatusOK},
+		{"wrong secret", "supersecret", false, "wrong", http.StatusUnauthorized},
+		{"missing secret", "supersecret", false, "", http.StatusUnauthorized},
+		// an empty admin.secret does not disable authentication on its own
+		{"empty admin.secret", "", false, "", http.StatusUnauthorized},
+		{"unauthenticated", "", true, "", http.StatusOK},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			viper.Set("admin.secret", test.secret)
+			viper.Set("admin.allow_unauthenticated", test.allowUnauthenticated)
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/user", nil)
+			if test.credential != "" {
+				req.Header.Set("Admin-Secret", test.credential)
+			}
+			w := httptest.NewRecorder()
+			handlerUserGetAll(w, req)
+			if w.Code != test.expected {
+				t.Fatalf("expected %d, got %d: %s", test.expected, w.Code, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestTokenAddGrant(t *testing.T) {
+	setupTestProvider(t)
+	viper.Set("admin.secret", "supersecret")
+	t.Cleanup(func() { viper.Set("admin.secret", "") })
+	expiresAt := time.Now().Add(time.Hour)
+	manager, managerSecret, err := dataprovider.NewToken("manager", []string{dataprovider.ScopeTokensManage, dataprovider.ScopeUsersRead}, &expiresAt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = dataProvider.AddToken(manager); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		credential string
+		body       string
+		expected   int
+	}{
+		{"held scopes", managerSecret, `{"name":"a","scopes":["users:read","tokens:manage"],"expires_at":"` + time.Now().Add(time.Minute).Format(time.RFC3339) + `"}`, http.StatusOK},
+		{"scope not held", managerSecret, `{"name":"b","scopes":["users:read","users:write"],"expires_at":"` + time.Now().Add(time.Minute).Format(time.RFC3339) + `"}`, http.StatusForbidden},
+		{"outliving its creator", managerSecret, `{"name":"c","scopes":["users:read"]}`, http.StatusForbidden},
+		{"admin secret", "supersecret", `{"name":"d","scopes":["users:write","audit:read"]}`, http.StatusOK},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/token", strings.NewReader(test.body))
+			req.Header.Set("Authorization", "Bearer "+test.credential)
+			w := httptest.NewRecorder()
+			handlerTokenAdd(w, req)
+			if w.Code != test.expected {
+				t.Fatalf("expected %d, got %d: %s", test.expected, w.Code, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestGroupChangesApplyToACLs(t *testing.T) {
+	setupTestProvider(t)
+	viper.Set("admin.secret", "supersecret")
+	t.Cleanup(func() { viper.Set("admin.secret", "") })
+	for _, h := range []dataprovider.HostSet{
+		{Name: "media", Hosts: []string{"plex.example.com"}},
+		{Name: "wiki", Hosts: []string{"wiki.example.com"}},
+	} {
+		h := h
+		if err := dataProvider.AddHostSet(&h); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := dataProvider.AddGroup(&dataprovider.Group{Name: "family", HostSets: []string{"media"}}); err != nil {
+		t.Fatal(err)
+	}
+	u := addTestUser(t, "usersecret", nil)
+	u.Groups = []string{"family"}
+	if err := dataProvider.UpdateUser(u); err != nil {
+		t.Fatal(err)
+	}
+	groupRequest := func(handler http.HandlerFunc, method, body string) {
+		t.Helper()
+		req := httptest.NewRequest(method, "/api/v1/group/family", strings.NewReader(body))
+		req.Header.Set("Admin-Secret", "supersecret")
+		req = mux.SetURLVars(req, map[string]string{"name": "family"})
+		w := httptest.NewRecorder()
+		handler(w, req)
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected the group to be changed, got %d: %s", w.Code, w.Body.String())
+		}
+	}
+
+	if status := challenge("192.0.2.10", "usersecret"); status != http.StatusAccepted {
+		t.Fatalf("expected the challenge to pass, got %d", status)
+	}
+	if status := authorize("192.0.2.10", "plex.example.com"); status != http.StatusOK {
+		t.Fatalf("expected the group to grant plex.example.com, got %d", status)
+	}
+
+	// the ACL of the challenge follows the group
+	groupRequest(handlerGroupUpdate, http.MethodPut, `{"name":"family","host_sets":["wiki"]}`)
+	if status := authorize("192.0.2.10", "plex.example.com"); status == http.StatusOK {
+		t.Fatal("expected plex.example.com to be denied once removed from the group")
+	}
+	if status := authorize("192.0.2.10", "wiki.example.com"); status != http.StatusOK {
+		t.Fatalf("expected wiki.example.com to be granted once added to the group, got %d", status)
+	}
+
+	groupRequest(handlerGroupDelete, http.MethodDelete, "")
+	if status := authorize("192.0.2.10", "wiki.example.com"); status == http.StatusOK {
+		t.Fatal("expected wiki.example.com to be denied once the group is deleted")
+	}
+	if u, _ = dataProvider.GetUser(u.ID); len(u.Groups) != 0 {
+		t.Fatalf("expected the deleted group to be removed from its members, got %v", u.Groups)
+	}
+}
+
+func TestClientCertificates(t *testing.T) {
+	setupTestProvider(t)
+	viper.Set("admin.secret", "supersecret")
+	viper.Set("server.tls.client_auth_enabled", true)
+	viper.Set("server.tls.client_auth_route_groups", []string{routeGroupAdmin})
+	viper.Set("server.tls.client_auth_admin_identity", true)
+	t.Cleanup(func() {
+		viper.Set("admin.secret", "")
+		viper.Set("server.tls.client_auth_enabled", false)
+		viper.Set("server.tls.client_auth_route_groups", []string{})
+		viper.Set("server.tls.client_auth_admin_identity", false)
+	})
+	verified := &tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{{Subject: pkix.Name{CommonName: "ops"}}}}}
+	ok := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) { w.WriteHeader(http.StatusOK) })
+	request := func(group string, state *tls.ConnectionState) int {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/user", nil)
+		req.TLS = state
+		w := httptest.NewRecorder()
+		requireClientCert(group, ok).ServeHTTP(w, req)
+		return w.Code
+	}
+
+	// client certificates are only required for the configured groups of routes
+	if status := request(routeGroupAuthorize, nil); status != http.StatusOK {
+		t.Fatalf("expected authorize to be served without a client certificate, got %d", status)
+	}
+	if status := request(routeGroupAdmin, nil); status != http.StatusForbidden {
+		t.Fatalf("expected the admin API to require a client certificate, got %d", status)
+	}
+	if status := request(routeGroupAdmin, &tls.ConnectionState{}); status != http.StatusForbidden {
+		t.Fatalf("expected an unverified client certificate to be refused, got %d", status)
+	}
+	if status := request(routeGroupAdmin, verified); status != http.StatusOK {
+		t.Fatalf("expected a verified client certificate to be accepted, got %d", status)
+	}
+
+	// the subject of the certificate authenticates the admin, without the admin secret
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/user", nil)
+	req.TLS = verified
+	actor, authenticated := authenticateAdmin(httptest.NewRecorder(), req, dataprovider.ScopeUsersWrite)
+	if !authenticated || actor != "CN=ops" {
+		t.Fatalf("expected the admin to be identified as CN=ops, got %q", actor)
+	}
+}
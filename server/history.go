@@ -0,0 +1,115 @@
+package server
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gbolo/protego/audit"
+	"github.com/gbolo/protego/config"
+	"github.com/gbolo/protego/dataprovider"
+)
+
+const (
+	// retention is enforced after this many events have been persisted
+	historyPruneInterval = 100
+	// the number of events waiting to be persisted, newer events are dropped
+	historyQueueSize = 1000
+	// the number of audit events returned when no limit is requested
+	defaultAuditLimit = 100
+	// the maximum number of audit events returned in a single request
+	maxAuditLimit = 1000
+)
+
+// the audit history, nil unless audit.history.enabled is set
+var history *historySink
+
+// historySink persists audit events through the data provider,
+// so they can be queried by the audit API.
+type historySink struct {
+	provider         dataprovider.Provider
+	maxAge           time.Duration
+	maxEvents        int
+	includeAuthorize bool
+	writes           uint64
+	// when and from where every user last passed a challenge, keyed by user ID
+	lastSeen map[string]lastSeen
+	lock     *sync.RWMutex
+}
+
+// newHistorySink returns a historySink configured as defined in configuration
+func newHistorySink(p dataprovider.Provider) *historySink {
+	return &historySink{
+		provider:         p,
+		maxAge:           config.GetDuration("audit.history.max_age"),
+		maxEvents:        config.GetInt("audit.history.max_events"),
+		includeAuthorize: config.GetBool("audit.history.include_authorize"),
+		lastSeen:         make(map[string]lastSeen),
+		lock:             new(sync.RWMutex),
+	}
+}
+
+func (s *historySink) Write(e *audit.Event) error {
+	if e.Type == audit.EventChallenge && e.Decision == audit.DecisionAllow {
+		s.see(e)
+	}
+	// authorize events are only persisted on request, since there is one for every denied request
+	if e.Type == audit.EventAuthorize && !s.includeAuthorize {
+		return nil
+	}
+	if err := s.provider.AddAuditEvent(e); err != nil {
+		return err
+	}
+	if atomic.AddUint64(&s.writes, 1)%historyPruneInterval == 0 {
+		return s.prune()
+	}
+	return nil
+}
+
+// prune removes the events which fall outside of the configured retention
+func (s *historySink) prune() error {
+	var before time.Time
+	if s.maxAge > 0 {
+		before = time.Now().Add(-s.maxAge)
+	}
+	return s.provider.PruneAuditEvents(before, s.maxEvents)
+}
+
+// loadLastSeen reads when and from where users last passed a challenge from the persisted events.
+// it is only called once, afterwards the last seen of users is kept up to date by Write
+func (s *historySink) loadLastSeen() error {
+	events, err := s.provider.GetAuditEvents(&audit.Filter{
+		Type:     audit.EventChallenge,
+		Decision: audit.DecisionAllow,
+	})
+	if err != nil {
+		return err
+	}
+	// events are ordered newest first
+	for i := len(events) - 1; i >= 0; i-- {
+		s.see(&events[i])
+	}
+	return nil
+}
+
+// see records the challenge passed in e as the last seen of its user
+func (s *historySink) see(e *audit.Event) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if seen, ok := s.lastSeen[e.UserID]; !ok || !e.Timestamp.Before(seen.Timestamp) {
+		s.lastSeen[e.UserID] = lastSeen{Timestamp: e.Timestamp, IpAddress: e.ClientIP}
+	}
+}
+
+// getLastSeen returns when and from where a user last passed a challenge, or nil
+func getLastSeen(userID string) *lastSeen {
+	if history == nil {
+		return nil
+	}
+	history.lock.RLock()
+	defer history.lock.RUnlock()
+	if seen, ok := history.lastSeen[userID]; ok {
+		return &seen
+	}
+	return nil
+}
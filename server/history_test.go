@@ -0,0 +1,65 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gbolo/protego/audit"
+)
+
+func TestLastSeen(t *testing.T) {
+	p := setupTestProvider(t)
+	if seen := getLastSeen("u1"); seen != nil {
+		t.Fatalf("expected no last seen without history, got %v", seen)
+	}
+
+	start := time.Now().UTC().Truncate(time.Second)
+	challenge := func(userID, ip string, at time.Time, allowed bool) *audit.Event {
+		return &audit.Event{Type: audit.EventChallenge, UserID: userID, ClientIP: ip, Timestamp: at, Decision: decision(allowed)}
+	}
+	for _, e := range []*audit.Event{
+		challenge("u1", "10.0.0.1", start, true),
+		challenge("u1", "10.0.0.2", start.Add(time.Minute), true),
+		challenge("u1", "10.0.0.3", start.Add(2*time.Minute), false),
+		challenge("u2", "10.0.0.4", start, true),
+	} {
+		if err := p.AddAuditEvent(e); err != nil {
+			t.Fatal(err)
+		}
+	}
+	history = newHistorySink(p)
+	t.Cleanup(func() { history = nil })
+	if err := history.loadLastSeen(); err != nil {
+		t.Fatal(err)
+	}
+
+	expect := func(userID, ip string, at time.Time) {
+		t.Helper()
+		seen := getLastSeen(userID)
+		switch {
+		case ip == "" && seen != nil:
+			t.Fatalf("expected %s to never be seen, got %v", userID, seen)
+		case ip != "" && (seen == nil || seen.IpAddress != ip || !seen.Timestamp.Equal(at)):
+			t.Fatalf("expected %s to be last seen from %s at %v, got %v", userID, ip, at, seen)
+		}
+	}
+	// failed challenges are not taken into account
+	expect("u1", "10.0.0.2", start.Add(time.Minute))
+	expect("u2", "10.0.0.4", start)
+	expect("u3", "", time.Time{})
+
+	// the last seen is updated by new challenges, without reading the history again
+	for _, e := range []*audit.Event{
+		challenge("u2", "10.0.0.5", start.Add(time.Hour), true),
+		challenge("u3", "10.0.0.6", start.Add(time.Hour), false),
+		// events are not always written in order
+		challenge("u1", "10.0.0.7", start.Add(-time.Hour), true),
+	} {
+		if err := history.Write(e); err != nil {
+			t.Fatal(err)
+		}
+	}
+	expect("u1", "10.0.0.2", start.Add(time.Minute))
+	expect("u2", "10.0.0.5", start.Add(time.Hour))
+	expect("u3", "", time.Time{})
+}
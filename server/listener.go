@@ -0,0 +1,174 @@
+package server
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"os/user"
+	"strconv"
+
+	"github.com/gbolo/protego/config"
+)
+
+// every group of routes
+var allRouteGroups = []string{routeGroupPublic, routeGroupAuthorize, routeGroupAdmin, routeGroupDebug}
+
+// listener describes an address the server listens on, and the groups of routes served there
+type listener struct {
+	name string
+	// tcp or unix
+	network string
+	// host:port for tcp, path for unix
+	address string
+	tls     bool
+	groups  []string
+}
+
+// configureListeners returns the listeners defined in configuration.
+// groups of routes served by the admin listener or unix socket are not served by the main listener,
+// and the main listener is not started when it has no groups of routes left.
+func configureListeners() (listeners []listener, err error) {
+	if config.GetBool("server.admin_listener.enabled") {
+		listeners = append(listeners, listener{
+			name:    "admin",
+			network: "tcp",
+			address: fmt.Sprintf(
+				"%s:%s",
+				config.GetString("server.admin_listener.bind_address"),
+				config.GetString("server.admin_listener.bind_port"),
+			),
+			tls:    config.GetBool("server.tls.enabled"),
+			groups: config.GetStringSlice("server.admin_listener.route_groups"),
+		})
+	}
+	if config.GetBool("server.unix_socket.enabled") {
+		listeners = append(listeners, listener{
+			name:    "unix",
+			network: "unix",
+			address: config.GetString("server.unix_socket.path"),
+			groups:  config.GetStringSlice("server.unix_socket.route_groups"),
+		})
+	}
+
+	// a group of routes can only be moved away from the main listener once
+	moved := make(map[string]string)
+	for _, l := range listeners {
+		for _, g := range l.groups {
+			if !containsGroup(allRouteGroups, g) {
+				return nil, fmt.Errorf("the %s listener has an unknown group of routes: %s", l.name, g)
+			}
+			if other, ok := moved[g]; ok {
+				return nil, fmt.Errorf("the group of routes %s is assigned to both the %s and %s listeners", g, other, l.name)
+			}
+			moved[g] = l.name
+		}
+	}
+
+	var mainGroups []string
+	for _, g := range allRouteGroups {
+		if _, ok := moved[g]; !ok {
+			mainGroups = append(mainGroups, g)
+		}
+	}
+	if len(mainGroups) == 0 {
+		log.Info("every group of routes is served by another listener, the main listener is not started")
+		return
+	}
+	listeners = append(listeners, listener{
+		name:    "main",
+		network: "tcp",
+		address: fmt.Sprintf(
+			"%s:%s",
+			config.GetString("server.bind_address"),
+			config.GetString("server.bind_port"),
+		),
+		tls:    config.GetBool("server.tls.enabled"),
+		groups: mainGroups,
+	})
+	return
+}
+
+// listen opens the network listener
+func (l *listener) listen() (net.Listener, error) {
+	if l.network != "unix" {
+		return net.Listen(l.network, l.address)
+	}
+	// remove a socket left behind by an unclean shutdown, but never any other kind of file
+	if fi, err := os.Lstat(l.address); err == nil {
+		if fi.Mode()&os.ModeSocket == 0 {
+			return nil, fmt.Errorf("%s exists and is not a unix socket", l.address)
+		}
+		if err = os.Remove(l.address); err != nil {
+			return nil, err
+		}
+	}
+	ln, err := net.Listen("unix", l.address)
+	if err != nil {
+		return nil, err
+	}
+	if err = configureSocketPermissions(l.address); err != nil {
+		ln.Close()
+		return nil, err
+	}
+	return ln, nil
+}
+
+// configureSocketPermissions applies the configured mode, owner and group to a unix socket
+func configureSocketPermissions(path string) error {
+	mode, err := strconv.ParseUint(config.GetString("server.unix_socket.mode"), 8, 32)
+	if err != nil {
+		return fmt.Errorf("server.unix_socket.mode is not a valid octal file mode: %v", err)
+	}
+	if err = os.Chmod(path, os.FileMode(mode)); err != nil {
+		return err
+	}
+	uid, gid := -1, -1
+	if owner := config.GetString("server.unix_socket.owner"); owner != "" {
+		if uid, err = lookupID(owner, func(name string) (string, error) {
+			u, e := user.Lookup(name)
+			if e != nil {
+				return "", e
+			}
+			return u.Uid, nil
+		}); err != nil {
+			return fmt.Errorf("server.unix_socket.owner is invalid: %v", err)
+		}
+	}
+	if group := config.GetString("server.unix_socket.group"); group != "" {
+		if gid, err = lookupID(group, func(name string) (string, error) {
+			g, e := user.LookupGroup(name)
+			if e != nil {
+				return "", e
+			}
+			return g.Gid, nil
+		}); err != nil {
+			return fmt.Errorf("server.unix_socket.group is invalid: %v", err)
+		}
+	}
+	if uid != -1 || gid != -1 {
+		return os.Chown(path, uid, gid)
+	}
+	return nil
+}
+
+// lookupID returns the numeric ID of a user or group, which may be given by name or ID
+func lookupID(nameOrID string, lookup func(string) (string, error)) (int, error) {
+	if id, err := strconv.Atoi(nameOrID); err == nil {
+		return id, nil
+	}
+	id, err := lookup(nameOrID)
+	if err != nil {
+		return -1, err
+	}
+	return strconv.Atoi(id)
+}
+
+// containsGroup returns true when group is in groups
+func containsGroup(groups []string, group string) bool {
+	for _, g := range groups {
+		if g == group {
+			return true
+		}
+	}
+	return false
+}
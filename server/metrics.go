@@ -0,0 +1,34 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/gbolo/protego/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// instrumentRoute observes the latency of every request handled by the named route
+func instrumentRoute(name string, handler http.Handler) http.Handler {
+	return promhttp.InstrumentHandlerDuration(
+		metrics.HTTPRequestDuration.MustCurryWith(prometheus.Labels{"route": name}),
+		handler,
+	)
+}
+
+// countACLs returns the number of active ACLs per source
+func countACLs() (counts map[string]int, err error) {
+	acls, err := dataProvider.GetAllACLs()
+	if err != nil {
+		return
+	}
+	counts = make(map[string]int)
+	for _, acl := range acls {
+		source := acl.Source
+		if source == "" {
+			source = "unknown"
+		}
+		counts[source]++
+	}
+	return
+}
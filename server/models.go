@@ -1,54 +1,148 @@
 package server
 
-import "github.com/gbolo/protego/dataprovider"
+import (
+	"sort"
+	"time"
+
+	"github.com/gbolo/protego/dataprovider"
+)
 
 type addUser struct {
 	// Determines if this User is enabled
-	Enabled         bool     `json:"enabled" example:"true"`
+	Enabled          bool                        `json:"enabled" example:"true"`
 	// A brief description of this User
-	Description     string   `json:"description" example:"Cloud Strife"`
+	Description      string                      `json:"description" example:"Cloud Strife"`
+	// When set, this User is notified by email when its access is used from a new IP
+	Email            string                      `json:"email,omitempty" example:"cloud@example.com"`
 	// This secret is used as a challenge to whitelist a User's IP
-	Secret          string   `json:"secret" example:"supersecret"`
+	Secret           string                      `json:"secret" example:"supersecret"`
 	// Determines if this User is allowed to access ALL resources
-	ACLAllowAll     bool     `json:"acl_allow_all" example:"false"`
+	ACLAllowAll      bool                        `json:"acl_allow_all" example:"false"`
 	// A list of hosts (FQDN) this User is allowed to access
-	ACLAllowedHosts []string `json:"acl_allowed_hosts,omitempty" example:"git.example.com,wiki.example.com"`
+	ACLAllowedHosts  []string                    `json:"acl_allowed_hosts,omitempty" example:"git.example.com,wiki.example.com"`
 	// A list of DNS names that resolve this User's IPs which get whitelisted automatically without a challenge.
-	DNSNames        []string `json:"dns_names,omitempty" example:"myhome.no-ip.info"`
+	DNSNames         []string                    `json:"dns_names,omitempty" example:"myhome.no-ip.info"`
 	// Represents the number of minutes this User's IP is whitelisted for after a successful challenge
-	TTLMinutes      int      `json:"ttl_minutes,omitempty" example:"60"`
+	TTLMinutes       int                         `json:"ttl_minutes,omitempty" example:"60"`
+	// When true, the TTL is extended by TTLMinutes whenever this User's IP is authorized
+	SlidingTTL       bool                        `json:"sliding_ttl,omitempty" example:"false"`
+	// The absolute maximum number of minutes a sliding TTL can extend this User's IP for, since the challenge (0 means unlimited)
+	MaxTTLMinutes    int                         `json:"max_ttl_minutes,omitempty" example:"480"`
+	// The names of the groups this User is a member of
+	Groups           []string                    `json:"groups,omitempty" example:"family"`
+	// This User can not be used before this date
+	ValidFrom        *time.Time                  `json:"valid_from,omitempty" example:"2020-03-20T18:00:00Z"`
+	// This User can not be used after this date
+	ValidUntil       *time.Time                  `json:"valid_until,omitempty" example:"2020-03-22T18:00:00Z"`
+	// When set, this User only has access during these recurring windows (in the configured timezone)
+	AccessWindows    []dataprovider.AccessWindow `json:"access_windows,omitempty"`
+	// The maximum number of IPs whitelisted by challenges of this User at the same time (0 means unlimited)
+	MaxIPs           int                         `json:"max_ips,omitempty" example:"3"`
+	// What happens when a challenge exceeds MaxIPs: evict_oldest (default) or reject
+	MaxIPsPolicy     string                      `json:"max_ips_policy,omitempty" example:"evict_oldest"`
+	// When set, this User only has access from these countries (ISO 3166-1 alpha-2), located with the GeoIP database
+	AllowedCountries []string                    `json:"allowed_countries,omitempty" example:"CA,FR"`
 }
 
 type modifyUser struct {
 	// Determines if this User is enabled
-	Enabled         bool     `json:"enabled" example:"true"`
+	Enabled          bool                        `json:"enabled" example:"true"`
 	// A brief description of this User
-	Description     string   `json:"description" example:"Cloud Strife"`
+	Description      string                      `json:"description" example:"Cloud Strife"`
+	// When set, this User is notified by email when its access is used from a new IP
+	Email            string                      `json:"email,omitempty" example:"cloud@example.com"`
 	// Determines if this User is allowed to access ALL resources
-	ACLAllowAll     bool     `json:"acl_allow_all" example:"false"`
+	ACLAllowAll      bool                        `json:"acl_allow_all" example:"false"`
 	// A list of hosts (FQDN) this User is allowed to access
-	ACLAllowedHosts []string `json:"acl_allowed_hosts,omitempty" example:"git.example.com,wiki.example.com"`
+	ACLAllowedHosts  []string                    `json:"acl_allowed_hosts,omitempty" example:"git.example.com,wiki.example.com"`
 	// A list of DNS names that resolve this User's IPs which get whitelisted automatically without a challenge.
-	DNSNames        []string `json:"dns_names,omitempty" example:"myhome.no-ip.info"`
+	DNSNames         []string                    `json:"dns_names,omitempty" example:"myhome.no-ip.info"`
 	// Represents the number of minutes this User's IP is whitelisted for after a successful challenge
-	TTLMinutes      int      `json:"ttl_minutes,omitempty" example:"60"`
+	TTLMinutes       int                         `json:"ttl_minutes,omitempty" example:"60"`
+	// When true, the TTL is extended by TTLMinutes whenever this User's IP is authorized
+	SlidingTTL       bool                        `json:"sliding_ttl,omitempty" example:"false"`
+	// The absolute maximum number of minutes a sliding TTL can extend this User's IP for, since the challenge (0 means unlimited)
+	MaxTTLMinutes    int                         `json:"max_ttl_minutes,omitempty" example:"480"`
+	// The names of the groups this User is a member of
+	Groups           []string                    `json:"groups,omitempty" example:"family"`
+	// This User can not be used before this date
+	ValidFrom        *time.Time                  `json:"valid_from,omitempty" example:"2020-03-20T18:00:00Z"`
+	// This User can not be used after this date
+	ValidUntil       *time.Time                  `json:"valid_until,omitempty" example:"2020-03-22T18:00:00Z"`
+	// When set, this User only has access during these recurring windows (in the configured timezone)
+	AccessWindows    []dataprovider.AccessWindow `json:"access_windows,omitempty"`
+	// The maximum number of IPs whitelisted by challenges of this User at the same time (0 means unlimited)
+	MaxIPs           int                         `json:"max_ips,omitempty" example:"3"`
+	// What happens when a challenge exceeds MaxIPs: evict_oldest (default) or reject
+	MaxIPsPolicy     string                      `json:"max_ips_policy,omitempty" example:"evict_oldest"`
+	// When set, this User only has access from these countries (ISO 3166-1 alpha-2), located with the GeoIP database
+	AllowedCountries []string                    `json:"allowed_countries,omitempty" example:"CA,FR"`
 }
 
 type getUser struct {
 	// A unique identifier for this User
-	ID              string   `json:"id" example:"5e8848"`
+	ID               string                      `json:"id" example:"5e8848"`
 	// Determines if this User is enabled
-	Enabled         bool     `json:"enabled" example:"true"`
+	Enabled          bool                        `json:"enabled" example:"true"`
 	// A brief description of this User
-	Description     string   `json:"description" example:"Cloud Strife"`
+	Description      string                      `json:"description" example:"Cloud Strife"`
+	// When set, this User is notified by email when its access is used from a new IP
+	Email            string                      `json:"email,omitempty" example:"cloud@example.com"`
 	// Determines if this User is allowed to access ALL resources
-	ACLAllowAll     bool     `json:"acl_allow_all" example:"false"`
+	ACLAllowAll      bool                        `json:"acl_allow_all" example:"false"`
 	// A list of hosts (FQDN) this User is allowed to access
-	ACLAllowedHosts []string `json:"acl_allowed_hosts,omitempty" example:"git.example.com,wiki.example.com"`
+	ACLAllowedHosts  []string                    `json:"acl_allowed_hosts,omitempty" example:"git.example.com,wiki.example.com"`
 	// A list of DNS names that resolve this User's IPs which get whitelisted automatically without a challenge.
-	DNSNames        []string `json:"dns_names,omitempty" example:"myhome.no-ip.info"`
+	DNSNames         []string                    `json:"dns_names,omitempty" example:"myhome.no-ip.info"`
 	// Represents the number of minutes this User's IP is whitelisted for after a successful challenge
-	TTLMinutes      int      `json:"ttl_minutes,omitempty" example:"60"`
+	TTLMinutes       int                         `json:"ttl_minutes,omitempty" example:"60"`
+	// When true, the TTL is extended by TTLMinutes whenever this User's IP is authorized
+	SlidingTTL       bool                        `json:"sliding_ttl,omitempty" example:"false"`
+	// The absolute maximum number of minutes a sliding TTL can extend this User's IP for, since the challenge (0 means unlimited)
+	MaxTTLMinutes    int                         `json:"max_ttl_minutes,omitempty" example:"480"`
+	// The names of the groups this User is a member of
+	Groups           []string                    `json:"groups,omitempty" example:"family"`
+	// This User can not be used before this date
+	ValidFrom        *time.Time                  `json:"valid_from,omitempty" example:"2020-03-20T18:00:00Z"`
+	// This User can not be used after this date
+	ValidUntil       *time.Time                  `json:"valid_until,omitempty" example:"2020-03-22T18:00:00Z"`
+	// When set, this User only has access during these recurring windows (in the configured timezone)
+	AccessWindows    []dataprovider.AccessWindow `json:"access_windows,omitempty"`
+	// The maximum number of IPs whitelisted by challenges of this User at the same time (0 means unlimited)
+	MaxIPs           int                         `json:"max_ips,omitempty" example:"3"`
+	// What happens when a challenge exceeds MaxIPs: evict_oldest (default) or reject
+	MaxIPsPolicy     string                      `json:"max_ips_policy,omitempty" example:"evict_oldest"`
+	// When set, this User only has access from these countries (ISO 3166-1 alpha-2), located with the GeoIP database
+	AllowedCountries []string                    `json:"allowed_countries,omitempty" example:"CA,FR"`
+	// The last successful challenge of this User (based on audit history)
+	LastSeen         *lastSeen                   `json:"last_seen,omitempty"`
+	// IPs whitelisted by challenges of this User, from least to most recently whitelisted
+	IPs              []string                    `json:"ip_addresses,omitempty" example:"1.1.1.1,1.1.1.2"`
+	// Determines if this User is enrolled in TOTP
+	TOTPEnabled      bool                        `json:"totp_enabled,omitempty" example:"false"`
+}
+
+type totpEnrollment struct {
+	// The TOTP secret (base32), which is only returned once
+	Secret string `json:"secret" example:"JBSWY3DPEHPK3PXPJBSWY3DPEHPK3PXP"`
+	// The otpauth URI of the secret, which authenticator apps can import (as a QR code)
+	URI string `json:"uri" example:"otpauth://totp/Protego:5e8848?secret=JBSWY3DPEHPK3PXPJBSWY3DPEHPK3PXP&issuer=Protego"`
+}
+
+type addDenyEntry struct {
+	// The IP address or range (CIDR) to block
+	CIDR      string     `json:"cidr" example:"203.0.113.0/24"`
+	// Why this range is blocked
+	Reason    string     `json:"reason,omitempty" example:"credential stuffing"`
+	// After this date, the range is no longer blocked. Never expires when not set
+	ExpiresAt *time.Time `json:"expires_at,omitempty" example:"2020-03-23T14:28:00Z"`
+}
+
+type lastSeen struct {
+	// When the challenge occurred
+	Timestamp time.Time `json:"timestamp" example:"2020-03-22T14:28:00Z"`
+	// The IP address which was whitelisted
+	IpAddress string `json:"ip_address" example:"1.1.1.1"`
 }
 
 type version struct {
@@ -63,33 +157,109 @@ type challengeResponse struct {
 	dataprovider.ACL
 }
 
+type getACL struct {
+	// The whitelisted IP address
+	IpAddress string `json:"ip_address" example:"1.1.1.1"`
+	dataprovider.ACL
+}
+
+type addToken struct {
+	// A unique name for this Token
+	Name      string     `json:"name" example:"ci-pipeline"`
+	// What this Token is allowed to do: users:read, users:write, acls:manage, audit:read, tokens:manage
+	Scopes    []string   `json:"scopes" example:"users:read,audit:read"`
+	// After this date, the Token is no longer valid. Never expires when not set
+	ExpiresAt *time.Time `json:"expires_at,omitempty" example:"2021-03-22T14:28:00Z"`
+}
+
+type getToken struct {
+	// A unique name for this Token
+	Name      string     `json:"name" example:"ci-pipeline"`
+	// What this Token is allowed to do
+	Scopes    []string   `json:"scopes" example:"users:read,audit:read"`
+	// When this Token was created
+	CreatedAt time.Time  `json:"created_at" example:"2020-03-22T14:28:00Z"`
+	// After this date, the Token is no longer valid
+	ExpiresAt *time.Time `json:"expires_at,omitempty" example:"2021-03-22T14:28:00Z"`
+	// True when this Token is past its expiry
+	Expired   bool       `json:"expired" example:"false"`
+}
+
+type tokenCreated struct {
+	getToken
+	// The token, which must be sent as a bearer token. It can not be retrieved again
+	Token string `json:"token" example:"ptg_3f1c..."`
+}
+
 type errorResponse struct {
 	Error string `json:"error"`
 }
 
 func getUserConvert(user *dataprovider.User) getUser {
 	return getUser{
-		ID:              user.ID,
-		Enabled:         user.Enabled,
-		Description:     user.Description,
-		ACLAllowAll:     user.ACLAllowAll,
-		ACLAllowedHosts: user.ACLAllowedHosts,
-		DNSNames:        user.DNSNames,
-		TTLMinutes:      user.TTLMinutes,
+		ID:               user.ID,
+		Enabled:          user.Enabled,
+		Description:      user.Description,
+		Email:            user.Email,
+		ACLAllowAll:      user.ACLAllowAll,
+		ACLAllowedHosts:  user.ACLAllowedHosts,
+		DNSNames:         user.DNSNames,
+		TTLMinutes:       user.TTLMinutes,
+		Groups:           user.Groups,
+		ValidFrom:        user.ValidFrom,
+		ValidUntil:       user.ValidUntil,
+		AccessWindows:    user.AccessWindows,
+		SlidingTTL:       user.SlidingTTL,
+		MaxTTLMinutes:    user.MaxTTLMinutes,
+		MaxIPs:           user.MaxIPs,
+		MaxIPsPolicy:     user.MaxIPsPolicy,
+		AllowedCountries: user.AllowedCountries,
+		IPs:              user.IPs,
+		TOTPEnabled:      user.TOTPSecret != "",
 	}
 }
 
 func getAllUsersConvert(users []dataprovider.User) (getUsers []getUser) {
 	for _, user := range users {
 		getUsers = append (getUsers, getUser{
-			ID:              user.ID,
-			Enabled:         user.Enabled,
-			Description:     user.Description,
-			ACLAllowAll:     user.ACLAllowAll,
-			ACLAllowedHosts: user.ACLAllowedHosts,
-			DNSNames:        user.DNSNames,
-			TTLMinutes:      user.TTLMinutes,
+			ID:               user.ID,
+			Enabled:          user.Enabled,
+			Description:      user.Description,
+			Email:            user.Email,
+			ACLAllowAll:      user.ACLAllowAll,
+			ACLAllowedHosts:  user.ACLAllowedHosts,
+			DNSNames:         user.DNSNames,
+			TTLMinutes:       user.TTLMinutes,
+			Groups:           user.Groups,
+			ValidFrom:        user.ValidFrom,
+			ValidUntil:       user.ValidUntil,
+			AccessWindows:    user.AccessWindows,
+			SlidingTTL:       user.SlidingTTL,
+			MaxTTLMinutes:    user.MaxTTLMinutes,
+			MaxIPs:           user.MaxIPs,
+			MaxIPsPolicy:     user.MaxIPsPolicy,
+			AllowedCountries: user.AllowedCountries,
+			IPs:              user.IPs,
+			TOTPEnabled:      user.TOTPSecret != "",
 		})
 	}
 	return
-}
\ No newline at end of file
+}
+
+func getAllACLsConvert(acls map[string]dataprovider.ACL) (getACLs []getACL) {
+	for ip, acl := range acls {
+		getACLs = append(getACLs, getACL{IpAddress: ip, ACL: acl})
+	}
+	sort.Slice(getACLs, func(i, j int) bool { return getACLs[i].IpAddress < getACLs[j].IpAddress })
+	return
+}
+
+func getTokenConvert(token *dataprovider.Token) getToken {
+	return getToken{
+		Name:      token.Name,
+		Scopes:    token.Scopes,
+		CreatedAt: token.CreatedAt,
+		ExpiresAt: token.ExpiresAt,
+		Expired:   token.IsExpired(),
+	}
+}
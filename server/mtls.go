@@ -0,0 +1,72 @@
+package server
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/gbolo/protego/config"
+)
+
+// clientAuthRequired returns true when requests to a group of routes must present a valid client certificate.
+// when no groups are configured, client certificates are required for every route.
+func clientAuthRequired(group string) bool {
+	if !config.GetBool("server.tls.client_auth_enabled") {
+		return false
+	}
+	groups := config.GetStringSlice("server.tls.client_auth_route_groups")
+	return len(groups) == 0 || containsGroup(groups, group)
+}
+
+// configureClientAuth adds mutual TLS settings to tlsConfig as defined in configuration.
+// when client certificates are only required for some groups of routes, they are
+// verified when given during the handshake and enforced per route by requireClientCert.
+func configureClientAuth(tlsConfig *tls.Config) error {
+	if !config.GetBool("server.tls.client_auth_enabled") {
+		return nil
+	}
+	caFile := config.GetString("server.tls.client_auth_ca")
+	log.Infof("mutual TLS enabled, loading client CA: %s", caFile)
+	caBytes, err := ioutil.ReadFile(caFile)
+	if err != nil {
+		return err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caBytes) {
+		return fmt.Errorf("no valid pem encoded certificate found in %s", caFile)
+	}
+	tlsConfig.ClientCAs = pool
+	if len(config.GetStringSlice("server.tls.client_auth_route_groups")) == 0 {
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	} else {
+		tlsConfig.ClientAuth = tls.VerifyClientCertIfGiven
+	}
+	return nil
+}
+
+// requireClientCert rejects requests without a verified client certificate
+// when client auth is required for the group of routes
+func requireClientCert(group string, next http.Handler) http.Handler {
+	if !clientAuthRequired(group) {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if clientCertSubject(req) == "" {
+			log.Warningf("request from %s to %s rejected: no valid client certificate", remoteIP(req), req.URL.Path)
+			writeJSONResponse(w, http.StatusForbidden, errorResponse{"a valid client certificate is required"})
+			return
+		}
+		next.ServeHTTP(w, req)
+	})
+}
+
+// clientCertSubject returns the subject of the verified client certificate
+// of a request, or an empty string if there is none
+func clientCertSubject(req *http.Request) string {
+	if req.TLS == nil || len(req.TLS.VerifiedChains) == 0 || len(req.TLS.VerifiedChains[0]) == 0 {
+		return ""
+	}
+	return req.TLS.VerifiedChains[0][0].Subject.String()
+}
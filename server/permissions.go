@@ -0,0 +1,124 @@
+package server
+
+import (
+	"sync"
+	"time"
+
+	"github.com/gbolo/protego/config"
+	"github.com/gbolo/protego/dataprovider"
+)
+
+// locations which were already loaded, by name. access_windows.timezone can be reloaded
+var locations sync.Map
+
+// aclPermissions returns what an ACL allows. An ACL which references a user is
+// evaluated against the user's current permissions (including its groups) and
+// Enabled flag, access time and countries, so that changes to the user apply to IPs which are already whitelisted.
+// An ACL which references several users (see ACL.UserIDs) allows what any of its valid users allows.
+// when reason is set, access must be denied regardless of the host.
+func aclPermissions(clientIP string, acl *dataprovider.ACL) (perm dataprovider.Permissions, reason string) {
+	// ACLs created before users were referenced only have their own copy
+	if acl.UserID == "" && len(acl.UserIDs) == 0 {
+		perm.Grant(acl.AllowAll, acl.AllowedHosts)
+		return
+	}
+	if len(acl.UserIDs) <= 1 {
+		return userPermissions(clientIP, acl.UserID)
+	}
+	// the reason of the first user is reported when none of them is valid
+	valid := false
+	for _, userID := range acl.UserIDs {
+		userPerm, userReason := userPermissions(clientIP, userID)
+		if userReason != "" {
+			if reason == "" {
+				reason = userReason
+			}
+			continue
+		}
+		valid = true
+		perm.Grant(userPerm.AllowAll, userPerm.Hosts)
+		for _, group := range userPerm.Groups {
+			if !perm.InAnyGroup([]string{group}) {
+				perm.Groups = append(perm.Groups, group)
+			}
+		}
+	}
+	if valid {
+		reason = ""
+	}
+	return
+}
+
+// userPermissions returns what a user allows clientIP to access, or the reason it must be denied access
+func userPermissions(clientIP, userID string) (perm dataprovider.Permissions, reason string) {
+	user, err := dataProvider.GetUser(userID)
+	if err != nil {
+		log.Errorf("unable to retrieve user %s: %v", userID, err)
+		return perm, reasonInternalError
+	}
+	if user == nil {
+		log.Debugf("user %s of this ACL no longer exists", userID)
+		return perm, reasonUnknownUser
+	}
+	if !user.Enabled {
+		return perm, reasonUserDisabled
+	}
+	if reason = checkAccessTime(user); reason != "" {
+		return
+	}
+	if reason = checkUserCountry(clientIP, user); reason != "" {
+		return
+	}
+	if perm, err = dataprovider.ResolvePermissions(dataProvider, user); err != nil {
+		log.Errorf("unable to resolve permissions of user %s: %v", user.ID, err)
+		return perm, reasonInternalError
+	}
+	return
+}
+
+// checkAccessTime returns the reason a user can not be used right now, or an empty string
+func checkAccessTime(user *dataprovider.User) string {
+	switch user.CheckAccessTime(time.Now(), accessWindowsLocation()) {
+	case nil:
+		return ""
+	case dataprovider.ErrUserNotYetValid:
+		return reasonNotYetValid
+	case dataprovider.ErrUserExpired:
+		return reasonUserExpired
+	default:
+		return reasonOutsideWindow
+	}
+}
+
+// accessWindowsLocation returns the timezone access windows are evaluated in.
+// an invalid timezone is rejected by the config validation, UTC is then used
+func accessWindowsLocation() *time.Location {
+	name := config.GetString("access_windows.timezone")
+	if loc, ok := locations.Load(name); ok {
+		return loc.(*time.Location)
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		log.Errorf("unable to load timezone %s: %v", name, err)
+		loc = time.UTC
+	}
+	locations.Store(name, loc)
+	return loc
+}
+
+// revokeUserACLs removes the ACLs which were created by challenges of a user
+func revokeUserACLs(userID string) {
+	acls, err := dataProvider.GetAllACLs()
+	if err != nil {
+		log.Errorf("unable to revoke ACLs of user %s: %v", userID, err)
+		return
+	}
+	for ip, acl := range acls {
+		if acl.UserID != userID || acl.Source != dataprovider.ACLSourceChallenge {
+			continue
+		}
+		if err = dataProvider.RemoveIp(ip); err != nil {
+			log.Errorf("unable to revoke ACL of %s: %v", ip, err)
+		}
+	}
+}
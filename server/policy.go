@@ -0,0 +1,228 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gbolo/protego/audit"
+	"github.com/gbolo/protego/dataprovider"
+	"github.com/gorilla/mux"
+)
+
+// checkHostPolicy returns the reason the policy of host denies clientIP with acl and perm, or an empty string
+func checkHostPolicy(host, clientIP string, acl *dataprovider.ACL, perm *dataprovider.Permissions) string {
+	pol, err := dataProvider.GetHostPolicy(policyHost(host))
+	if err != nil {
+		log.Errorf("unable to retrieve policy of host %s: %v", host, err)
+		return reasonInternalError
+	}
+	if pol == nil {
+		return ""
+	}
+	switch pol.Check(acl, perm, time.Now()) {
+	case nil:
+		_, reason := checkCountry(clientIP, pol.CheckCountry)
+		return reason
+	case dataprovider.ErrHostTTLExpired:
+		return reasonHostTTLExpired
+	case dataprovider.ErrTOTPRequired:
+		return reasonTOTPRequired
+	default:
+		return reasonGroupNotAllowed
+	}
+}
+
+// policyHost returns the host a policy applies to, so that a policy
+// can not be bypassed with a port, a trailing dot or a different case
+func policyHost(host string) string {
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	return strings.ToLower(strings.TrimSuffix(host, "."))
+}
+
+// decodeHostPolicy reads a host policy from the request body, the host is taken from the path when present
+func decodeHostPolicy(req *http.Request) (pol *dataprovider.HostPolicy, err error) {
+	pol = &dataprovider.HostPolicy{}
+	if err = json.NewDecoder(req.Body).Decode(pol); err != nil {
+		return nil, err
+	}
+	if host, ok := mux.Vars(req)["host"]; ok {
+		pol.Host = host
+	}
+	if err = pol.Validate(); err != nil {
+		return nil, err
+	}
+	return pol, checkGroupsExist(pol.AllowedGroups)
+}
+
+// handlerHostPolicyAdd godoc
+// @Summary Add a new HostPolicy
+// @Description add a policy which restricts access to a host further than the permissions of users
+// @Tags HostPolicy
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "Bearer followed by an admin API token or the admin secret"
+// @Param hostpolicy body dataprovider.HostPolicy true "Add HostPolicy"
+// @Success 200 {object} dataprovider.HostPolicy
+// @Failure 400 "bad request: the host policy is invalid or a group does not exist" {object} errorResponse
+// @Failure 409 "a policy for this host already exists" {object} errorResponse
+// @Router /hostpolicy [post]
+func handlerHostPolicyAdd(w http.ResponseWriter, req *http.Request) {
+	// validate admin credentials
+	actor, ok := authenticateAdmin(w, req, dataprovider.ScopeUsersWrite)
+	if !ok {
+		return
+	}
+
+	pol, err := decodeHostPolicy(req)
+	if err != nil {
+		writeJSONResponse(w, http.StatusBadRequest, errorResponse{"Bad request: " + err.Error()})
+		return
+	}
+	err = dataProvider.AddHostPolicy(pol)
+	switch {
+	case err == dataprovider.ErrHostPolicyExists:
+		writeJSONResponse(w, http.StatusConflict, errorResponse{fmt.Sprintf("Host policy already exists (host: %s). Try Modifying it", pol.Host)})
+		return
+	case err != nil:
+		log.Errorf("couldn't add new host policy: %v", err)
+		writeJSONResponse(w, http.StatusInternalServerError, errorResponse{"Could not add host policy"})
+		return
+	}
+
+	// host policy has been added
+	log.Infof("new host policy has been added: %s", pol.Host)
+	recordAdminChange(req, audit.Event{Type: audit.EventHostPolicyAdd, Target: pol.Host}, actor)
+	writeJSONResponse(w, http.StatusOK, pol)
+}
+
+// handlerHostPolicyUpdate godoc
+// @Summary Update an existing HostPolicy
+// @Description update a host policy, the change applies to IPs which are already whitelisted
+// @Tags HostPolicy
+// @Accept  json
+// @Produce  json
+// @Param Authorization header string true "Bearer followed by an admin API token or the admin secret"
+// @Param host path string true "Host (FQDN)"
+// @Param hostpolicy body dataprovider.HostPolicy true "Update HostPolicy"
+// @Success 200 {object} dataprovider.HostPolicy
+// @Failure 400 "bad request: the host policy is invalid or was not found" {object} errorResponse
+// @Router /hostpolicy/{host} [put]
+func handlerHostPolicyUpdate(w http.ResponseWriter, req *http.Request) {
+	// validate admin credentials
+	actor, ok := authenticateAdmin(w, req, dataprovider.ScopeUsersWrite)
+	if !ok {
+		return
+	}
+
+	pol, err := decodeHostPolicy(req)
+	if err != nil {
+		writeJSONResponse(w, http.StatusBadRequest, errorResponse{"Bad request: " + err.Error()})
+		return
+	}
+	err = dataProvider.UpdateHostPolicy(pol)
+	switch {
+	case err == dataprovider.ErrHostPolicyNotFound:
+		writeJSONResponse(w, http.StatusBadRequest, errorResponse{"host policy was not found"})
+		return
+	case err != nil:
+		log.Errorf("could not update host policy: %v", err)
+		writeJSONResponse(w, http.StatusInternalServerError, errorResponse{"Could not update host policy"})
+		return
+	}
+
+	// host policy has been updated
+	log.Infof("host policy has been updated: %s", pol.Host)
+	recordAdminChange(req, audit.Event{Type: audit.EventHostPolicyUpdate, Target: pol.Host}, actor)
+	writeJSONResponse(w, http.StatusOK, pol)
+}
+
+// handlerHostPolicyGet godoc
+// @Summary Retrieve a HostPolicy based on provided host
+// @Description get HostPolicy by host
+// @Tags HostPolicy
+// @Produce json
+// @Param Authorization header string true "Bearer followed by an admin API token or the admin secret"
+// @Param host path string true "Host (FQDN)"
+// @Success 200 {object} dataprovider.HostPolicy
+// @Router /hostpolicy/{host} [get]
+func handlerHostPolicyGet(w http.ResponseWriter, req *http.Request) {
+	// validate admin credentials
+	if _, ok := authenticateAdmin(w, req, dataprovider.ScopeUsersRead); !ok {
+		return
+	}
+
+	host := policyHost(mux.Vars(req)["host"])
+	pol, err := dataProvider.GetHostPolicy(host)
+	if pol == nil || err != nil {
+		log.Warningf("host policy was not found: %s", host)
+		writeJSONResponse(w, http.StatusBadRequest, errorResponse{"host policy was not found"})
+		return
+	}
+	writeJSONResponse(w, http.StatusOK, pol)
+}
+
+// handlerHostPolicyGetAll godoc
+// @Summary Retrieve all HostPolicies
+// @Description get all HostPolicies
+// @Tags HostPolicy
+// @Produce json
+// @Param Authorization header string true "Bearer followed by an admin API token or the admin secret"
+// @Success 200 {array} dataprovider.HostPolicy
+// @Router /hostpolicy [get]
+func handlerHostPolicyGetAll(w http.ResponseWriter, req *http.Request) {
+	// validate admin credentials
+	if _, ok := authenticateAdmin(w, req, dataprovider.ScopeUsersRead); !ok {
+		return
+	}
+
+	policies, err := dataProvider.GetAllHostPolicies()
+	if err != nil {
+		log.Warningf("could not get all host policies: %v", err)
+		writeJSONResponse(w, http.StatusServiceUnavailable, errorResponse{"could not retrieve all host policies"})
+		return
+	}
+	sort.Slice(policies, func(i, j int) bool { return policies[i].Host < policies[j].Host })
+	writeJSONResponse(w, http.StatusOK, policies)
+}
+
+// handlerHostPolicyDelete godoc
+// @Summary Remove a HostPolicy based on provided host
+// @Description remove a HostPolicy by host, access to the host is then only restricted by the permissions of users
+// @Tags HostPolicy
+// @Produce json
+// @Param Authorization header string true "Bearer followed by an admin API token or the admin secret"
+// @Param host path string true "Host (FQDN)"
+// @Success 200 {object} dataprovider.HostPolicy
+// @Router /hostpolicy/{host} [delete]
+func handlerHostPolicyDelete(w http.ResponseWriter, req *http.Request) {
+	// validate admin credentials
+	actor, ok := authenticateAdmin(w, req, dataprovider.ScopeUsersWrite)
+	if !ok {
+		return
+	}
+
+	host := policyHost(mux.Vars(req)["host"])
+	pol, err := dataProvider.GetHostPolicy(host)
+	if pol == nil || err != nil {
+		log.Warningf("host policy was not found: %s", host)
+		writeJSONResponse(w, http.StatusBadRequest, errorResponse{"host policy was not found"})
+		return
+	}
+	if err = dataProvider.RemoveHostPolicy(pol.Host); err != nil {
+		log.Warningf("unable to remove host policy %s: %v", pol.Host, err)
+		writeJSONResponse(w, http.StatusInternalServerError, errorResponse{"unable to remove host policy"})
+		return
+	}
+
+	// host policy has been removed
+	log.Infof("host policy has been removed: %s", pol.Host)
+	recordAdminChange(req, audit.Event{Type: audit.EventHostPolicyRemove, Target: pol.Host}, actor)
+	writeJSONResponse(w, http.StatusOK, pol)
+}
@@ -0,0 +1,53 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gbolo/protego/dataprovider"
+	"github.com/gorilla/mux"
+	"github.com/spf13/viper"
+)
+
+// hostPolicyRequest returns the status code of handler, called by the admin for host
+func hostPolicyRequest(handler http.HandlerFunc, method, host string) int {
+	req := httptest.NewRequest(method, "/api/v1/hostpolicy/"+host, nil)
+	req.Header.Set("Admin-Secret", viper.GetString("admin.secret"))
+	req = mux.SetURLVars(req, map[string]string{"host": host})
+	w := httptest.NewRecorder()
+	handler(w, req)
+	return w.Code
+}
+
+func TestHostPolicyHandlersNormalizeHost(t *testing.T) {
+	setupTestProvider(t)
+	viper.Set("admin.secret", "supersecret")
+	t.Cleanup(func() { viper.Set("admin.secret", "") })
+	pol := &dataprovider.HostPolicy{Host: "App.Example.com.", MaxTTLMinutes: 60}
+	if err := pol.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if pol.Host != "app.example.com" {
+		t.Fatalf("expected the host of the policy to be normalized, got %s", pol.Host)
+	}
+	if err := dataProvider.AddHostPolicy(pol); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, host := range []string{"app.example.com", "APP.example.com", "app.example.com.", "app.example.com:443"} {
+		if status := hostPolicyRequest(handlerHostPolicyGet, http.MethodGet, host); status != http.StatusOK {
+			t.Errorf("expected the policy to be found for %s, got %d", host, status)
+		}
+	}
+	if status := hostPolicyRequest(handlerHostPolicyGet, http.MethodGet, "other.example.com"); status != http.StatusBadRequest {
+		t.Errorf("expected no policy for another host, got %d", status)
+	}
+
+	if status := hostPolicyRequest(handlerHostPolicyDelete, http.MethodDelete, "App.Example.COM."); status != http.StatusOK {
+		t.Fatalf("expected the policy to be removed, got %d", status)
+	}
+	if pol, err := dataProvider.GetHostPolicy("app.example.com"); err != nil || pol != nil {
+		t.Fatalf("expected the policy to be gone, got %v (error: %v)", pol, err)
+	}
+}
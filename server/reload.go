@@ -0,0 +1,69 @@
+package server
+
+import (
+	"crypto/tls"
+	"sync"
+
+	"github.com/gbolo/protego/config"
+)
+
+// the TLS certificate presented by the server
+var serverCert = &certificate{lock: new(sync.RWMutex)}
+
+// certificate holds a TLS certificate which can be swapped
+// without dropping established connections
+type certificate struct {
+	cert *tls.Certificate
+	lock *sync.RWMutex
+}
+
+// load reads the configured cert and key. The current certificate is kept on failure.
+func (c *certificate) load() error {
+	log.Debugf("loading TLS cert and key: %s %s", config.GetString("server.tls.cert_chain"), config.GetString("server.tls.private_key"))
+	cert, err := tls.LoadX509KeyPair(config.GetString("server.tls.cert_chain"), config.GetString("server.tls.private_key"))
+	if err != nil {
+		return err
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.cert = &cert
+	return nil
+}
+
+// getCertificate is used as tls.Config.GetCertificate
+func (c *certificate) getCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return c.cert, nil
+}
+
+// Reload applies changes to the config file while the server is running.
+// An invalid config file is rejected as a whole. Settings which are read per
+// request (like admin.secret and server.access_log) take effect immediately,
+// the log level, TLS certificate and GeoIP database are applied here, and every other
+// changed setting is reported as requiring a restart.
+func Reload() {
+	log.Info("reloading configuration")
+	restartRequired, err := config.Reload()
+	if err != nil {
+		log.Errorf("configuration reload rejected, keeping current configuration: %v", err)
+		return
+	}
+	if config.GetBool("server.tls.enabled") {
+		if err = serverCert.load(); err != nil {
+			log.Errorf("unable to reload TLS cert and key, keeping current ones: %v", err)
+		} else {
+			log.Info("TLS cert and key have been reloaded")
+		}
+	}
+	if err = loadGeoIP(); err != nil {
+		log.Errorf("unable to reload GeoIP database, keeping current one: %v", err)
+	} else if config.GetBool("geoip.enabled") {
+		log.Info("GeoIP database has been reloaded")
+	}
+	// decisions may depend on settings which have changed
+	decisions.flush()
+	for _, setting := range restartRequired {
+		log.Warningf("setting %s has changed but requires a restart to take effect", setting)
+	}
+}
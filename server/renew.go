@@ -0,0 +1,22 @@
+package server
+
+import (
+	"time"
+
+	"github.com/gbolo/protego/config"
+	"github.com/gbolo/protego/dataprovider"
+)
+
+// renewACL extends the sliding TTL of an authorized IP. To avoid a write to the
+// data provider on every request, the ACL is only updated once its TTL can be
+// extended by at least sliding_ttl.renew_interval
+func renewACL(clientIP string, acl *dataprovider.ACL) {
+	if !acl.Renew(time.Now(), config.GetDuration("sliding_ttl.renew_interval")) {
+		return
+	}
+	if err := dataProvider.UpdateACL(clientIP, acl); err != nil {
+		log.Errorf("unable to renew ACL of %s: %v", clientIP, err)
+		return
+	}
+	log.Debugf("user IP (%s) TTL has been renewed to: %v", clientIP, *acl.TTL)
+}
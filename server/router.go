@@ -7,10 +7,11 @@ import (
 	_ "net/http/pprof"
 
 	"github.com/gbolo/protego/asset"
+	"github.com/gbolo/protego/config"
 	_ "github.com/gbolo/protego/docs"
 	"github.com/gorilla/handlers"
 	"github.com/gorilla/mux"
-	"github.com/spf13/viper"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	httpSwagger "github.com/swaggo/http-swagger"
 )
 
@@ -25,12 +26,25 @@ func getEndpoint(suffix string) string {
 	return fmt.Sprintf(endpointFormat, APIVersion, suffix)
 }
 
+// groups of routes, used to apply settings to several routes at once
+const (
+	// the challenge UI and API, used by end users
+	routeGroupPublic = "public"
+	// the authorize endpoint, used by nginx
+	routeGroupAuthorize = "authorize"
+	// the admin API
+	routeGroupAdmin = "admin"
+	// swagger, profiler and metrics
+	routeGroupDebug = "debug"
+)
+
 // Route defines a route passed to our mux
 type Route struct {
 	Name        string
 	Method      string
 	Pattern     string
 	HandlerFunc http.HandlerFunc
+	Group       string
 }
 
 // Routes holds a list of Routes
@@ -45,6 +59,7 @@ var routes = Routes{
 		"GET",
 		getEndpoint("version"),
 		handlerVersion,
+		routeGroupPublic,
 	},
 
 	Route{
@@ -52,6 +67,7 @@ var routes = Routes{
 		"GET",
 		getEndpoint("authorize"),
 		handlerAuthorize,
+		routeGroupAuthorize,
 	},
 
 	Route{
@@ -59,6 +75,7 @@ var routes = Routes{
 		"POST",
 		getEndpoint("challenge"),
 		handlerChallenge,
+		routeGroupPublic,
 	},
 
 	Route{
@@ -66,6 +83,7 @@ var routes = Routes{
 		"POST",
 		getEndpoint("user"),
 		handlerUserAdd,
+		routeGroupAdmin,
 	},
 
 	Route{
@@ -73,6 +91,7 @@ var routes = Routes{
 		"PUT",
 		getEndpoint("user/{user-id}"),
 		handlerUserUpdate,
+		routeGroupAdmin,
 	},
 
 	Route{
@@ -80,6 +99,7 @@ var routes = Routes{
 		"DELETE",
 		getEndpoint("user/{user-id}"),
 		handlerUserDelete,
+		routeGroupAdmin,
 	},
 
 	Route{
@@ -87,6 +107,7 @@ var routes = Routes{
 		"GET",
 		getEndpoint("user/{user-id}"),
 		handlerUserGet,
+		routeGroupAdmin,
 	},
 
 	Route{
@@ -94,20 +115,236 @@ var routes = Routes{
 		"GET",
 		getEndpoint("user"),
 		handlerUserGetAll,
+		routeGroupAdmin,
+	},
+
+	Route{
+		"UserTOTPEnable",
+		"POST",
+		getEndpoint("user/{user-id}/totp"),
+		handlerUserTOTPEnable,
+		routeGroupAdmin,
+	},
+
+	Route{
+		"UserTOTPDisable",
+		"DELETE",
+		getEndpoint("user/{user-id}/totp"),
+		handlerUserTOTPDisable,
+		routeGroupAdmin,
+	},
+
+	Route{
+		"HostPolicyAdd",
+		"POST",
+		getEndpoint("hostpolicy"),
+		handlerHostPolicyAdd,
+		routeGroupAdmin,
+	},
+
+	Route{
+		"HostPolicyModify",
+		"PUT",
+		getEndpoint("hostpolicy/{host}"),
+		handlerHostPolicyUpdate,
+		routeGroupAdmin,
+	},
+
+	Route{
+		"HostPolicyRemove",
+		"DELETE",
+		getEndpoint("hostpolicy/{host}"),
+		handlerHostPolicyDelete,
+		routeGroupAdmin,
+	},
+
+	Route{
+		"HostPolicyGet",
+		"GET",
+		getEndpoint("hostpolicy/{host}"),
+		handlerHostPolicyGet,
+		routeGroupAdmin,
+	},
+
+	Route{
+		"HostPoliciesGetAll",
+		"GET",
+		getEndpoint("hostpolicy"),
+		handlerHostPolicyGetAll,
+		routeGroupAdmin,
+	},
+
+	Route{
+		"GroupAdd",
+		"POST",
+		getEndpoint("group"),
+		handlerGroupAdd,
+		routeGroupAdmin,
+	},
+
+	Route{
+		"GroupModify",
+		"PUT",
+		getEndpoint("group/{name}"),
+		handlerGroupUpdate,
+		routeGroupAdmin,
+	},
+
+	Route{
+		"GroupRemove",
+		"DELETE",
+		getEndpoint("group/{name}"),
+		handlerGroupDelete,
+		routeGroupAdmin,
+	},
+
+	Route{
+		"GroupGet",
+		"GET",
+		getEndpoint("group/{name}"),
+		handlerGroupGet,
+		routeGroupAdmin,
+	},
+
+	Route{
+		"GroupsGetAll",
+		"GET",
+		getEndpoint("group"),
+		handlerGroupGetAll,
+		routeGroupAdmin,
+	},
+
+	Route{
+		"HostSetAdd",
+		"POST",
+		getEndpoint("hostset"),
+		handlerHostSetAdd,
+		routeGroupAdmin,
+	},
+
+	Route{
+		"HostSetModify",
+		"PUT",
+		getEndpoint("hostset/{name}"),
+		handlerHostSetUpdate,
+		routeGroupAdmin,
+	},
+
+	Route{
+		"HostSetRemove",
+		"DELETE",
+		getEndpoint("hostset/{name}"),
+		handlerHostSetDelete,
+		routeGroupAdmin,
+	},
+
+	Route{
+		"HostSetGet",
+		"GET",
+		getEndpoint("hostset/{name}"),
+		handlerHostSetGet,
+		routeGroupAdmin,
+	},
+
+	Route{
+		"HostSetsGetAll",
+		"GET",
+		getEndpoint("hostset"),
+		handlerHostSetGetAll,
+		routeGroupAdmin,
+	},
+
+	Route{
+		"ACLGetAll",
+		"GET",
+		getEndpoint("acl"),
+		handlerACLGetAll,
+		routeGroupAdmin,
+	},
+
+	Route{
+		"ACLRemove",
+		"DELETE",
+		getEndpoint("acl/{ip}"),
+		handlerACLDelete,
+		routeGroupAdmin,
+	},
+
+	Route{
+		"TokenAdd",
+		"POST",
+		getEndpoint("token"),
+		handlerTokenAdd,
+		routeGroupAdmin,
+	},
+
+	Route{
+		"TokensGetAll",
+		"GET",
+		getEndpoint("token"),
+		handlerTokenGetAll,
+		routeGroupAdmin,
+	},
+
+	Route{
+		"TokenRemove",
+		"DELETE",
+		getEndpoint("token/{name}"),
+		handlerTokenDelete,
+		routeGroupAdmin,
+	},
+
+	Route{
+		"DenyAdd",
+		"POST",
+		getEndpoint("deny"),
+		handlerDenyAdd,
+		routeGroupAdmin,
+	},
+
+	Route{
+		"DenyGetAll",
+		"GET",
+		getEndpoint("deny"),
+		handlerDenyGetAll,
+		routeGroupAdmin,
+	},
+
+	Route{
+		"DenyRemove",
+		"DELETE",
+		// a CIDR contains a slash
+		getEndpoint("deny/{cidr:.+}"),
+		handlerDenyDelete,
+		routeGroupAdmin,
+	},
+
+	Route{
+		"AuditGet",
+		"GET",
+		getEndpoint("audit"),
+		handlerAuditGet,
+		routeGroupAdmin,
 	},
 }
 
-func newRouter() *mux.Router {
+// newRouter returns a router which only serves the specified groups of routes
+func newRouter(groups []string) *mux.Router {
 
 	router := mux.NewRouter().StrictSlash(true)
 	for _, route := range routes {
+		if !containsGroup(groups, route.Group) {
+			continue
+		}
 
 		// add compression support to handler if enabled
 		var handler http.Handler
 		handler = route.HandlerFunc
-		if viper.GetBool("server.compression") {
+		if config.GetBool("server.compression") {
 			handler = handlers.CompressHandler(route.HandlerFunc)
 		}
+		handler = requireClientCert(route.Group, handler)
+		handler = instrumentRoute(route.Name, handler)
 
 		// add routes to mux
 		router.
@@ -117,30 +354,52 @@ func newRouter() *mux.Router {
 			Handler(handler)
 	}
 
+	if containsGroup(groups, routeGroupDebug) {
+		addDebugRoutes(router)
+	}
+	if containsGroup(groups, routeGroupPublic) {
+		addStaticRoutes(router)
+	}
+
+	return router
+}
+
+// addDebugRoutes adds swagger, and if enabled, pprof and prometheus metrics
+func addDebugRoutes(router *mux.Router) {
 	// add swagger UI
-	router.Methods("GET").Path("/swagger").HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+	router.Methods("GET").Path("/swagger").Handler(requireClientCert(routeGroupDebug, http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
 		// redirect to /swagger/index.html which is provided by httpSwagger.WrapHandler
 		http.Redirect(w, req, "/swagger/index.html", 301)
-	})
-	router.Methods("GET").PathPrefix("/swagger").Handler(httpSwagger.WrapHandler)
+	})))
+	router.Methods("GET").PathPrefix("/swagger").Handler(requireClientCert(routeGroupDebug, httpSwagger.WrapHandler))
 
 	// add route for pprof if enabled
-	if viper.GetBool("server.enable_profiler") {
+	if config.GetBool("server.enable_profiler") {
 		log.Warning("profiler is enabled on endpoint /debug/")
-		router.Methods("GET").PathPrefix("/debug/").Handler(http.DefaultServeMux)
+		router.Methods("GET").PathPrefix("/debug/").Handler(requireClientCert(routeGroupDebug, http.DefaultServeMux))
 	}
 
+	// add route for prometheus metrics if enabled
+	if config.GetBool("server.enable_metrics") {
+		log.Info("prometheus metrics are enabled on endpoint /metrics")
+		router.Methods("GET").Path("/metrics").Handler(requireClientCert(routeGroupDebug, promhttp.Handler()))
+	}
+}
+
+// addStaticRoutes adds the embedded assets (the challenge UI).
+// this must be added last, since it matches every path
+func addStaticRoutes(router *mux.Router) {
 	// add embedded assets
 	handlerStatic := http.StripPrefix("/", http.FileServer(asset.Assets))
 	// add compression support to handler if enabled
-	if viper.GetBool("server.compression") {
+	if config.GetBool("server.compression") {
 		handlerStatic = handlers.CompressHandler(handlerStatic)
 	}
+	handlerStatic = requireClientCert(routeGroupPublic, handlerStatic)
+	handlerStatic = instrumentRoute("Static", handlerStatic)
 
 	router.
 		Methods("GET").
 		PathPrefix("/").
 		Handler(handlerStatic)
-
-	return router
 }
@@ -5,23 +5,26 @@
 package server
 
 import (
+	"context"
 	"crypto/tls"
 	"fmt"
 	"net/http"
 	"os"
+	"sync"
 	"time"
 
+	"github.com/gbolo/protego/audit"
 	"github.com/gbolo/protego/config"
 	"github.com/gbolo/protego/dataprovider"
+	"github.com/gbolo/protego/metrics"
 	"github.com/gorilla/handlers"
 	"github.com/gorilla/mux"
-	"github.com/spf13/viper"
 )
 
 var (
 	log          = config.GetLogger()
 	dataProvider dataprovider.Provider
-	ddnsProvider dataprovider.DdnsProvider
+	ddnsProvider *dataprovider.DdnsProvider
 
 	// set timeouts to avoid Slowloris attacks.
 	httpWriteTimeout = time.Second * 15
@@ -58,7 +61,11 @@ var (
 	}
 )
 
-func InitServer(p dataprovider.Provider) error {
+// InitServer starts all background workers and the http server, then blocks
+// until ctx is cancelled or the http server fails. In-flight requests are
+// drained and background workers are stopped before returning.
+// The data provider is NOT closed, since it is owned by the caller.
+func InitServer(ctx context.Context, p dataprovider.Provider) error {
 	if p == nil {
 		return fmt.Errorf("data provider is nil")
 	}
@@ -67,60 +74,157 @@ func InitServer(p dataprovider.Provider) error {
 	}
 	// set the data provider
 	dataProvider = p
+	// cache authorize decisions, which are invalidated by every change to the data provider
+	if config.GetBool("authorize_cache.enabled") {
+		decisions = newDecisionCache(config.GetInt("authorize_cache.max_entries"))
+		dataProvider = &cachingProvider{Provider: p, cache: decisions}
+	}
+	// open the audit log
+	if err := audit.Init(); err != nil {
+		return err
+	}
+	// open the GeoIP database, used to locate clients
+	if err := loadGeoIP(); err != nil {
+		return err
+	}
+	// persist audit events so they can be queried
+	if config.GetBool("audit.history.enabled") {
+		history = newHistorySink(dataProvider)
+		if err := history.prune(); err != nil {
+			log.Warningf("unable to prune audit history: %v", err)
+		}
+		if err := history.loadLastSeen(); err != nil {
+			log.Warningf("unable to retrieve when users were last seen: %v", err)
+		}
+		audit.AddSink(audit.NewAsyncSink(history, historyQueueSize))
+	}
+	// notify users by email when their access is used from a new IP
+	if config.GetBool("email.enabled") {
+		notifications, err := newEmailSink(dataProvider)
+		if err != nil {
+			return err
+		}
+		log.Infof("email notifications enabled: sending through %s", config.GetString("email.smtp.host"))
+		audit.AddSink(notifications)
+	}
+	// expose the number of ACLs in prometheus metrics
+	if err := metrics.RegisterACLCounter(countACLs); err != nil {
+		return err
+	}
 	// set the dynamic dns provider
-	ddnsProvider = dataprovider.NewDdnsProvider()
+	ddnsProvider = dataprovider.NewDdnsProvider(dataProvider, nil, config.GetDuration("ddns.update_interval"))
 	// populate any existing users from dataprovider into ddnsprovider
 	users, err := dataProvider.GetAllUsers()
 	if err != nil {
 		return err
 	}
 	ddnsProvider.ProcessUsers(users)
+
+	// background workers are stopped once the http server exits
+	workerCtx, stopWorkers := context.WithCancel(context.Background())
+	workers := new(sync.WaitGroup)
+	defer func() {
+		log.Info("stopping background workers")
+		stopWorkers()
+		workers.Wait()
+		// no more audit events can be produced at this point
+		audit.Close()
+	}()
+	workers.Add(1)
+	go func() {
+		defer workers.Done()
+		ddnsProvider.Run(workerCtx)
+	}()
+	// reload the config when the config file changes
+	if config.GetBool("config.watch") {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			if err := config.WatchConfigFile(workerCtx, Reload); err != nil {
+				log.Errorf("unable to watch config file: %v", err)
+			}
+		}()
+	}
+
 	// start http server
-	return startHTTPServer()
+	return startHTTPServer(ctx)
 }
 
-func startHTTPServer() (err error) {
-
-	// create routes
-	mux := newRouter()
+// startHTTPServer starts an http server for every configured listener, then
+// blocks until ctx is cancelled or one of them fails. All http servers are
+// shutdown before returning, draining their in-flight requests.
+func startHTTPServer(ctx context.Context) (err error) {
 
-	// get server config
-	srv := configureHTTPServer(mux)
+	// get listeners config
+	listeners, err := configureListeners()
+	if err != nil {
+		return err
+	}
 
 	// get TLS config
 	tlsConifig, err := configureTLS()
 	if err != nil {
-		log.Fatalf("error configuring TLS: %s", err)
-		return
+		return fmt.Errorf("error configuring TLS: %s", err)
 	}
-	srv.TLSConfig = &tlsConifig
 
-	// start the server
-	if viper.GetBool("server.tls.enabled") {
-		// cert and key should already be configured
-		log.Infof("starting HTTP server with TLS enabled: listening on %s", srv.Addr)
-		err = srv.ListenAndServeTLS("", "")
-	} else {
-		log.Infof("starting HTTP server: listening on %s", srv.Addr)
-		err = srv.ListenAndServe()
+	// start a server for each listener
+	var servers []*http.Server
+	serverErr := make(chan error, len(listeners))
+	for _, l := range listeners {
+		ln, listenErr := l.listen()
+		if listenErr != nil {
+			serverErr <- fmt.Errorf("%s listener: %v", l.name, listenErr)
+			break
+		}
+		// create routes and get server config
+		srv := configureHTTPServer(newRouter(l.groups), l.address)
+		if l.tls {
+			srv.TLSConfig = &tlsConifig
+		}
+		servers = append(servers, srv)
+		go func(l listener) {
+			if l.tls {
+				// cert and key should already be configured
+				log.Infof("starting HTTP server (%s) with TLS enabled: listening on %s for routes %v", l.name, srv.Addr, l.groups)
+				serverErr <- fmt.Errorf("%s listener: %v", l.name, srv.ServeTLS(ln, "", ""))
+			} else {
+				log.Infof("starting HTTP server (%s): listening on %s for routes %v", l.name, srv.Addr, l.groups)
+				serverErr <- fmt.Errorf("%s listener: %v", l.name, srv.Serve(ln))
+			}
+		}(l)
 	}
 
-	if err != nil {
-		log.Fatalf("failed to start server: %s", err)
+	select {
+	case err = <-serverErr:
+		err = fmt.Errorf("failed to start server: %s", err)
+	case <-ctx.Done():
 	}
 
+	// stop accepting new connections and wait for in-flight requests
+	timeout := config.GetDuration("server.shutdown_timeout")
+	log.Infof("shutting down HTTP server(s): waiting up to %v for in-flight requests", timeout)
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	shutdownErrs := make(chan error, len(servers))
+	for _, srv := range servers {
+		go func(srv *http.Server) {
+			shutdownErrs <- srv.Shutdown(shutdownCtx)
+		}(srv)
+	}
+	for range servers {
+		if shutdownErr := <-shutdownErrs; shutdownErr != nil && err == nil {
+			err = fmt.Errorf("failed to shutdown server gracefully: %s", shutdownErr)
+		}
+	}
+	if err == nil {
+		log.Info("HTTP server(s) have been shutdown")
+	}
 	return
 }
 
-func configureHTTPServer(mux *mux.Router) (httpServer *http.Server) {
+func configureHTTPServer(mux *mux.Router, address string) (httpServer *http.Server) {
 
 	// apply standard http server settings
-	address := fmt.Sprintf(
-		"%s:%s",
-		viper.GetString("server.bind_address"),
-		viper.GetString("server.bind_port"),
-	)
-
 	httpServer = &http.Server{
 		Addr: address,
 
@@ -133,38 +237,49 @@ func configureHTTPServer(mux *mux.Router) (httpServer *http.Server) {
 	httpServer.SetKeepAlivesEnabled(true)
 
 	// stdout access log enable/disable
-	if viper.GetBool("server.access_log") {
-		httpServer.Handler = handlers.CombinedLoggingHandler(os.Stdout, mux)
-	} else {
-		httpServer.Handler = mux
-	}
+	httpServer.Handler = accessLogHandler(mux)
 
 	return
 }
 
+// accessLogHandler writes an access log to stdout when server.access_log is enabled.
+// the setting is checked on every request, so that it can be changed by a config reload
+func accessLogHandler(next http.Handler) http.Handler {
+	logged := handlers.CombinedLoggingHandler(os.Stdout, next)
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if config.GetBool("server.access_log") {
+			logged.ServeHTTP(w, req)
+			return
+		}
+		next.ServeHTTP(w, req)
+	})
+}
+
 // configure TLS as defined in configuration
 func configureTLS() (tlsConfig tls.Config, err error) {
 
-	if !viper.GetBool("server.tls.enabled") {
+	if !config.GetBool("server.tls.enabled") {
 		log.Debug("TLS not enabled, skipping TLS config")
 		return
 	}
 
 	// attempt to load configured cert/key
 	log.Info("TLS enabled, loading cert and key")
-	log.Debugf("loading TLS cert and key: %s %s", viper.GetString("server.tls.cert_chain"), viper.GetString("server.tls.private_key"))
-	cert, err := tls.LoadX509KeyPair(viper.GetString("server.tls.cert_chain"), viper.GetString("server.tls.private_key"))
-	if err != nil {
+	if err = serverCert.load(); err != nil {
 		return
 	}
 
 	// configure hardened TLS settings
-	tlsConfig.Certificates = []tls.Certificate{cert}
+	// the certificate is retrieved for every handshake, so that it can be swapped by a config reload
+	tlsConfig.GetCertificate = serverCert.getCertificate
 	tlsConfig.MinVersion = tlsMinVersion
 	tlsConfig.InsecureSkipVerify = false
 	tlsConfig.PreferServerCipherSuites = true
 	tlsConfig.CurvePreferences = tlsCurvePreferences
 	tlsConfig.CipherSuites = tlsCiphers
 
+	// configure client cert authentication
+	err = configureClientAuth(&tlsConfig)
+
 	return
 }
@@ -0,0 +1,179 @@
+package server
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gbolo/protego/audit"
+	"github.com/gbolo/protego/dataprovider"
+	logging "github.com/op/go-logging"
+	"github.com/spf13/viper"
+)
+
+func TestMain(m *testing.M) {
+	// decisions are logged at debug level, which would flood benchmarks
+	logging.SetLevel(logging.ERROR, "")
+	os.Exit(m.Run())
+}
+
+// setupTestProvider sets a bolt data provider (in a temporary directory) as the data provider of
+// the server, with an empty DDNS provider and without a decision cache. It is reset when the test ends
+func setupTestProvider(tb testing.TB) dataprovider.Provider {
+	tb.Helper()
+	viper.Set("db.bolt.file", filepath.Join(tb.TempDir(), "protego.db"))
+	p, err := dataprovider.NewBoltProvider()
+	if err != nil {
+		tb.Fatalf("unable to create bolt provider: %v", err)
+	}
+	dataProvider = &p
+	ddnsProvider = dataprovider.NewDdnsProvider(nil, nil, time.Hour)
+	decisions = nil
+	tb.Cleanup(func() {
+		p.Close()
+		dataProvider, ddnsProvider, decisions = nil, nil, nil
+	})
+	return dataProvider
+}
+
+// addTestUser adds an enabled user allowed to access hosts, and whitelists its IPs
+func addTestUser(tb testing.TB, secret string, hosts []string, ips ...string) *dataprovider.User {
+	tb.Helper()
+	u, err := dataprovider.NewUser(secret, "test")
+	if err != nil {
+		tb.Fatal(err)
+	}
+	u.ACLAllowedHosts = hosts
+	if err = dataProvider.AddUser(u); err != nil {
+		tb.Fatal(err)
+	}
+	for _, ip := range ips {
+		acl := dataprovider.ACL{AllowedHosts: hosts, Source: dataprovider.ACLSourceChallenge, UserID: u.ID}
+		if _, _, err = dataProvider.AddUserIp(ip, &acl); err != nil {
+			tb.Fatal(err)
+		}
+	}
+	return u
+}
+
+// authorize returns the status code of an authorize request of clientIP for host
+func authorize(clientIP, host string) int {
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/authorize", nil)
+	req.Header.Set("X-Real-IP", clientIP)
+	req.Host = host
+	w := httptest.NewRecorder()
+	handlerAuthorize(w, req)
+	return w.Code
+}
+
+// challenge returns the status code of a challenge of clientIP with secret
+func challenge(clientIP, secret string) int {
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/challenge", nil)
+	req.Header.Set("X-Real-IP", clientIP)
+	req.Header.Set("User-Secret", secret)
+	w := httptest.NewRecorder()
+	handlerChallenge(w, req)
+	return w.Code
+}
+
+// eventRecorder is an audit sink which keeps the events it receives
+type eventRecorder struct {
+	events []audit.Event
+	lock   sync.Mutex
+}
+
+// recordEvents registers an eventRecorder, which is removed when the test ends
+func recordEvents(tb testing.TB) *eventRecorder {
+	r := &eventRecorder{}
+	audit.AddSink(r)
+	tb.Cleanup(audit.Close)
+	return r
+}
+
+func (r *eventRecorder) Write(e *audit.Event) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.events = append(r.events, *e)
+	return nil
+}
+
+// ofType returns the recorded events of type eventType
+func (r *eventRecorder) ofType(eventType string) (events []audit.Event) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	for _, e := range r.events {
+		if e.Type == eventType {
+			events = append(events, e)
+		}
+	}
+	return
+}
+
+func TestUnixSocketListener(t *testing.T) {
+	setupTestProvider(t)
+	path := filepath.Join(t.TempDir(), "protego.sock")
+	viper.Set("server.unix_socket.enabled", true)
+	viper.Set("server.unix_socket.path", path)
+	viper.Set("server.unix_socket.mode", "0600")
+	viper.Set("server.unix_socket.route_groups", []string{routeGroupAuthorize})
+	t.Cleanup(func() {
+		viper.Set("server.unix_socket.enabled", false)
+		viper.Set("server.unix_socket.route_groups", []string{})
+	})
+
+	listeners, err := configureListeners()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(listeners) != 2 || listeners[0].name != "unix" || containsGroup(listeners[1].groups, routeGroupAuthorize) {
+		t.Fatalf("expected authorize to only be served on the unix socket, got %+v", listeners)
+	}
+	// a socket left behind by an unclean shutdown is replaced
+	for i := 0; i < 2; i++ {
+		ln, err := listeners[0].listen()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if i == 0 {
+			// keeps the socket file
+			ln.(*net.UnixListener).SetUnlinkOnClose(false)
+			ln.Close()
+			continue
+		}
+		t.Cleanup(func() { ln.Close() })
+		go http.Serve(ln, newRouter(listeners[0].groups))
+	}
+	if fi, err := os.Stat(path); err != nil || fi.Mode().Perm() != 0600 {
+		t.Fatalf("expected the socket to have mode 0600, got %v (%v)", fi.Mode().Perm(), err)
+	}
+
+	client := &http.Client{Transport: &http.Transport{
+		DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+			return new(net.Dialer).DialContext(ctx, "unix", path)
+		},
+	}}
+	resp, err := client.Get("http://unix/api/v1/authorize")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected authorize to be served on the unix socket, got %d", resp.StatusCode)
+	}
+
+	// any other kind of file is never removed
+	regular := filepath.Join(t.TempDir(), "protego.sock")
+	if err = os.WriteFile(regular, nil, 0600); err != nil {
+		t.Fatal(err)
+	}
+	l := listener{name: "unix", network: "unix", address: regular}
+	if _, err = l.listen(); err == nil {
+		t.Fatal("expected a regular file to be refused as the unix socket")
+	}
+}
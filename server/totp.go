@@ -0,0 +1,86 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/gbolo/protego/audit"
+	"github.com/gbolo/protego/dataprovider"
+	"github.com/gorilla/mux"
+)
+
+// handlerUserTOTPEnable godoc
+// @Summary Enroll a User in TOTP
+// @Description generate a new TOTP secret for a User, which then also requires its current TOTP code (header User-TOTP) to pass a challenge. The secret is only returned once
+// @Tags User
+// @Produce json
+// @Param Authorization header string true "Bearer followed by an admin API token or the admin secret"
+// @Param id path string true "User ID"
+// @Success 200 {object} server.totpEnrollment
+// @Router /user/{id}/totp [post]
+func handlerUserTOTPEnable(w http.ResponseWriter, req *http.Request) {
+	// validate admin credentials
+	actor, ok := authenticateAdmin(w, req, dataprovider.ScopeUsersWrite)
+	if !ok {
+		return
+	}
+
+	userId := mux.Vars(req)["user-id"]
+	user, err := dataProvider.GetUser(userId)
+	if user == nil || err != nil {
+		log.Warningf("user was not found: %s", userId)
+		writeJSONResponse(w, http.StatusBadRequest, errorResponse{"user was not found"})
+		return
+	}
+	if user.TOTPSecret, err = dataprovider.GenerateTOTPSecret(); err != nil {
+		log.Errorf("unable to generate TOTP secret: %v", err)
+		writeJSONResponse(w, http.StatusInternalServerError, errorResponse{"there was an error handling this request"})
+		return
+	}
+	if err = dataProvider.UpdateUser(user); err != nil {
+		log.Errorf("could not update user: %v", err)
+		writeJSONResponse(w, http.StatusInternalServerError, errorResponse{"Could not update user"})
+		return
+	}
+
+	log.Infof("user has been enrolled in TOTP: %s", user.ID)
+	recordAdminChange(req, audit.Event{Type: audit.EventUserUpdate, UserID: user.ID, Target: "totp"}, actor)
+	writeJSONResponse(w, http.StatusOK, totpEnrollment{
+		Secret: user.TOTPSecret,
+		URI:    dataprovider.TOTPURI(user.ID, user.TOTPSecret),
+	})
+}
+
+// handlerUserTOTPDisable godoc
+// @Summary Remove the TOTP of a User
+// @Description remove the TOTP secret of a User, a challenge then only requires its secret
+// @Tags User
+// @Produce json
+// @Param Authorization header string true "Bearer followed by an admin API token or the admin secret"
+// @Param id path string true "User ID"
+// @Success 200 {object} server.getUser
+// @Router /user/{id}/totp [delete]
+func handlerUserTOTPDisable(w http.ResponseWriter, req *http.Request) {
+	// validate admin credentials
+	actor, ok := authenticateAdmin(w, req, dataprovider.ScopeUsersWrite)
+	if !ok {
+		return
+	}
+
+	userId := mux.Vars(req)["user-id"]
+	user, err := dataProvider.GetUser(userId)
+	if user == nil || err != nil {
+		log.Warningf("user was not found: %s", userId)
+		writeJSONResponse(w, http.StatusBadRequest, errorResponse{"user was not found"})
+		return
+	}
+	user.TOTPSecret = ""
+	if err = dataProvider.UpdateUser(user); err != nil {
+		log.Errorf("could not update user: %v", err)
+		writeJSONResponse(w, http.StatusInternalServerError, errorResponse{"Could not update user"})
+		return
+	}
+
+	log.Infof("TOTP of user has been removed: %s", user.ID)
+	recordAdminChange(req, audit.Event{Type: audit.EventUserUpdate, UserID: user.ID, Target: "totp"}, actor)
+	writeJSONResponse(w, http.StatusOK, getUserConvert(user))
+}
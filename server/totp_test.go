@@ -0,0 +1,108 @@
+package server
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gbolo/protego/audit"
+)
+
+// totpCode returns the TOTP code (RFC 6238) of secret at t
+func totpCode(t *testing.T, secret string, at time.Time) string {
+	t.Helper()
+	key, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(secret)
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := make([]byte, 8)
+	binary.BigEndian.PutUint64(msg, uint64(at.Unix()/30))
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg)
+	sum := mac.Sum(nil)
+	offset := sum[len(sum)-1] & 0x0f
+	return fmt.Sprintf("%06d", (binary.BigEndian.Uint32(sum[offset:offset+4])&0x7fffffff)%1000000)
+}
+
+// totpChallenge returns the status code of a challenge of clientIP with secret and a TOTP code
+func totpChallenge(clientIP, secret, code string) int {
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/challenge", nil)
+	req.Header.Set("X-Real-IP", clientIP)
+	req.Header.Set("User-Secret", secret)
+	req.Header.Set("User-TOTP", code)
+	w := httptest.NewRecorder()
+	handlerChallenge(w, req)
+	return w.Code
+}
+
+func TestChallengeTOTPReplay(t *testing.T) {
+	setupTestProvider(t)
+	events := recordEvents(t)
+	u := addTestUser(t, "secret123", []string{"a.example.com"})
+	u.TOTPSecret = "JBSWY3DPEHPK3PXP"
+	if err := dataProvider.UpdateUser(u); err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Now()
+	previous, current := totpCode(t, u.TOTPSecret, now.Add(-30*time.Second)), totpCode(t, u.TOTPSecret, now)
+	if status := totpChallenge("10.0.0.1", "secret123", "abcdef"); status != http.StatusUnauthorized {
+		t.Fatalf("expected a wrong code to be refused, got %d", status)
+	}
+	if status := totpChallenge("10.0.0.1", "secret123", current); status != http.StatusAccepted {
+		t.Fatalf("expected the current code to be accepted, got %d", status)
+	}
+	// the same code, from the same or another IP, is refused
+	for _, ip := range []string{"10.0.0.1", "10.0.0.2"} {
+		if status := totpChallenge(ip, "secret123", current); status != http.StatusUnauthorized {
+			t.Fatalf("expected a replayed code from %s to be refused, got %d", ip, status)
+		}
+	}
+	// as well as the code of the previous period, which is still within the skew
+	expected := 2
+	if previous != current {
+		expected++
+		if status := totpChallenge("10.0.0.2", "secret123", previous); status != http.StatusUnauthorized {
+			t.Fatalf("expected the code of an earlier period to be refused, got %d", status)
+		}
+	}
+
+	replays := 0
+	for _, e := range events.ofType(audit.EventChallenge) {
+		if e.Reason == reasonTOTPReplayed {
+			replays++
+		}
+	}
+	if replays != expected {
+		t.Fatalf("expected the replayed codes to be audited as %s, got %d event(s)", reasonTOTPReplayed, replays)
+	}
+}
+
+// a challenge refused after the TOTP code was checked does not spend the code
+func TestChallengeTOTPNotSpentWhenRefused(t *testing.T) {
+	setupTestProvider(t)
+	setupTestGeoIP(t, geoipPolicyReject)
+	u := addTestUser(t, "secret123", []string{"a.example.com"})
+	u.TOTPSecret = "JBSWY3DPEHPK3PXP"
+	u.AllowedCountries = []string{"GB"}
+	if err := dataProvider.UpdateUser(u); err != nil {
+		t.Fatal(err)
+	}
+
+	code := totpCode(t, u.TOTPSecret, time.Now())
+	if status := totpChallenge("89.160.20.1", "secret123", code); status != http.StatusForbidden {
+		t.Fatalf("expected a challenge from SE to be refused, got %d", status)
+	}
+	if status := totpChallenge("81.2.69.1", "secret123", code); status != http.StatusAccepted {
+		t.Fatalf("expected the code to still be usable from GB, got %d", status)
+	}
+	if status := totpChallenge("81.2.69.1", "secret123", code); status != http.StatusUnauthorized {
+		t.Fatalf("expected the code to be spent once accepted, got %d", status)
+	}
+}
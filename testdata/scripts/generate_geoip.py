@@ -0,0 +1,90 @@
+#!/usr/bin/env python3
+# generates the tiny GeoIP (MaxMind DB format, IPv4 only) database used by tests:
+#   python3 testdata/scripts/generate_geoip.py > testdata/geoip/test-city.mmdb
+# see https://maxmind.github.io/MaxMind-DB/ for the format
+import ipaddress
+import sys
+
+# network, country ISO code, city (english) name
+NETWORKS = [
+    ("81.2.69.0/24", "GB", "London"),
+    ("89.160.20.0/24", "SE", "Linkoping"),
+    ("216.160.83.0/24", "US", ""),
+]
+
+
+def encode_string(s):
+    b = s.encode()
+    assert len(b) < 29
+    return bytes([0x40 | len(b)]) + b
+
+
+def encode_uint(value, type_):
+    b = value.to_bytes((value.bit_length() + 7) // 8, "big") if value else b""
+    if type_ <= 7:
+        return bytes([(type_ << 5) | len(b)]) + b
+    return bytes([len(b), type_ - 7]) + b
+
+
+def encode_map(d):
+    out = bytes([0xE0 | len(d)])
+    for key, value in d.items():
+        out += encode_string(key) + value
+    return out
+
+
+def encode_array(values):
+    return bytes([len(values), 4]) + b"".join(values)
+
+
+def main():
+    data, offsets = b"", []
+    for _, country, city in NETWORKS:
+        record = {"country": encode_map({"iso_code": encode_string(country)})}
+        if city:
+            record["city"] = encode_map({"names": encode_map({"en": encode_string(city)})})
+        offsets.append(len(data))
+        data += encode_map(record)
+
+    # a binary tree of 32 bit addresses, leaves point to the data section
+    nodes = [[None, None]]
+    for i, (network, _, _) in enumerate(NETWORKS):
+        network = ipaddress.ip_network(network)
+        bits, node = int(network.network_address), 0
+        for depth in range(network.prefixlen):
+            bit = (bits >> (31 - depth)) & 1
+            if depth == network.prefixlen - 1:
+                nodes[node][bit] = ("data", i)
+                continue
+            if nodes[node][bit] is None:
+                nodes.append([None, None])
+                nodes[node][bit] = ("node", len(nodes) - 1)
+            node = nodes[node][bit][1]
+
+    count, tree = len(nodes), b""
+    for node in nodes:
+        for record in node:
+            if record is None:
+                value = count
+            elif record[0] == "node":
+                value = record[1]
+            else:
+                value = count + 16 + offsets[record[1]]
+            tree += value.to_bytes(3, "big")
+
+    metadata = encode_map({
+        "node_count": encode_uint(count, 6),
+        "record_size": encode_uint(24, 5),
+        "ip_version": encode_uint(4, 5),
+        "database_type": encode_string("Protego-Test-City"),
+        "languages": encode_array([encode_string("en")]),
+        "binary_format_major_version": encode_uint(2, 5),
+        "binary_format_minor_version": encode_uint(0, 5),
+        "build_epoch": encode_uint(1700000000, 9),
+        "description": encode_map({"en": encode_string("Protego test data")}),
+    })
+    sys.stdout.buffer.write(tree + b"\0" * 16 + data + b"\xab\xcd\xefMaxMind.com" + metadata)
+
+
+if __name__ == "__main__":
+    main()